@@ -0,0 +1,241 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/address_lookup_table"
+	"github.com/blocto/solana-go-sdk/program/metaplex/token_metadata"
+	"github.com/blocto/solana-go-sdk/program/name_service"
+	"github.com/blocto/solana-go-sdk/program/stake"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/program/token"
+	"github.com/blocto/solana-go-sdk/rpc"
+)
+
+var (
+	ErrAccountNotFound      = errors.New("account not found")
+	ErrAccountOwnerMismatch = errors.New("account owner mismatch")
+	ErrAccountDataMismatch  = errors.New("account data mismatch")
+	ErrNoAccountDecoder     = errors.New("no account decoder")
+)
+
+// AccountDecoder decodes the data of the accounts owned by Owner into one type
+type AccountDecoder struct {
+	Owner common.PublicKey
+	// Match tells the type apart from the other ones of the owner by the data size or the discriminator.
+	// nil matches any data.
+	Match func(data []byte) bool
+
+	typ    reflect.Type
+	decode func(data []byte) (any, error)
+}
+
+// NewAccountDecoder returns a decoder which decodes the data into T
+func NewAccountDecoder[T any](owner common.PublicKey, match func(data []byte) bool, decode func(data []byte) (T, error)) AccountDecoder {
+	return AccountDecoder{
+		Owner: owner,
+		Match: match,
+		typ:   reflect.TypeOf((*T)(nil)).Elem(),
+		decode: func(data []byte) (any, error) {
+			return decode(data)
+		},
+	}
+}
+
+// AccountDecoders is a registry which picks the decoder of an account by its owner and data.
+// it is safe for concurrent use.
+type AccountDecoders struct {
+	mu       sync.RWMutex
+	decoders []AccountDecoder
+}
+
+func NewAccountDecoders(decoders ...AccountDecoder) *AccountDecoders {
+	r := &AccountDecoders{}
+	r.Register(decoders...)
+	return r
+}
+
+// Register adds the decoders, the ones registered later are tried first so a builtin one can be overridden
+func (r *AccountDecoders) Register(decoders ...AccountDecoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.decoders = append(r.decoders, decoders...)
+}
+
+// Decode decodes the account with the first decoder which matches its owner and data
+func (r *AccountDecoders) Decode(account AccountInfo) (any, error) {
+	return DecodeAccount[any](r, account)
+}
+
+// DecodeAccount decodes the account into T with the first decoder of T which matches its owner and data.
+// it returns ErrAccountOwnerMismatch if no decoder of T takes the owner and ErrAccountDataMismatch if
+// the data isn't T, e.g. a mint is decoded into a token account.
+func DecodeAccount[T any](r *AccountDecoders, account AccountInfo) (T, error) {
+	var output T
+	want := reflect.TypeOf((*T)(nil)).Elem()
+
+	r.mu.RLock()
+	decoders := r.decoders
+	r.mu.RUnlock()
+
+	var typeMatched, ownerMatched bool
+	for i := len(decoders) - 1; i >= 0; i-- {
+		d := decoders[i]
+		if !d.typ.AssignableTo(want) {
+			continue
+		}
+		typeMatched = true
+		if d.Owner != account.Owner {
+			continue
+		}
+		ownerMatched = true
+		if d.Match != nil && !d.Match(account.Data) {
+			continue
+		}
+		v, err := d.decode(account.Data)
+		if err != nil {
+			return output, fmt.Errorf("failed to decode %v, err: %w", d.typ, err)
+		}
+		return v.(T), nil
+	}
+
+	switch {
+	case !typeMatched:
+		return output, fmt.Errorf("%w for %v", ErrNoAccountDecoder, want)
+	case !ownerMatched:
+		return output, fmt.Errorf("%w: %v isn't owned by %v", ErrAccountOwnerMismatch, want, account.Owner)
+	default:
+		return output, fmt.Errorf("%w: the data of %v bytes isn't %v", ErrAccountDataMismatch, len(account.Data), want)
+	}
+}
+
+// DefaultAccountDecoders has the decoders of the accounts of the native and the spl programs
+var DefaultAccountDecoders = NewAccountDecoders(
+	NewAccountDecoder(common.SystemProgramID, func(data []byte) bool {
+		return len(data) == system.NonceAccountSize
+	}, system.NonceAccountDeserialize),
+	NewAccountDecoder(common.StakeProgramID, func(data []byte) bool {
+		return len(data) == stake.StakeAccountSize
+	}, stake.StakeAccountDeserialize),
+	NewAccountDecoder(common.VoteProgramID, nil, stake.VoteAccountDeserialize),
+	NewAccountDecoder(common.AddressLookupTableProgramID, nil, func(data []byte) (address_lookup_table.AddressLookupTable, error) {
+		return address_lookup_table.DeserializeLookupTable(data, common.AddressLookupTableProgramID)
+	}),
+	NewAccountDecoder(common.MetaplexTokenMetaProgramID, func(data []byte) bool {
+		return len(data) > 0 && token_metadata.Key(data[0]) == token_metadata.KeyMetadataV1
+	}, token_metadata.MetadataDeserialize),
+	NewAccountDecoder(common.SPLNameServiceProgramID, nil, name_service.NameRecordHeaderFromData),
+	NewAccountDecoder(common.TokenProgramID, isTokenAccountType(token.AccountTypeAccount), token.TokenAccountFromData),
+	NewAccountDecoder(common.TokenProgramID, isTokenAccountType(token.AccountTypeMint), token.MintAccountFromData),
+	NewAccountDecoder(common.TokenProgramID, isTokenMultisig, token.MultisigAccountFromData),
+	NewAccountDecoder(common.Token2022ProgramID, isTokenAccountType(token.AccountTypeAccount), token.TokenAccountFromData),
+	NewAccountDecoder(common.Token2022ProgramID, isTokenAccountType(token.AccountTypeMint), token.MintAccountFromData),
+	NewAccountDecoder(common.Token2022ProgramID, isTokenMultisig, token.MultisigAccountFromData),
+)
+
+// isTokenAccountType tells a mint and a token account apart by the size or by the account type
+// after the base account. token-2022 never makes an account with extensions as large as a multisig.
+func isTokenAccountType(accountType token.AccountType) func(data []byte) bool {
+	return func(data []byte) bool {
+		switch len(data) {
+		case token.MintAccountSize:
+			return accountType == token.AccountTypeMint
+		case token.TokenAccountSize:
+			return accountType == token.AccountTypeAccount
+		case token.MultisigAccountSize:
+			return false
+		}
+		return len(data) > token.TokenAccountSize && token.AccountType(data[token.TokenAccountSize]) == accountType
+	}
+}
+
+func isTokenMultisig(data []byte) bool {
+	return len(data) == token.MultisigAccountSize
+}
+
+type GetAccountConfig struct {
+	Commitment rpc.Commitment
+	// Decoders picks the decoder of the accounts, default: DefaultAccountDecoders
+	Decoders *AccountDecoders
+}
+
+func (c GetAccountConfig) decoders() *AccountDecoders {
+	if c.Decoders == nil {
+		return DefaultAccountDecoders
+	}
+	return c.Decoders
+}
+
+// GetAccount fetches the account and decodes it into T, it returns ErrAccountNotFound if the account doesn't exist
+func GetAccount[T any](ctx context.Context, c *Client, base58Addr string) (T, error) {
+	return GetAccountWithConfig[T](ctx, c, base58Addr, GetAccountConfig{})
+}
+
+// GetAccountWithConfig fetches the account and decodes it into T, it returns ErrAccountNotFound if the account doesn't exist
+func GetAccountWithConfig[T any](ctx context.Context, c *Client, base58Addr string, cfg GetAccountConfig) (T, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[rpc.AccountInfo]], error) {
+			return c.RpcClient.GetAccountInfoWithConfig(ctx, base58Addr, GetAccountInfoConfig{Commitment: cfg.Commitment}.toRpc())
+		},
+		func(v rpc.ValueWithContext[rpc.AccountInfo]) (T, error) {
+			var output T
+			account, err := decodeAccountInfo[T](cfg.decoders(), base58Addr, v.Value)
+			if err != nil {
+				return output, err
+			}
+			if account == nil {
+				return output, fmt.Errorf("%w: %v", ErrAccountNotFound, base58Addr)
+			}
+			return *account, nil
+		},
+	)
+}
+
+// GetMultipleAccountsAs fetches the accounts and decodes them into T, the missing accounts are nil
+func GetMultipleAccountsAs[T any](ctx context.Context, c *Client, addrs []string) ([]*T, error) {
+	return GetMultipleAccountsAsWithConfig[T](ctx, c, addrs, GetAccountConfig{})
+}
+
+// GetMultipleAccountsAsWithConfig fetches the accounts and decodes them into T, the missing accounts are nil
+func GetMultipleAccountsAsWithConfig[T any](ctx context.Context, c *Client, addrs []string, cfg GetAccountConfig) ([]*T, error) {
+	return process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]], error) {
+			return c.RpcClient.GetMultipleAccountsWithConfig(ctx, addrs, GetMultipleAccountsConfig{Commitment: cfg.Commitment}.toRpc())
+		},
+		func(v rpc.ValueWithContext[[]rpc.AccountInfo]) ([]*T, error) {
+			if len(v.Value) != len(addrs) {
+				return nil, fmt.Errorf("expected %v accounts, got %v", len(addrs), len(v.Value))
+			}
+			output := make([]*T, 0, len(v.Value))
+			for i, accountInfo := range v.Value {
+				account, err := decodeAccountInfo[T](cfg.decoders(), addrs[i], accountInfo)
+				if err != nil {
+					return nil, err
+				}
+				output = append(output, account)
+			}
+			return output, nil
+		},
+	)
+}
+
+// decodeAccountInfo returns nil if the account doesn't exist
+func decodeAccountInfo[T any](decoders *AccountDecoders, base58Addr string, v rpc.AccountInfo) (*T, error) {
+	if v == (rpc.AccountInfo{}) {
+		return nil, nil
+	}
+	accountInfo, err := convertAccountInfo(v)
+	if err != nil {
+		return nil, err
+	}
+	account, err := DecodeAccount[T](decoders, accountInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode account %v, err: %w", base58Addr, err)
+	}
+	return &account, nil
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/address_lookup_table"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/program/token"
+	"github.com/blocto/solana-go-sdk/rpc/rpctest"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTokenAccountData(mint, owner common.PublicKey, amount uint64) []byte {
+	data := make([]byte, token.TokenAccountSize)
+	copy(data, mint.Bytes())
+	copy(data[32:], owner.Bytes())
+	binary.LittleEndian.PutUint64(data[64:], amount)
+	data[108] = byte(token.TokenAccountStateInitialized)
+	return data
+}
+
+func TestGetAccount(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := NewClient(s.URL)
+	ctx := context.Background()
+
+	mint, owner := types.NewAccount().PublicKey, types.NewAccount().PublicKey
+	tokenAccount, token2022Account, mintAccount, nonceAccount, wallet, table, missing :=
+		types.NewAccount().PublicKey, types.NewAccount().PublicKey, types.NewAccount().PublicKey,
+		types.NewAccount().PublicKey, types.NewAccount().PublicKey, types.NewAccount().PublicKey,
+		types.NewAccount().PublicKey
+
+	mintData := make([]byte, token.MintAccountSize)
+	mintData[44] = 9
+	mintData[45] = 1
+	s.SetAccount(tokenAccount, rpctest.Account{Lamports: 1, Owner: common.TokenProgramID, Data: testTokenAccountData(mint, owner, 100)})
+	s.SetAccount(token2022Account, rpctest.Account{Lamports: 1, Owner: common.Token2022ProgramID, Data: append(testTokenAccountData(mint, owner, 200), byte(token.AccountTypeAccount))})
+	s.SetAccount(mintAccount, rpctest.Account{Lamports: 1, Owner: common.TokenProgramID, Data: mintData})
+	s.SetAccount(nonceAccount, rpctest.Account{Lamports: 1, Owner: common.SystemProgramID, Data: make([]byte, system.NonceAccountSize)})
+	s.SetAccount(table, rpctest.Account{Lamports: 1, Owner: common.AddressLookupTableProgramID, Data: testLookupTableData(math.MaxUint64, []common.PublicKey{mint})})
+	s.Airdrop(wallet, 1_000_000)
+
+	t.Run("token account", func(t *testing.T) {
+		got, err := GetAccount[token.TokenAccount](ctx, c, tokenAccount.ToBase58())
+		assert.Nil(t, err)
+		assert.Equal(t, token.TokenAccount{Mint: mint, Owner: owner, Amount: 100, State: token.TokenAccountStateInitialized}, got)
+
+		got, err = GetAccount[token.TokenAccount](ctx, c, token2022Account.ToBase58())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(200), got.Amount)
+	})
+
+	t.Run("any", func(t *testing.T) {
+		got, err := GetAccount[any](ctx, c, mintAccount.ToBase58())
+		assert.Nil(t, err)
+		assert.Equal(t, token.MintAccount{Decimals: 9, IsInitialized: true}, got)
+
+		got, err = GetAccount[any](ctx, c, table.ToBase58())
+		assert.Nil(t, err)
+		assert.Equal(t, []common.PublicKey{mint}, got.(address_lookup_table.AddressLookupTable).Addresses)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := GetAccount[token.MintAccount](ctx, c, tokenAccount.ToBase58())
+		assert.ErrorIs(t, err, ErrAccountDataMismatch)
+
+		_, err = GetAccount[token.TokenAccount](ctx, c, nonceAccount.ToBase58())
+		assert.ErrorIs(t, err, ErrAccountOwnerMismatch)
+
+		_, err = GetAccount[system.NonceAccount](ctx, c, wallet.ToBase58())
+		assert.ErrorIs(t, err, ErrAccountDataMismatch)
+
+		_, err = GetAccount[token.TokenAccount](ctx, c, missing.ToBase58())
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+
+		_, err = GetAccount[int](ctx, c, tokenAccount.ToBase58())
+		assert.ErrorIs(t, err, ErrNoAccountDecoder)
+	})
+
+	t.Run("multiple", func(t *testing.T) {
+		got, err := GetMultipleAccountsAs[token.TokenAccount](ctx, c, []string{token2022Account.ToBase58(), missing.ToBase58(), tokenAccount.ToBase58()})
+		assert.Nil(t, err)
+		assert.Len(t, got, 3)
+		assert.Equal(t, uint64(200), got[0].Amount)
+		assert.Nil(t, got[1])
+		assert.Equal(t, uint64(100), got[2].Amount)
+
+		_, err = GetMultipleAccountsAs[token.TokenAccount](ctx, c, []string{tokenAccount.ToBase58(), mintAccount.ToBase58()})
+		assert.ErrorIs(t, err, ErrAccountDataMismatch)
+	})
+
+	t.Run("custom decoders", func(t *testing.T) {
+		type walletAccount struct {
+			DataSize int
+		}
+		decoders := NewAccountDecoders(
+			NewAccountDecoder(common.SystemProgramID, nil, func(data []byte) (walletAccount, error) {
+				return walletAccount{DataSize: len(data)}, nil
+			}),
+		)
+		got, err := GetAccountWithConfig[walletAccount](ctx, c, wallet.ToBase58(), GetAccountConfig{Decoders: decoders})
+		assert.Nil(t, err)
+		assert.Equal(t, walletAccount{}, got)
+
+		_, err = GetAccountWithConfig[token.TokenAccount](ctx, c, tokenAccount.ToBase58(), GetAccountConfig{Decoders: decoders})
+		assert.ErrorIs(t, err, ErrNoAccountDecoder)
+	})
+}
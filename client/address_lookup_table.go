@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/address_lookup_table"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+// exhaustiveSearchLimit is the max number of useful tables which are tried in every combination,
+// above it the tables are picked greedily
+const exhaustiveSearchLimit = 10
+
+type NewV0MessageParam struct {
+	FeePayer        common.PublicKey
+	Instructions    []types.Instruction
+	RecentBlockhash common.Hash
+	// AddressLookupTables are the candidates, only the ones which make the transaction smaller are used
+	AddressLookupTables []common.PublicKey
+}
+
+type V0Message struct {
+	Message types.Message
+	// AddressLookupTableAccounts are the tables the message uses
+	AddressLookupTableAccounts []types.AddressLookupTableAccount
+	// Size is the size of the transaction after it is signed
+	Size int
+}
+
+// Fits reports whether the transaction is within the packet limit
+func (m V0Message) Fits() bool {
+	return m.Size <= types.PacketDataSize
+}
+
+// GetAddressLookupTables fetches the lookup tables. the closed, the uninitialized and the deactivated ones
+// are dropped, so are the accounts which aren't lookup tables.
+func (c *Client) GetAddressLookupTables(ctx context.Context, addrs []common.PublicKey) ([]types.AddressLookupTableAccount, error) {
+	if len(addrs) == 0 {
+		return []types.AddressLookupTableAccount{}, nil
+	}
+
+	base58Addrs := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		base58Addrs = append(base58Addrs, addr.ToBase58())
+	}
+	accountInfos, err := c.GetMultipleAccountsInPages(ctx, base58Addrs, GetMultipleAccountsInPagesConfig{})
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make([]types.AddressLookupTableAccount, 0, len(addrs))
+	for i, accountInfo := range accountInfos {
+		if accountInfo == nil || accountInfo.Owner != common.AddressLookupTableProgramID {
+			continue
+		}
+		table, err := address_lookup_table.DeserializeLookupTable(accountInfo.Data, accountInfo.Owner)
+		if err != nil {
+			return nil, fmt.Errorf("failed to deserialize lookup table %v, err: %w", addrs[i], err)
+		}
+		if table.ProgramState != address_lookup_table.ProgramStateLookupTable || table.DeactivationSlot != math.MaxUint64 {
+			continue
+		}
+		tables = append(tables, types.AddressLookupTableAccount{
+			Key:       addrs[i],
+			Addresses: table.Addresses,
+		})
+	}
+	return tables, nil
+}
+
+// NewV0Message fetches the candidate lookup tables and builds the smallest v0 message with them
+func (c *Client) NewV0Message(ctx context.Context, param NewV0MessageParam) (V0Message, error) {
+	tables, err := c.GetAddressLookupTables(ctx, param.AddressLookupTables)
+	if err != nil {
+		return V0Message{}, fmt.Errorf("failed to get address lookup tables, err: %w", err)
+	}
+	return NewV0MessageWithAddressLookupTables(types.NewMessageParam{
+		FeePayer:                   param.FeePayer,
+		Instructions:               param.Instructions,
+		RecentBlockhash:            param.RecentBlockhash,
+		AddressLookupTableAccounts: tables,
+	}), nil
+}
+
+// NewV0MessageWithAddressLookupTables builds the smallest v0 message with a subset of param.AddressLookupTableAccounts.
+// RecentBlockhash can be zero if it is going to be set later, the size doesn't depend on it.
+func NewV0MessageWithAddressLookupTables(param types.NewMessageParam) V0Message {
+	// only the accounts which are neither signers nor programs can be looked up
+	compiledKeys := types.NewCompiledKeys(param.Instructions, &param.FeePayer)
+	useful := []types.AddressLookupTableAccount{}
+	for _, table := range param.AddressLookupTableAccounts {
+		for _, address := range table.Addresses {
+			if meta, ok := compiledKeys.KeyMetaMap[address]; ok && !meta.IsSigner && !meta.IsInvoked {
+				useful = append(useful, table)
+				break
+			}
+		}
+	}
+
+	build := func(tables []types.AddressLookupTableAccount) V0Message {
+		p := param
+		p.AddressLookupTableAccounts = tables
+		message := types.NewMessage(p)
+		message.Version = types.MessageVersionV0
+		return V0Message{Message: message, AddressLookupTableAccounts: tables, Size: message.EstimateSize()}
+	}
+
+	best := build([]types.AddressLookupTableAccount{})
+
+	if len(useful) <= exhaustiveSearchLimit {
+		for mask := 1; mask < 1<<len(useful); mask++ {
+			tables := make([]types.AddressLookupTableAccount, 0, len(useful))
+			for i := range useful {
+				if mask&(1<<i) != 0 {
+					tables = append(tables, useful[i])
+				}
+			}
+			m := build(tables)
+			if m.Size < best.Size || (m.Size == best.Size && len(m.AddressLookupTableAccounts) < len(best.AddressLookupTableAccounts)) {
+				best = m
+			}
+		}
+		return best
+	}
+
+	// add the table which saves the most until none of the rest helps
+	chosen := make([]bool, len(useful))
+	for {
+		next, nextIdx := best, -1
+		for i := range useful {
+			if chosen[i] {
+				continue
+			}
+			tables := make([]types.AddressLookupTableAccount, 0, len(best.AddressLookupTableAccounts)+1)
+			for j := range useful {
+				if chosen[j] || j == i {
+					tables = append(tables, useful[j])
+				}
+			}
+			m := build(tables)
+			if m.Size < next.Size {
+				next, nextIdx = m, i
+			}
+		}
+		if nextIdx < 0 {
+			return best
+		}
+		chosen[nextIdx] = true
+		best = next
+	}
+}
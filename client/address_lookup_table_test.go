@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/rpc/rpctest"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testLookupTableData(deactivationSlot uint64, addresses []common.PublicKey) []byte {
+	data := make([]byte, 56, 56+32*len(addresses))
+	binary.LittleEndian.PutUint32(data, 1)
+	binary.LittleEndian.PutUint64(data[4:], deactivationSlot)
+	data[21] = 1 // has an authority
+	for _, address := range addresses {
+		data = append(data, address.Bytes()...)
+	}
+	return data
+}
+
+func TestClient_NewV0Message(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := NewClient(s.URL)
+	ctx := context.Background()
+
+	payer := types.NewAccount()
+	s.Airdrop(payer.PublicKey, 1_000_000_000)
+
+	recipients := make([]common.PublicKey, 0, 40)
+	instructions := make([]types.Instruction, 0, 40)
+	for i := 0; i < 40; i++ {
+		recipient := types.NewAccount().PublicKey
+		recipients = append(recipients, recipient)
+		instructions = append(instructions, system.Transfer(system.TransferParam{
+			From:   payer.PublicKey,
+			To:     recipient,
+			Amount: rpctest.MinimumBalanceForRentExemption(0),
+		}))
+	}
+
+	recipientTable, unrelatedTable, deactivatedTable := types.NewAccount().PublicKey, types.NewAccount().PublicKey, types.NewAccount().PublicKey
+	s.SetAccount(recipientTable, rpctest.Account{Lamports: 1, Owner: common.AddressLookupTableProgramID, Data: testLookupTableData(math.MaxUint64, recipients)})
+	s.SetAccount(unrelatedTable, rpctest.Account{Lamports: 1, Owner: common.AddressLookupTableProgramID, Data: testLookupTableData(math.MaxUint64, []common.PublicKey{types.NewAccount().PublicKey})})
+	s.SetAccount(deactivatedTable, rpctest.Account{Lamports: 1, Owner: common.AddressLookupTableProgramID, Data: testLookupTableData(1, recipients)})
+
+	tables, err := c.GetAddressLookupTables(ctx, []common.PublicKey{deactivatedTable, unrelatedTable, recipientTable})
+	assert.Nil(t, err)
+	assert.Len(t, tables, 2)
+
+	latest, err := c.GetLatestBlockhash(ctx)
+	assert.Nil(t, err)
+
+	withoutTables := NewV0MessageWithAddressLookupTables(types.NewMessageParam{
+		FeePayer:     payer.PublicKey,
+		Instructions: instructions,
+	})
+	assert.False(t, withoutTables.Fits())
+	assert.Empty(t, withoutTables.AddressLookupTableAccounts)
+
+	m, err := c.NewV0Message(ctx, NewV0MessageParam{
+		FeePayer:            payer.PublicKey,
+		Instructions:        instructions,
+		RecentBlockhash:     latest.Blockhash,
+		AddressLookupTables: []common.PublicKey{deactivatedTable, unrelatedTable, recipientTable},
+	})
+	assert.Nil(t, err)
+	assert.True(t, m.Fits())
+	assert.Equal(t, types.MessageVersion(types.MessageVersionV0), m.Message.Version)
+	assert.Equal(t, []types.AddressLookupTableAccount{{Key: recipientTable, Addresses: recipients}}, m.AddressLookupTableAccounts)
+
+	tx, err := types.NewTransaction(types.NewTransactionParam{Message: m.Message, Signers: []types.Account{payer}})
+	assert.Nil(t, err)
+	raw, err := tx.Serialize()
+	assert.Nil(t, err)
+	assert.Equal(t, m.Size, len(raw))
+
+	sig, err := c.SendAndConfirmTransaction(ctx, tx, SendAndConfirmTransactionConfig{
+		Confirm: ConfirmTransactionConfig{
+			LastValidBlockHeight: latest.LatestValidBlockHeight,
+			PollInterval:         time.Millisecond,
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, rpctest.MinimumBalanceForRentExemption(0), s.GetBalance(recipients[39]))
+
+	// the accounts from the table are resolved by the loaded addresses in the meta
+	got, err := c.GetTransaction(ctx, sig)
+	assert.Nil(t, err)
+	decompiled, err := got.DecompileInstructions()
+	assert.Nil(t, err)
+	assert.Equal(t, instructions, decompiled)
+
+	// an account which isn't a lookup table is dropped
+	tables, err = c.GetAddressLookupTables(ctx, []common.PublicKey{payer.PublicKey})
+	assert.Nil(t, err)
+	assert.Empty(t, tables)
+}
+
+func TestNewV0MessageWithAddressLookupTables(t *testing.T) {
+	payer, signer := types.NewAccount().PublicKey, types.NewAccount().PublicKey
+	a, b, c := types.NewAccount().PublicKey, types.NewAccount().PublicKey, types.NewAccount().PublicKey
+	instruction := types.Instruction{
+		ProgramID: common.MemoProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: signer, IsSigner: true},
+			{PubKey: a, IsWritable: true},
+			{PubKey: b},
+			{PubKey: c},
+		},
+	}
+	tables := []types.AddressLookupTableAccount{
+		// only saves a, a table costs more than a key
+		{Key: types.NewAccount().PublicKey, Addresses: []common.PublicKey{a}},
+		// signers and programs can't be looked up
+		{Key: types.NewAccount().PublicKey, Addresses: []common.PublicKey{signer, common.MemoProgramID}},
+		{Key: types.NewAccount().PublicKey, Addresses: []common.PublicKey{a, b, c}},
+	}
+
+	got := NewV0MessageWithAddressLookupTables(types.NewMessageParam{
+		FeePayer:                   payer,
+		Instructions:               []types.Instruction{instruction},
+		AddressLookupTableAccounts: tables,
+	})
+	assert.Equal(t, tables[2:], got.AddressLookupTableAccounts)
+	assert.Equal(t, []common.PublicKey{payer, signer, common.MemoProgramID}, got.Message.Accounts)
+
+	got = NewV0MessageWithAddressLookupTables(types.NewMessageParam{
+		FeePayer:                   payer,
+		Instructions:               []types.Instruction{instruction},
+		AddressLookupTableAccounts: tables[:2],
+	})
+	assert.Empty(t, got.AddressLookupTableAccounts)
+	assert.Empty(t, got.Message.AddressLookupTables)
+	assert.Equal(t, types.MessageVersion(types.MessageVersionV0), got.Message.Version)
+}
+
+func TestClient_GetAddressLookupTables(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := NewClient(s.URL)
+	ctx := context.Background()
+
+	candidates := make([]common.PublicKey, 0, 120)
+	for i := 0; i < 118; i++ {
+		table := types.NewAccount().PublicKey
+		s.SetAccount(table, rpctest.Account{Lamports: 1, Owner: common.AddressLookupTableProgramID, Data: testLookupTableData(math.MaxUint64, []common.PublicKey{types.NewAccount().PublicKey})})
+		candidates = append(candidates, table)
+	}
+	closedTable, wallet := types.NewAccount().PublicKey, types.NewAccount().PublicKey
+	s.Airdrop(wallet, 1_000_000)
+	candidates = append(candidates, closedTable, wallet)
+
+	tables, err := c.GetAddressLookupTables(ctx, candidates)
+	assert.Nil(t, err)
+	assert.Len(t, tables, 118)
+	for i, table := range tables {
+		assert.Equal(t, candidates[i], table.Key)
+	}
+}
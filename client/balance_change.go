@@ -0,0 +1,317 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/program/token"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+// SolBalanceChange is the lamports change of an account in a transaction
+type SolBalanceChange struct {
+	Account common.PublicKey
+	Pre     int64
+	Post    int64
+	// Change is Post - Pre. The fee payer's change includes the fee.
+	Change int64
+	// Fee is the transaction fee. It is only set on the fee payer.
+	Fee uint64
+}
+
+// TokenBalanceChange is the token amount change of an owner for a mint in a transaction
+type TokenBalanceChange struct {
+	Owner     common.PublicKey
+	Mint      common.PublicKey
+	ProgramID common.PublicKey
+	Decimals  uint8
+	Pre       uint64
+	Post      uint64
+	// Change is Post - Pre. It is a big.Int because the difference of two u64 amounts can overflow an int64.
+	Change *big.Int
+}
+
+// SolTransfer is a lamports transfer done by the system program, it is a transfer, an account creation
+// or a nonce account withdrawal
+type SolTransfer struct {
+	// InstructionIndex is the index of the top-level instruction
+	InstructionIndex int
+	// InnerInstructionIndex is the index in the inner instructions of the top-level instruction, nil means it is the top-level one
+	InnerInstructionIndex *int
+	From                  common.PublicKey
+	To                    common.PublicKey
+	Amount                uint64
+}
+
+// TokenTransfer is a token transfer done by the token program or the token-2022 program
+type TokenTransfer struct {
+	// InstructionIndex is the index of the top-level instruction
+	InstructionIndex int
+	// InnerInstructionIndex is the index in the inner instructions of the top-level instruction, nil means it is the top-level one
+	InnerInstructionIndex *int
+	ProgramID             common.PublicKey
+	Mint                  common.PublicKey
+	// Source and Destination are token accounts, their owners come from the token balances of the meta
+	Source           common.PublicKey
+	SourceOwner      common.PublicKey
+	Destination      common.PublicKey
+	DestinationOwner common.PublicKey
+	Amount           uint64
+	Decimals         uint8
+	// Fee is the withheld transfer fee of TransferCheckedWithFee
+	Fee uint64
+}
+
+// SolBalanceChanges returns the accounts whose lamports changed in the transaction, in the order of AccountKeys.
+// The fee payer is always included when the fee is not zero.
+func (t Transaction) SolBalanceChanges() ([]SolBalanceChange, error) {
+	if t.Meta == nil {
+		return nil, errors.New("transaction meta not found")
+	}
+	if len(t.Meta.PreBalances) != len(t.AccountKeys) || len(t.Meta.PostBalances) != len(t.AccountKeys) {
+		return nil, fmt.Errorf("balances length mismatch, accounts: %v, pre: %v, post: %v", len(t.AccountKeys), len(t.Meta.PreBalances), len(t.Meta.PostBalances))
+	}
+
+	changes := []SolBalanceChange{}
+	for i, account := range t.AccountKeys {
+		change := SolBalanceChange{
+			Account: account,
+			Pre:     t.Meta.PreBalances[i],
+			Post:    t.Meta.PostBalances[i],
+			Change:  t.Meta.PostBalances[i] - t.Meta.PreBalances[i],
+		}
+		if i == 0 {
+			change.Fee = t.Meta.Fee
+		}
+		if change.Change == 0 && change.Fee == 0 {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// TokenBalanceChanges returns the token changes grouped by owner and mint, in the order they first show up in the token balances.
+func (t Transaction) TokenBalanceChanges() ([]TokenBalanceChange, error) {
+	if t.Meta == nil {
+		return nil, errors.New("transaction meta not found")
+	}
+
+	type key struct {
+		owner, mint common.PublicKey
+	}
+	m := map[key]*TokenBalanceChange{}
+	keys := []key{}
+	add := func(balances []rpc.TransactionMetaTokenBalance, isPost bool) error {
+		for _, balance := range balances {
+			info, err := convertTokenBalance(balance)
+			if err != nil {
+				return err
+			}
+			k := key{owner: info.owner, mint: info.mint}
+			c, ok := m[k]
+			if !ok {
+				c = &TokenBalanceChange{
+					Owner:     info.owner,
+					Mint:      info.mint,
+					ProgramID: info.programID,
+					Decimals:  info.decimals,
+				}
+				m[k] = c
+				keys = append(keys, k)
+			}
+			if isPost {
+				c.Post += info.amount
+			} else {
+				c.Pre += info.amount
+			}
+		}
+		return nil
+	}
+	if err := add(t.Meta.PreTokenBalances, false); err != nil {
+		return nil, err
+	}
+	if err := add(t.Meta.PostTokenBalances, true); err != nil {
+		return nil, err
+	}
+
+	changes := make([]TokenBalanceChange, 0, len(keys))
+	for _, k := range keys {
+		c := m[k]
+		if c.Pre == c.Post {
+			continue
+		}
+		c.Change = new(big.Int).Sub(new(big.Int).SetUint64(c.Post), new(big.Int).SetUint64(c.Pre))
+		changes = append(changes, *c)
+	}
+	return changes, nil
+}
+
+// Transfers reconstructs the sol and token transfers from the top-level and the inner instructions in the executed order.
+// A failed transaction has no transfers.
+func (t Transaction) Transfers() ([]SolTransfer, []TokenTransfer, error) {
+	if t.Meta == nil {
+		return nil, nil, errors.New("transaction meta not found")
+	}
+	if t.Meta.Err != nil {
+		return []SolTransfer{}, []TokenTransfer{}, nil
+	}
+
+	tokenAccounts := map[uint64]tokenBalanceInfo{}
+	for _, balances := range [][]rpc.TransactionMetaTokenBalance{t.Meta.PreTokenBalances, t.Meta.PostTokenBalances} {
+		for _, balance := range balances {
+			info, err := convertTokenBalance(balance)
+			if err != nil {
+				return nil, nil, err
+			}
+			tokenAccounts[balance.AccountIndex] = info
+		}
+	}
+	tokenAccount := func(pubkey common.PublicKey) tokenBalanceInfo {
+		for i, account := range t.AccountKeys {
+			if account == pubkey {
+				return tokenAccounts[uint64(i)]
+			}
+		}
+		return tokenBalanceInfo{}
+	}
+
+	innerInstructions := map[int][]types.CompiledInstruction{}
+	for _, inner := range t.Meta.InnerInstructions {
+		innerInstructions[int(inner.Index)] = append(innerInstructions[int(inner.Index)], inner.Instructions...)
+	}
+
+	solTransfers, tokenTransfers := []SolTransfer{}, []TokenTransfer{}
+	collect := func(instructionIndex int, innerInstructionIndex *int, compiledInstruction types.CompiledInstruction) error {
+		if compiledInstruction.ProgramIDIndex < 0 || compiledInstruction.ProgramIDIndex >= len(t.AccountKeys) {
+			return fmt.Errorf("%w: program id index %v", instructiondecoder.ErrInvalidAccountIndex, compiledInstruction.ProgramIDIndex)
+		}
+		switch t.AccountKeys[compiledInstruction.ProgramIDIndex] {
+		case common.SystemProgramID, common.TokenProgramID, common.Token2022ProgramID:
+		default:
+			return nil
+		}
+
+		instruction, err := instructiondecoder.DecodeCompiled(t.AccountKeys, compiledInstruction)
+		if err != nil {
+			if errors.Is(err, instructiondecoder.ErrUnknownInstruction) {
+				return nil
+			}
+			return err
+		}
+
+		switch p := instruction.Param.(type) {
+		case system.TransferParam:
+			solTransfers = append(solTransfers, SolTransfer{InstructionIndex: instructionIndex, InnerInstructionIndex: innerInstructionIndex, From: p.From, To: p.To, Amount: p.Amount})
+		case system.TransferWithSeedParam:
+			solTransfers = append(solTransfers, SolTransfer{InstructionIndex: instructionIndex, InnerInstructionIndex: innerInstructionIndex, From: p.From, To: p.To, Amount: p.Amount})
+		case system.CreateAccountParam:
+			solTransfers = append(solTransfers, SolTransfer{InstructionIndex: instructionIndex, InnerInstructionIndex: innerInstructionIndex, From: p.From, To: p.New, Amount: p.Lamports})
+		case system.CreateAccountWithSeedParam:
+			solTransfers = append(solTransfers, SolTransfer{InstructionIndex: instructionIndex, InnerInstructionIndex: innerInstructionIndex, From: p.From, To: p.New, Amount: p.Lamports})
+		case system.WithdrawNonceAccountParam:
+			solTransfers = append(solTransfers, SolTransfer{InstructionIndex: instructionIndex, InnerInstructionIndex: innerInstructionIndex, From: p.Nonce, To: p.To, Amount: p.Amount})
+		case token.TransferParam:
+			source, destination := tokenAccount(p.From), tokenAccount(p.To)
+			mint, decimals := source.mint, source.decimals
+			if mint == (common.PublicKey{}) {
+				mint, decimals = destination.mint, destination.decimals
+			}
+			tokenTransfers = append(tokenTransfers, TokenTransfer{
+				InstructionIndex:      instructionIndex,
+				InnerInstructionIndex: innerInstructionIndex,
+				ProgramID:             instruction.ProgramID,
+				Mint:                  mint,
+				Source:                p.From,
+				SourceOwner:           source.owner,
+				Destination:           p.To,
+				DestinationOwner:      destination.owner,
+				Amount:                p.Amount,
+				Decimals:              decimals,
+			})
+		case token.TransferCheckedParam:
+			tokenTransfers = append(tokenTransfers, TokenTransfer{
+				InstructionIndex:      instructionIndex,
+				InnerInstructionIndex: innerInstructionIndex,
+				ProgramID:             instruction.ProgramID,
+				Mint:                  p.Mint,
+				Source:                p.From,
+				SourceOwner:           tokenAccount(p.From).owner,
+				Destination:           p.To,
+				DestinationOwner:      tokenAccount(p.To).owner,
+				Amount:                p.Amount,
+				Decimals:              p.Decimals,
+			})
+		case token.TransferCheckedWithFeeParam:
+			tokenTransfers = append(tokenTransfers, TokenTransfer{
+				InstructionIndex:      instructionIndex,
+				InnerInstructionIndex: innerInstructionIndex,
+				ProgramID:             instruction.ProgramID,
+				Mint:                  p.Mint,
+				Source:                p.From,
+				SourceOwner:           tokenAccount(p.From).owner,
+				Destination:           p.To,
+				DestinationOwner:      tokenAccount(p.To).owner,
+				Amount:                p.Amount,
+				Decimals:              p.Decimals,
+				Fee:                   p.Fee,
+			})
+		}
+		return nil
+	}
+
+	for i, compiledInstruction := range t.Transaction.Message.Instructions {
+		if err := collect(i, nil, compiledInstruction); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode instruction #%v, err: %w", i, err)
+		}
+		for j, innerInstruction := range innerInstructions[i] {
+			j := j
+			if err := collect(i, &j, innerInstruction); err != nil {
+				return nil, nil, fmt.Errorf("failed to decode inner instruction #%v.%v, err: %w", i, j, err)
+			}
+		}
+	}
+
+	return solTransfers, tokenTransfers, nil
+}
+
+type tokenBalanceInfo struct {
+	owner     common.PublicKey
+	mint      common.PublicKey
+	programID common.PublicKey
+	decimals  uint8
+	amount    uint64
+}
+
+func convertTokenBalance(balance rpc.TransactionMetaTokenBalance) (tokenBalanceInfo, error) {
+	amount, err := strconv.ParseUint(balance.UITokenAmount.Amount, 10, 64)
+	if err != nil {
+		return tokenBalanceInfo{}, fmt.Errorf("failed to parse token amount, account index: %v, err: %v", balance.AccountIndex, err)
+	}
+	mint, err := common.ParsePublicKey(balance.Mint)
+	if err != nil {
+		return tokenBalanceInfo{}, fmt.Errorf("failed to parse token mint, account index: %v, err: %w", balance.AccountIndex, err)
+	}
+	info := tokenBalanceInfo{
+		mint:     mint,
+		decimals: balance.UITokenAmount.Decimals,
+		amount:   amount,
+	}
+	if balance.Owner != "" {
+		if info.owner, err = common.ParsePublicKey(balance.Owner); err != nil {
+			return tokenBalanceInfo{}, fmt.Errorf("failed to parse token owner, account index: %v, err: %w", balance.AccountIndex, err)
+		}
+	}
+	if balance.ProgramId != "" {
+		if info.programID, err = common.ParsePublicKey(balance.ProgramId); err != nil {
+			return tokenBalanceInfo{}, fmt.Errorf("failed to parse token program id, account index: %v, err: %w", balance.AccountIndex, err)
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,230 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/pointer"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/program/token"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testAlice        = common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	testBob          = common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	testCarol        = common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+	testAliceToken   = common.PublicKeyFromString("AyHWro8zumyZN68Mfxg4Jzw1QbE4imSfJKv8SFx1LiJT")
+	testBobToken     = common.PublicKeyFromString("9We5nqAKpWQ9dJ2CqvM8ciEFZDvXGgHTSDrn2uYbBZKP")
+	testCarolToken   = common.PublicKeyFromString("DCvEnNNSkkMUxUMd1nW4RVaC1bDsEjCtBLgtDtSaBqsX")
+	testMint         = common.PublicKeyFromString("F6tecPzBMF47yJ2EN6j2aXJE1CxcVnjgtfaHTGFXS1gB")
+	testOtherProgram = common.PublicKeyFromString("8WSNk9uUtL6iE1fdDSsWCXXmCCf3Ls4kYqyB8jXynuRP")
+)
+
+// testTransferTransaction builds a transaction which
+//   - #0 transfers 1000 lamports from alice to bob
+//   - #1 transfers 10 tokens from alice to bob
+//   - #2 calls a program which transfers 500 lamports from bob to carol and 3 tokens from bob to carol
+func testTransferTransaction() Transaction {
+	accountKeys := []common.PublicKey{
+		testAlice, testBob, testCarol, testAliceToken, testBobToken, testCarolToken, testMint,
+		common.SystemProgramID, common.TokenProgramID, testOtherProgram,
+	}
+	tokenBalance := func(accountIndex uint64, owner common.PublicKey, amount string) rpc.TransactionMetaTokenBalance {
+		return rpc.TransactionMetaTokenBalance{
+			AccountIndex:  accountIndex,
+			Mint:          testMint.ToBase58(),
+			Owner:         owner.ToBase58(),
+			ProgramId:     common.TokenProgramID.ToBase58(),
+			UITokenAmount: rpc.TokenAccountBalance{Amount: amount, Decimals: 6},
+		}
+	}
+	return Transaction{
+		Transaction: types.Transaction{
+			Message: types.Message{
+				Accounts: accountKeys,
+				Instructions: []types.CompiledInstruction{
+					{
+						ProgramIDIndex: 7,
+						Accounts:       []int{0, 1},
+						Data:           system.Transfer(system.TransferParam{From: testAlice, To: testBob, Amount: 1000}).Data,
+					},
+					{
+						ProgramIDIndex: 8,
+						Accounts:       []int{3, 6, 4, 0},
+						Data: token.TransferChecked(token.TransferCheckedParam{
+							From: testAliceToken, To: testBobToken, Mint: testMint, Auth: testAlice, Amount: 10, Decimals: 6, ProgramID: common.TokenProgramID,
+						}).Data,
+					},
+					{
+						ProgramIDIndex: 9,
+						Accounts:       []int{1, 4, 5},
+						Data:           []byte{0},
+					},
+				},
+			},
+		},
+		Meta: &TransactionMeta{
+			Fee:          5000,
+			PreBalances:  []int64{10000, 0, 0, 1, 1, 1, 1, 1, 1, 1},
+			PostBalances: []int64{4000, 500, 500, 1, 1, 1, 1, 1, 1, 1},
+			PreTokenBalances: []rpc.TransactionMetaTokenBalance{
+				tokenBalance(3, testAlice, "100"),
+				tokenBalance(4, testBob, "0"),
+			},
+			PostTokenBalances: []rpc.TransactionMetaTokenBalance{
+				tokenBalance(3, testAlice, "90"),
+				tokenBalance(4, testBob, "7"),
+				tokenBalance(5, testCarol, "3"),
+			},
+			InnerInstructions: []InnerInstruction{
+				{
+					Index: 2,
+					Instructions: []types.CompiledInstruction{
+						{
+							ProgramIDIndex: 7,
+							Accounts:       []int{1, 2},
+							Data:           system.Transfer(system.TransferParam{From: testBob, To: testCarol, Amount: 500}).Data,
+						},
+						{
+							ProgramIDIndex: 8,
+							Accounts:       []int{4, 5, 1},
+							Data:           token.Transfer(token.TransferParam{From: testBobToken, To: testCarolToken, Auth: testBob, Amount: 3}).Data,
+						},
+					},
+				},
+			},
+		},
+		AccountKeys: accountKeys,
+	}
+}
+
+func TestTransaction_SolBalanceChanges(t *testing.T) {
+	got, err := testTransferTransaction().SolBalanceChanges()
+	assert.Nil(t, err)
+	assert.Equal(t, []SolBalanceChange{
+		{Account: testAlice, Pre: 10000, Post: 4000, Change: -6000, Fee: 5000},
+		{Account: testBob, Pre: 0, Post: 500, Change: 500},
+		{Account: testCarol, Pre: 0, Post: 500, Change: 500},
+	}, got)
+
+	tx := testTransferTransaction()
+	tx.Meta.PostBalances = tx.Meta.PostBalances[:1]
+	_, err = tx.SolBalanceChanges()
+	assert.NotNil(t, err)
+}
+
+func TestTransaction_TokenBalanceChanges(t *testing.T) {
+	got, err := testTransferTransaction().TokenBalanceChanges()
+	assert.Nil(t, err)
+	assert.Equal(t, []TokenBalanceChange{
+		{Owner: testAlice, Mint: testMint, ProgramID: common.TokenProgramID, Decimals: 6, Pre: 100, Post: 90, Change: big.NewInt(-10)},
+		{Owner: testBob, Mint: testMint, ProgramID: common.TokenProgramID, Decimals: 6, Pre: 0, Post: 7, Change: big.NewInt(7)},
+		{Owner: testCarol, Mint: testMint, ProgramID: common.TokenProgramID, Decimals: 6, Pre: 0, Post: 3, Change: big.NewInt(3)},
+	}, got)
+
+	tx := testTransferTransaction()
+	tx.Meta.PostTokenBalances[0].UITokenAmount.Amount = "-1"
+	_, err = tx.TokenBalanceChanges()
+	assert.NotNil(t, err)
+
+	// a malformed key isn't turned into a wrong one
+	tx = testTransferTransaction()
+	tx.Meta.PostTokenBalances[0].Mint = "not-a-key"
+	_, err = tx.TokenBalanceChanges()
+	assert.ErrorIs(t, err, common.ErrInvalidPublicKey)
+
+	tx = testTransferTransaction()
+	tx.Meta.PreTokenBalances[1].Owner = testBob.ToBase58()[:20]
+	_, err = tx.TokenBalanceChanges()
+	assert.ErrorIs(t, err, common.ErrInvalidPublicKey)
+
+	tx = testTransferTransaction()
+	tx.Meta.PreTokenBalances[0].ProgramId = "0OIl"
+	_, err = tx.TokenBalanceChanges()
+	assert.ErrorIs(t, err, common.ErrInvalidPublicKey)
+}
+
+func TestTransaction_Transfers(t *testing.T) {
+	solTransfers, tokenTransfers, err := testTransferTransaction().Transfers()
+	assert.Nil(t, err)
+	assert.Equal(t, []SolTransfer{
+		{InstructionIndex: 0, From: testAlice, To: testBob, Amount: 1000},
+		{InstructionIndex: 2, InnerInstructionIndex: pointer.Get(0), From: testBob, To: testCarol, Amount: 500},
+	}, solTransfers)
+	assert.Equal(t, []TokenTransfer{
+		{
+			InstructionIndex: 1,
+			ProgramID:        common.TokenProgramID,
+			Mint:             testMint,
+			Source:           testAliceToken,
+			SourceOwner:      testAlice,
+			Destination:      testBobToken,
+			DestinationOwner: testBob,
+			Amount:           10,
+			Decimals:         6,
+		},
+		{
+			InstructionIndex:      2,
+			InnerInstructionIndex: pointer.Get(1),
+			ProgramID:             common.TokenProgramID,
+			Mint:                  testMint,
+			Source:                testBobToken,
+			SourceOwner:           testBob,
+			Destination:           testCarolToken,
+			DestinationOwner:      testCarol,
+			Amount:                3,
+			Decimals:              6,
+		},
+	}, tokenTransfers)
+}
+
+func TestTransaction_Transfers_Failed(t *testing.T) {
+	tx := testTransferTransaction()
+	tx.Meta.Err = &rpc.TransactionError{Kind: rpc.TransactionErrorInstructionError, InstructionIndex: 1, InstructionError: &rpc.InstructionError{Kind: rpc.InstructionErrorCustom}}
+	tx.Meta.PostBalances = []int64{5000, 0, 0, 1, 1, 1, 1, 1, 1, 1}
+	tx.Meta.PostTokenBalances = tx.Meta.PreTokenBalances
+
+	solTransfers, tokenTransfers, err := tx.Transfers()
+	assert.Nil(t, err)
+	assert.Empty(t, solTransfers)
+	assert.Empty(t, tokenTransfers)
+
+	changes, err := tx.SolBalanceChanges()
+	assert.Nil(t, err)
+	assert.Equal(t, []SolBalanceChange{{Account: testAlice, Pre: 10000, Post: 5000, Change: -5000, Fee: 5000}}, changes)
+
+	tx = testTransferTransaction()
+	tx.Transaction.Message.Instructions[0].Accounts = []int{0, 10}
+	_, _, err = tx.Transfers()
+	assert.NotNil(t, err)
+}
+
+func TestTransaction_Transfers_SystemInstructions(t *testing.T) {
+	newAccount, seedAccount, nonceAccount := testAliceToken, testBobToken, testCarolToken
+	message := types.NewMessage(types.NewMessageParam{
+		FeePayer: testAlice,
+		Instructions: []types.Instruction{
+			system.CreateAccount(system.CreateAccountParam{From: testAlice, New: newAccount, Owner: common.TokenProgramID, Lamports: 2039280, Space: 165}),
+			system.CreateAccountWithSeed(system.CreateAccountWithSeedParam{From: testAlice, New: seedAccount, Base: testAlice, Owner: common.StakeProgramID, Seed: "stake", Lamports: 3000000, Space: 200}),
+			system.WithdrawNonceAccount(system.WithdrawNonceAccountParam{Nonce: nonceAccount, Auth: testAlice, To: testBob, Amount: 1500000}),
+		},
+	})
+	tx := Transaction{
+		Transaction: types.Transaction{Message: message},
+		Meta:        &TransactionMeta{Fee: 5000},
+		AccountKeys: message.Accounts,
+	}
+
+	solTransfers, tokenTransfers, err := tx.Transfers()
+	assert.Nil(t, err)
+	assert.Equal(t, []SolTransfer{
+		{InstructionIndex: 0, From: testAlice, To: newAccount, Amount: 2039280},
+		{InstructionIndex: 1, From: testAlice, To: seedAccount, Amount: 3000000},
+		{InstructionIndex: 2, From: nonceAccount, To: testBob, Amount: 1500000},
+	}, solTransfers)
+	assert.Empty(t, tokenTransfers)
+}
@@ -0,0 +1,239 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/compute_budget"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+// MaxComputeUnitLimit is the max compute units a transaction can request
+const MaxComputeUnitLimit = 1_400_000
+
+const defaultBatchConcurrency = 4
+
+var ErrInstructionTooLarge = errors.New("instruction doesn't fit in a transaction")
+
+type BatchConfig struct {
+	FeePayer common.PublicKey
+	// AddressLookupTableAccounts makes the transactions v0, every transaction uses the tables which make it smallest
+	AddressLookupTableAccounts []types.AddressLookupTableAccount
+	// ComputeUnitsPerInstruction prepends a SetComputeUnitLimit of the sum of the instructions when it is set,
+	// the instructions of a transaction don't exceed MaxComputeUnitLimit
+	ComputeUnitsPerInstruction uint32
+	// ComputeUnitPrice prepends a SetComputeUnitPrice in micro-lamports when it is set
+	ComputeUnitPrice uint64
+	// MaxInstructions limits the instructions of a transaction, the compute budget ones aren't counted. 0 means no limit.
+	MaxInstructions int
+}
+
+// Batch is the message of the instructions [Start, End). its RecentBlockHash is zero.
+type Batch struct {
+	Start   int
+	End     int
+	Message types.Message
+}
+
+// BatchInstructions packs the instructions into the fewest messages in order. it returns ErrInstructionTooLarge
+// if an instruction can't fit in a transaction by itself.
+func BatchInstructions(instructions []types.Instruction, cfg BatchConfig) ([]Batch, error) {
+	maxInstructions := cfg.MaxInstructions
+	if cfg.ComputeUnitsPerInstruction > 0 {
+		n := int(MaxComputeUnitLimit / cfg.ComputeUnitsPerInstruction)
+		if n == 0 {
+			return nil, fmt.Errorf("%w: compute units per instruction exceeds %v", ErrInstructionTooLarge, MaxComputeUnitLimit)
+		}
+		if maxInstructions == 0 || n < maxInstructions {
+			maxInstructions = n
+		}
+	}
+
+	batches := []Batch{}
+	start := 0
+	var last types.Message
+	for i := range instructions {
+		if maxInstructions == 0 || i+1-start <= maxInstructions {
+			message, ok, err := cfg.newMessage(instructions[start : i+1])
+			if err != nil {
+				return nil, fmt.Errorf("instruction #%d: %w", i, err)
+			}
+			if ok {
+				last = message
+				continue
+			}
+		}
+		if i == start {
+			return nil, fmt.Errorf("%w: instruction #%d", ErrInstructionTooLarge, i)
+		}
+
+		batches = append(batches, cfg.finalize(instructions[start:i], start, last))
+		start = i
+		message, ok, err := cfg.newMessage(instructions[i : i+1])
+		if err != nil {
+			return nil, fmt.Errorf("instruction #%d: %w", i, err)
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: instruction #%d", ErrInstructionTooLarge, i)
+		}
+		last = message
+	}
+	if start < len(instructions) {
+		batches = append(batches, cfg.finalize(instructions[start:], start, last))
+	}
+	return batches, nil
+}
+
+func (cfg BatchConfig) withComputeBudget(instructions []types.Instruction) []types.Instruction {
+	result := make([]types.Instruction, 0, len(instructions)+2)
+	if cfg.ComputeUnitsPerInstruction > 0 {
+		result = append(result, compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{
+			Units: cfg.ComputeUnitsPerInstruction * uint32(len(instructions)),
+		}))
+	}
+	if cfg.ComputeUnitPrice > 0 {
+		result = append(result, compute_budget.SetComputeUnitPrice(compute_budget.SetComputeUnitPriceParam{
+			MicroLamports: cfg.ComputeUnitPrice,
+		}))
+	}
+	return append(result, instructions...)
+}
+
+// newMessage builds the message with every lookup table, it reports whether the message fits in a transaction
+func (cfg BatchConfig) newMessage(instructions []types.Instruction) (types.Message, bool, error) {
+	message := types.NewMessage(types.NewMessageParam{
+		FeePayer:                   cfg.FeePayer,
+		Instructions:               cfg.withComputeBudget(instructions),
+		AddressLookupTableAccounts: cfg.AddressLookupTableAccounts,
+	})
+	err := message.Validate()
+	switch {
+	case err == nil:
+		return message, true, nil
+	case errors.Is(err, types.ErrTransactionTooLarge), errors.Is(err, types.ErrTooManyAccounts):
+		return types.Message{}, false, nil
+	default:
+		return types.Message{}, false, err
+	}
+}
+
+// finalize drops the lookup tables which don't make the message smaller
+func (cfg BatchConfig) finalize(instructions []types.Instruction, start int, message types.Message) Batch {
+	if len(cfg.AddressLookupTableAccounts) > 0 {
+		m := NewV0MessageWithAddressLookupTables(types.NewMessageParam{
+			FeePayer:                   cfg.FeePayer,
+			Instructions:               cfg.withComputeBudget(instructions),
+			AddressLookupTableAccounts: cfg.AddressLookupTableAccounts,
+		})
+		if m.Size <= message.EstimateSize() {
+			message = m.Message
+		}
+	}
+	return Batch{Start: start, End: start + len(instructions), Message: message}
+}
+
+type SendBatchesConfig struct {
+	Batch BatchConfig
+	// Signers sign the transactions, each transaction is signed by the ones it needs. the fee payer should be in it.
+	Signers []types.Account
+	// Concurrency is the max number of transactions which are sent at the same time, default: 4
+	Concurrency int
+	Send        SendTransactionConfig
+	// Confirm waits for every transaction when it is set, LastValidBlockHeight is filled from the blockhash if it is 0
+	Confirm *ConfirmTransactionConfig
+}
+
+// BatchResult is the result of a transaction, Err is set if it failed to be signed, sent or confirmed
+type BatchResult struct {
+	Start       int
+	End         int
+	Transaction types.Transaction
+	Signature   string
+	Err         error
+}
+
+// SendInstructionsInBatches packs the instructions into transactions by BatchInstructions and sends them.
+// each transaction gets a fresh blockhash and is signed right before it is sent, so the batches which wait
+// for the concurrency don't expire. the error is only for the packing, the result of each transaction is
+// in its BatchResult.
+func (c *Client) SendInstructionsInBatches(ctx context.Context, instructions []types.Instruction, cfg SendBatchesConfig) ([]BatchResult, error) {
+	batches, err := BatchInstructions(instructions, cfg.Batch)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make(map[common.PublicKey]types.Account, len(cfg.Signers))
+	for _, signer := range cfg.Signers {
+		signers[signer.PublicKey] = signer
+	}
+
+	concurrency := cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	results := make([]BatchResult, len(batches))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, batch := range batches {
+		results[i] = BatchResult{Start: batch.Start, End: batch.End}
+
+		message := batch.Message
+		accounts := make([]types.Account, 0, message.Header.NumRequireSignatures)
+		for _, pubkey := range message.Accounts[:message.Header.NumRequireSignatures] {
+			signer, ok := signers[pubkey]
+			if !ok {
+				results[i].Err = fmt.Errorf("missing signer %v", pubkey)
+				break
+			}
+			accounts = append(accounts, signer)
+		}
+		if results[i].Err != nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *BatchResult) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				result.Err = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+
+			latest, err := c.GetLatestBlockhash(ctx)
+			if err != nil {
+				result.Err = fmt.Errorf("failed to get latest blockhash, err: %w", err)
+				return
+			}
+			message.RecentBlockHash = latest.Blockhash
+			tx, err := types.NewTransaction(types.NewTransactionParam{Message: message, Signers: accounts})
+			if err != nil {
+				result.Err = fmt.Errorf("failed to sign transaction, err: %w", err)
+				return
+			}
+			result.Transaction = tx
+
+			if cfg.Confirm == nil {
+				result.Signature, result.Err = c.SendTransactionWithConfig(ctx, tx, cfg.Send)
+				return
+			}
+			confirm := *cfg.Confirm
+			if confirm.NonceAccount == nil && confirm.LastValidBlockHeight == 0 {
+				confirm.LastValidBlockHeight = latest.LatestValidBlockHeight
+			}
+			result.Signature, result.Err = c.SendAndConfirmTransaction(ctx, tx, SendAndConfirmTransactionConfig{
+				Send:    cfg.Send,
+				Confirm: confirm,
+			})
+		}(&results[i])
+	}
+	wg.Wait()
+
+	return results, nil
+}
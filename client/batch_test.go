@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/compute_budget"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/rpc/rpctest"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTransfers(from common.PublicKey, n int, amount uint64) ([]common.PublicKey, []types.Instruction) {
+	recipients := make([]common.PublicKey, 0, n)
+	instructions := make([]types.Instruction, 0, n)
+	for i := 0; i < n; i++ {
+		recipient := types.NewAccount().PublicKey
+		recipients = append(recipients, recipient)
+		instructions = append(instructions, system.Transfer(system.TransferParam{From: from, To: recipient, Amount: amount}))
+	}
+	return recipients, instructions
+}
+
+func TestBatchInstructions(t *testing.T) {
+	feePayer := types.NewAccount().PublicKey
+	_, instructions := testTransfers(feePayer, 100, 1)
+
+	tests := []struct {
+		name          string
+		cfg           BatchConfig
+		wantBatches   int
+		wantPerBatch  int
+		wantPrepended int
+	}{
+		{
+			name:         "packet size",
+			cfg:          BatchConfig{FeePayer: feePayer},
+			wantBatches:  5,
+			wantPerBatch: 21,
+		},
+		{
+			name:         "max instructions",
+			cfg:          BatchConfig{FeePayer: feePayer, MaxInstructions: 10},
+			wantBatches:  10,
+			wantPerBatch: 10,
+		},
+		{
+			name:          "compute budget",
+			cfg:           BatchConfig{FeePayer: feePayer, ComputeUnitsPerInstruction: 150_000, ComputeUnitPrice: 1000},
+			wantBatches:   12,
+			wantPerBatch:  9,
+			wantPrepended: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batches, err := BatchInstructions(instructions, tt.cfg)
+			assert.Nil(t, err)
+			assert.Len(t, batches, tt.wantBatches)
+			assert.Equal(t, tt.wantPerBatch, batches[0].End-batches[0].Start)
+			assert.Equal(t, 100, batches[len(batches)-1].End)
+			for i, batch := range batches {
+				if i > 0 {
+					assert.Equal(t, batches[i-1].End, batch.Start)
+				}
+				assert.Nil(t, batch.Message.Validate())
+				assert.Len(t, batch.Message.Instructions, batch.End-batch.Start+tt.wantPrepended)
+			}
+		})
+	}
+
+	// the limit covers the instructions of the batch
+	batches, err := BatchInstructions(instructions[:3], BatchConfig{FeePayer: feePayer, ComputeUnitsPerInstruction: 300})
+	assert.Nil(t, err)
+	assert.Equal(t, compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{Units: 900}).Data, batches[0].Message.Instructions[0].Data)
+
+	_, err = BatchInstructions([]types.Instruction{instructions[0], {ProgramID: common.MemoProgramID, Data: make([]byte, types.PacketDataSize)}}, BatchConfig{FeePayer: feePayer})
+	assert.True(t, errors.Is(err, ErrInstructionTooLarge), err)
+	assert.Contains(t, err.Error(), "instruction #1")
+
+	_, err = BatchInstructions(instructions, BatchConfig{})
+	assert.True(t, errors.Is(err, types.ErrMissingFeePayer), err)
+}
+
+func TestBatchInstructions_AddressLookupTables(t *testing.T) {
+	feePayer := types.NewAccount().PublicKey
+	recipients, instructions := testTransfers(feePayer, 100, 1)
+
+	batches, err := BatchInstructions(instructions, BatchConfig{
+		FeePayer: feePayer,
+		AddressLookupTableAccounts: []types.AddressLookupTableAccount{
+			{Key: types.NewAccount().PublicKey, Addresses: recipients},
+			{Key: types.NewAccount().PublicKey, Addresses: []common.PublicKey{types.NewAccount().PublicKey}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, batches, 2)
+	for _, batch := range batches {
+		assert.Nil(t, batch.Message.Validate())
+		assert.Len(t, batch.Message.AddressLookupTables, 1)
+	}
+}
+
+func TestClient_SendInstructionsInBatches(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := NewClient(s.URL)
+
+	feePayer, sender := types.NewAccount(), types.NewAccount()
+	s.Airdrop(feePayer.PublicKey, 1_000_000_000)
+	s.Airdrop(sender.PublicKey, 1_000_000_000)
+	amount := rpctest.MinimumBalanceForRentExemption(0)
+	recipients, instructions := testTransfers(sender.PublicKey, 50, amount)
+
+	results, err := c.SendInstructionsInBatches(context.Background(), instructions, SendBatchesConfig{
+		Batch:       BatchConfig{FeePayer: feePayer.PublicKey, ComputeUnitPrice: 1},
+		Signers:     []types.Account{feePayer, sender},
+		Concurrency: 2,
+		Confirm:     &ConfirmTransactionConfig{PollInterval: time.Millisecond},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, results, 3)
+	for _, result := range results {
+		assert.Nil(t, result.Err)
+		assert.NotEmpty(t, result.Signature)
+	}
+	for _, recipient := range recipients {
+		assert.Equal(t, amount, s.GetBalance(recipient))
+	}
+
+	// every batch takes the blockhash right before it is sent, a landed transaction makes a new block
+	recipients, instructions = testTransfers(sender.PublicKey, 50, amount)
+	results, err = c.SendInstructionsInBatches(context.Background(), instructions, SendBatchesConfig{
+		Batch:       BatchConfig{FeePayer: feePayer.PublicKey},
+		Signers:     []types.Account{feePayer, sender},
+		Concurrency: 1,
+		Confirm:     &ConfirmTransactionConfig{PollInterval: time.Millisecond},
+	})
+	assert.Nil(t, err)
+	blockhashes := map[common.Hash]bool{}
+	for _, result := range results {
+		assert.Nil(t, result.Err)
+		blockhashes[result.Transaction.Message.RecentBlockHash] = true
+	}
+	assert.Len(t, blockhashes, len(results))
+	for _, recipient := range recipients {
+		assert.Equal(t, amount, s.GetBalance(recipient))
+	}
+
+	results, err = c.SendInstructionsInBatches(context.Background(), instructions[:1], SendBatchesConfig{
+		Batch:   BatchConfig{FeePayer: feePayer.PublicKey},
+		Signers: []types.Account{feePayer},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.NotNil(t, results[0].Err)
+	assert.Empty(t, results[0].Signature)
+}
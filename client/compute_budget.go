@@ -0,0 +1,69 @@
+package client
+
+import (
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/compute_budget"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+// WithComputeUnitPrice returns a copy of the message whose SetComputeUnitPrice is replaced or prepended.
+// the message should be signed again after it.
+func WithComputeUnitPrice(message types.Message, microLamports uint64) types.Message {
+	return withComputeBudgetInstruction(message, compute_budget.InstructionSetComputeUnitPrice, compute_budget.SetComputeUnitPrice(compute_budget.SetComputeUnitPriceParam{
+		MicroLamports: microLamports,
+	}).Data)
+}
+
+// withComputeBudgetInstruction replaces the data of the compute budget instruction or prepends one.
+// the compute budget program is added as the last static account if the message doesn't have it,
+// the indexes of the accounts from lookup tables move one after it.
+func withComputeBudgetInstruction(message types.Message, instruction compute_budget.Instruction, data []byte) types.Message {
+	m := message
+	m.Accounts = append([]common.PublicKey{}, message.Accounts...)
+	m.Instructions = append([]types.CompiledInstruction{}, message.Instructions...)
+
+	programIDIndex := -1
+	for i, account := range m.Accounts {
+		if account == common.ComputeBudgetProgramID {
+			programIDIndex = i
+			break
+		}
+	}
+	if programIDIndex >= 0 {
+		for i, ins := range m.Instructions {
+			if ins.ProgramIDIndex == programIDIndex && len(ins.Data) > 0 && compute_budget.Instruction(ins.Data[0]) == instruction {
+				m.Instructions[i].Data = data
+				return m
+			}
+		}
+	} else {
+		programIDIndex = len(m.Accounts)
+		m.Accounts = append(m.Accounts, common.ComputeBudgetProgramID)
+		m.Header.NumReadonlyUnsignedAccounts++
+		for i, ins := range m.Instructions {
+			accounts := make([]int, 0, len(ins.Accounts))
+			for _, idx := range ins.Accounts {
+				if idx >= programIDIndex {
+					idx++
+				}
+				accounts = append(accounts, idx)
+			}
+			m.Instructions[i].Accounts = accounts
+		}
+	}
+
+	m.Instructions = append([]types.CompiledInstruction{{
+		ProgramIDIndex: programIDIndex,
+		Accounts:       []int{},
+		Data:           data,
+	}}, m.Instructions...)
+	return m
+}
+
+// WithComputeUnitLimit returns a copy of the message whose SetComputeUnitLimit is replaced or prepended.
+// the message should be signed again after it.
+func WithComputeUnitLimit(message types.Message, units uint32) types.Message {
+	return withComputeBudgetInstruction(message, compute_budget.InstructionSetComputeUnitLimit, compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{
+		Units: units,
+	}).Data)
+}
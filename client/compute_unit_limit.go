@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+const defaultComputeUnitMargin = 0.1
+
+type ComputeUnitLimitConfig struct {
+	// Margin is the ratio added on top of the consumed units, default: 0.1
+	Margin float64
+	// MinUnits is the least limit which is set
+	MinUnits   uint32
+	Commitment rpc.Commitment
+}
+
+// EstimateComputeUnits simulates the message with the max compute unit limit and returns the consumed units.
+// the message doesn't need to be signed or have a blockhash. it returns a *SimulationFailedError if the simulation failed.
+func (c *Client) EstimateComputeUnits(ctx context.Context, message types.Message, commitment rpc.Commitment) (uint64, error) {
+	message = WithComputeUnitLimit(message, MaxComputeUnitLimit)
+	signatures := make([]types.Signature, 0, message.Header.NumRequireSignatures)
+	for i := uint8(0); i < message.Header.NumRequireSignatures; i++ {
+		signatures = append(signatures, types.Signature{})
+	}
+
+	result, err := c.SimulateTransactionWithConfig(ctx, types.Transaction{Signatures: signatures, Message: message}, SimulateTransactionConfig{
+		Commitment:             commitment,
+		ReplaceRecentBlockhash: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := result.TransactionError(); err != nil {
+		return 0, err
+	}
+	if result.UnitConsumed == nil {
+		return 0, errors.New("the node didn't return the consumed units")
+	}
+	return *result.UnitConsumed, nil
+}
+
+// WithEstimatedComputeUnitLimit sets the SetComputeUnitLimit of the message to the consumed units in a simulation
+// plus the margin. it returns the new message and the limit.
+func (c *Client) WithEstimatedComputeUnitLimit(ctx context.Context, message types.Message, cfg ComputeUnitLimitConfig) (types.Message, uint32, error) {
+	unitsConsumed, err := c.EstimateComputeUnits(ctx, message, cfg.Commitment)
+	if err != nil {
+		return types.Message{}, 0, err
+	}
+
+	margin := cfg.Margin
+	if margin == 0 {
+		margin = defaultComputeUnitMargin
+	}
+	units := uint32(MaxComputeUnitLimit)
+	if v := math.Ceil(float64(unitsConsumed) * (1 + margin)); v < MaxComputeUnitLimit {
+		units = uint32(v)
+	}
+	if units < cfg.MinUnits {
+		units = cfg.MinUnits
+	}
+	return WithComputeUnitLimit(message, units), units, nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/blocto/solana-go-sdk/program/compute_budget"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/rpc/rpctest"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_WithEstimatedComputeUnitLimit(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := NewClient(s.URL)
+	ctx := context.Background()
+
+	feePayer, to := types.NewAccount(), types.NewAccount().PublicKey
+	s.Airdrop(feePayer.PublicKey, 1_000_000_000)
+	transferMessage := func(amount uint64, instructions ...types.Instruction) types.Message {
+		return types.NewMessage(types.NewMessageParam{
+			FeePayer:     feePayer.PublicKey,
+			Instructions: append(instructions, system.Transfer(system.TransferParam{From: feePayer.PublicKey, To: to, Amount: amount})),
+		})
+	}
+	limit := func(units uint32) types.Instruction {
+		return compute_budget.SetComputeUnitLimit(compute_budget.SetComputeUnitLimitParam{Units: units})
+	}
+	amount := rpctest.MinimumBalanceForRentExemption(0)
+
+	unitsConsumed, err := c.EstimateComputeUnits(ctx, transferMessage(amount), "")
+	assert.Nil(t, err)
+	assert.NotZero(t, unitsConsumed)
+
+	// the limit is prepended and the transaction lands
+	message, units, err := c.WithEstimatedComputeUnitLimit(ctx, transferMessage(amount), ComputeUnitLimitConfig{Margin: 0.5})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(math.Ceil(float64(unitsConsumed)*1.5)), units)
+	assert.Equal(t, []types.Instruction{limit(units), system.Transfer(system.TransferParam{From: feePayer.PublicKey, To: to, Amount: amount})}, message.DecompileInstructions())
+
+	latest, err := c.GetLatestBlockhash(ctx)
+	assert.Nil(t, err)
+	message.RecentBlockHash = latest.Blockhash
+	tx, err := types.NewTransaction(types.NewTransactionParam{Message: message, Signers: []types.Account{feePayer}})
+	assert.Nil(t, err)
+	_, err = c.SendAndConfirmTransaction(ctx, tx, SendAndConfirmTransactionConfig{
+		Confirm: ConfirmTransactionConfig{LastValidBlockHeight: latest.LatestValidBlockHeight, PollInterval: time.Millisecond},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, amount, s.GetBalance(to))
+
+	// an existing limit is replaced, MinUnits is the floor
+	message, units, err = c.WithEstimatedComputeUnitLimit(ctx, transferMessage(amount, limit(1)), ComputeUnitLimitConfig{MinUnits: 50_000})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(50_000), units)
+	assert.Len(t, message.Instructions, 2)
+	assert.Equal(t, limit(50_000).Data, message.Instructions[0].Data)
+
+	// the failure of the simulation is decoded
+	_, _, err = c.WithEstimatedComputeUnitLimit(ctx, transferMessage(10_000_000_000), ComputeUnitLimitConfig{})
+	var simulationErr *SimulationFailedError
+	assert.True(t, errors.As(err, &simulationErr), err)
+	assert.NotEmpty(t, simulationErr.Logs)
+	assert.ErrorIs(t, simulationErr.CustomError, system.ErrResultWithNegativeLamports)
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+const defaultConfirmPollInterval = 2 * time.Second
+
+// BlockHeightExceededError means the blockhash of the transaction expired before it reached the commitment
+type BlockHeightExceededError struct {
+	Signature            string
+	LastValidBlockHeight uint64
+	BlockHeight          uint64
+}
+
+func (e *BlockHeightExceededError) Error() string {
+	return fmt.Sprintf("transaction %v expired, block height %v exceeded last valid block height %v", e.Signature, e.BlockHeight, e.LastValidBlockHeight)
+}
+
+// NonceAdvancedError means the nonce which a durable nonce transaction used has been advanced
+// before the transaction reached the commitment
+type NonceAdvancedError struct {
+	Signature    string
+	NonceAccount common.PublicKey
+	Nonce        string
+	CurrentNonce string
+}
+
+func (e *NonceAdvancedError) Error() string {
+	return fmt.Sprintf("transaction %v expired, nonce account %v advanced from %v to %v", e.Signature, e.NonceAccount, e.Nonce, e.CurrentNonce)
+}
+
+// TransactionFailedError means the transaction landed but failed
+type TransactionFailedError struct {
+	Signature string
+	Slot      uint64
+	Err       *rpc.TransactionError
+}
+
+func (e *TransactionFailedError) Error() string {
+	return fmt.Sprintf("transaction %v failed at slot %v, err: %v", e.Signature, e.Slot, e.Err)
+}
+
+func (e *TransactionFailedError) Unwrap() error {
+	if e.Err == nil {
+		return nil
+	}
+	return e.Err
+}
+
+type ConfirmTransactionConfig struct {
+	// Commitment is the level the transaction should reach, default: finalized
+	Commitment rpc.Commitment
+
+	// LastValidBlockHeight comes from GetLatestBlockhash. the transaction is regarded as expired
+	// once the block height exceeds it.
+	LastValidBlockHeight uint64
+
+	// NonceAccount and Nonce are used for durable nonce transactions instead of LastValidBlockHeight.
+	// the transaction is regarded as expired once the nonce stored in the account is no longer Nonce.
+	NonceAccount *common.PublicKey
+	Nonce        string
+
+	// PollInterval is the interval between two status checks, default: 2s
+	PollInterval time.Duration
+}
+
+// ConfirmTransaction waits until the signature reaches the commitment.
+// it returns a *BlockHeightExceededError or a *NonceAdvancedError when the transaction expires before it lands
+// and a *TransactionFailedError when the transaction failed.
+func (c *Client) ConfirmTransaction(ctx context.Context, signature string, cfg ConfirmTransactionConfig) (*rpc.SignatureStatus, error) {
+	if cfg.NonceAccount == nil && cfg.LastValidBlockHeight == 0 {
+		return nil, errors.New("either LastValidBlockHeight or NonceAccount is required")
+	}
+	if cfg.NonceAccount != nil && cfg.Nonce == "" {
+		return nil, errors.New("nonce is required for a durable nonce transaction")
+	}
+	commitment := cfg.Commitment
+	if commitment == "" {
+		commitment = rpc.CommitmentFinalized
+	}
+	pollInterval := cfg.PollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultConfirmPollInterval
+	}
+
+	for {
+		status, done, err := c.checkSignatureStatus(ctx, signature, commitment)
+		if done || err != nil {
+			return status, err
+		}
+
+		// a landed transaction only waits for the commitment. the blockhash or the nonce it used
+		// expiring afterwards, or its own nonce advance, doesn't make it fail.
+		if status == nil {
+			err = c.checkTransactionExpiry(ctx, signature, cfg)
+			if isTransactionExpiredError(err) {
+				// the transaction may land in the same slot the expiry is observed
+				status, done, checkErr := c.checkSignatureStatus(ctx, signature, commitment)
+				if done || checkErr != nil {
+					return status, checkErr
+				}
+				if status == nil {
+					return nil, err
+				}
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (c *Client) checkSignatureStatus(ctx context.Context, signature string, commitment rpc.Commitment) (*rpc.SignatureStatus, bool, error) {
+	status, err := c.GetSignatureStatus(ctx, signature)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get signature status, err: %v", err)
+	}
+	if status == nil {
+		return nil, false, nil
+	}
+	if status.Err != nil {
+		return status, true, &TransactionFailedError{
+			Signature: signature,
+			Slot:      status.Slot,
+			Err:       status.Err,
+		}
+	}
+	if status.ConfirmationStatus == nil || !commitmentReached(*status.ConfirmationStatus, commitment) {
+		return status, false, nil
+	}
+	return status, true, nil
+}
+
+func isTransactionExpiredError(err error) bool {
+	var blockHeightExceededErr *BlockHeightExceededError
+	var nonceAdvancedErr *NonceAdvancedError
+	return errors.As(err, &blockHeightExceededErr) || errors.As(err, &nonceAdvancedErr)
+}
+
+func (c *Client) checkTransactionExpiry(ctx context.Context, signature string, cfg ConfirmTransactionConfig) error {
+	if cfg.NonceAccount != nil {
+		nonceAccount, err := c.GetNonceAccount(ctx, cfg.NonceAccount.ToBase58())
+		if err != nil {
+			return fmt.Errorf("failed to get nonce account, err: %v", err)
+		}
+		if currentNonce := nonceAccount.Nonce.ToBase58(); currentNonce != cfg.Nonce {
+			return &NonceAdvancedError{
+				Signature:    signature,
+				NonceAccount: *cfg.NonceAccount,
+				Nonce:        cfg.Nonce,
+				CurrentNonce: currentNonce,
+			}
+		}
+		return nil
+	}
+
+	blockHeight, err := c.GetBlockHeightWithConfig(ctx, GetBlockHeightConfig{Commitment: rpc.CommitmentConfirmed})
+	if err != nil {
+		return fmt.Errorf("failed to get block height, err: %v", err)
+	}
+	if blockHeight > cfg.LastValidBlockHeight {
+		return &BlockHeightExceededError{
+			Signature:            signature,
+			LastValidBlockHeight: cfg.LastValidBlockHeight,
+			BlockHeight:          blockHeight,
+		}
+	}
+	return nil
+}
+
+func commitmentReached(current, target rpc.Commitment) bool {
+	level := func(c rpc.Commitment) int {
+		switch c {
+		case rpc.CommitmentProcessed:
+			return 1
+		case rpc.CommitmentConfirmed:
+			return 2
+		case rpc.CommitmentFinalized:
+			return 3
+		}
+		return 0
+	}
+	return level(current) >= level(target)
+}
+
+type SendAndConfirmTransactionConfig struct {
+	Send    SendTransactionConfig
+	Confirm ConfirmTransactionConfig
+}
+
+// SendAndConfirmTransaction sends the transaction and waits until it reaches the commitment. see ConfirmTransaction
+// for the returned errors. the signature is returned as long as the transaction was sent.
+func (c *Client) SendAndConfirmTransaction(ctx context.Context, tx types.Transaction, cfg SendAndConfirmTransactionConfig) (string, error) {
+	sig, err := c.SendTransactionWithConfig(ctx, tx, cfg.Send)
+	if err != nil {
+		return "", err
+	}
+	_, err = c.ConfirmTransaction(ctx, sig, cfg.Confirm)
+	return sig, err
+}
@@ -0,0 +1,232 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/internal/client_test"
+	"github.com/blocto/solana-go-sdk/pkg/pointer"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ConfirmTransaction(t *testing.T) {
+	const sig = "3E6jD48LnMeNDs1QTXXunXGaqYybZKHXYdriDwqXGJbCXzVkMZNexuiGnTtUSba7PcmbKcsxKsAcBKLSmqjUKDRg"
+	const getSignatureStatusesRequest = `{"jsonrpc":"2.0", "id":1, "method":"getSignatureStatuses", "params":[["3E6jD48LnMeNDs1QTXXunXGaqYybZKHXYdriDwqXGJbCXzVkMZNexuiGnTtUSba7PcmbKcsxKsAcBKLSmqjUKDRg"]]}`
+	const getBlockHeightRequest = `{"jsonrpc":"2.0", "id":1, "method":"getBlockHeight", "params":[{"commitment":"confirmed"}]}`
+	const getNonceAccountRequest = `{"jsonrpc":"2.0", "id":1, "method":"getAccountInfo", "params":["9rCXBmEPEKmRq4ypKYcL6NcNJtjmZgGC8VSJYRmWKDFs", {"encoding": "base64"}]}`
+	const notFoundResponse = `{"jsonrpc":"2.0","result":{"context":{"slot":86136583},"value":[null]},"id":1}`
+	const processedResponse = `{"jsonrpc":"2.0","result":{"context":{"slot":86136583},"value":[{"confirmationStatus":"processed","confirmations":0,"err":null,"slot":86136551,"status":{"Ok":null}}]},"id":1}`
+	const confirmedResponse = `{"jsonrpc":"2.0","result":{"context":{"slot":86136583},"value":[{"confirmationStatus":"confirmed","confirmations":25,"err":null,"slot":86136551,"status":{"Ok":null}}]},"id":1}`
+	const nonceAccountResponse = `{"jsonrpc":"2.0","result":{"context":{"slot":86136583},"value":{"data":["AQAAAAEAAAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4fIAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHiBMAAAAAAAA=","base64"],"executable":false,"lamports":1447680,"owner":"11111111111111111111111111111111","rentEpoch":0}},"id":1}`
+
+	nonceAccount := common.PublicKeyFromString("9rCXBmEPEKmRq4ypKYcL6NcNJtjmZgGC8VSJYRmWKDFs")
+
+	client_test.TestAllSequence(
+		t,
+		[]client_test.SequenceParam{
+			{
+				Name: "confirmed",
+				RequestBodies: []string{
+					getSignatureStatusesRequest,
+					getBlockHeightRequest,
+					getSignatureStatusesRequest,
+					getSignatureStatusesRequest,
+				},
+				ResponseBodies: []string{
+					notFoundResponse,
+					`{"jsonrpc":"2.0","result":100,"id":1}`,
+					// the expiry isn't checked after the transaction landed
+					processedResponse,
+					confirmedResponse,
+				},
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.ConfirmTransaction(context.Background(), sig, ConfirmTransactionConfig{
+						Commitment:           rpc.CommitmentConfirmed,
+						LastValidBlockHeight: 150,
+						PollInterval:         time.Millisecond,
+					})
+				},
+				ExpectedValue: &rpc.SignatureStatus{
+					ConfirmationStatus: (*rpc.Commitment)(pointer.Get(string(rpc.CommitmentConfirmed))),
+					Confirmations:      pointer.Get[uint64](25),
+					Err:                nil,
+					Slot:               86136551,
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name: "block height exceeded",
+				RequestBodies: []string{
+					getSignatureStatusesRequest,
+					getBlockHeightRequest,
+					getSignatureStatusesRequest,
+				},
+				ResponseBodies: []string{
+					notFoundResponse,
+					`{"jsonrpc":"2.0","result":151,"id":1}`,
+					notFoundResponse,
+				},
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.ConfirmTransaction(context.Background(), sig, ConfirmTransactionConfig{
+						LastValidBlockHeight: 150,
+						PollInterval:         time.Millisecond,
+					})
+				},
+				ExpectedValue: (*rpc.SignatureStatus)(nil),
+				ExpectedError: &BlockHeightExceededError{
+					Signature:            sig,
+					LastValidBlockHeight: 150,
+					BlockHeight:          151,
+				},
+			},
+			{
+				Name: "landed right before the block height exceeded",
+				RequestBodies: []string{
+					getSignatureStatusesRequest,
+					getBlockHeightRequest,
+					getSignatureStatusesRequest,
+					getSignatureStatusesRequest,
+				},
+				ResponseBodies: []string{
+					notFoundResponse,
+					`{"jsonrpc":"2.0","result":151,"id":1}`,
+					processedResponse,
+					confirmedResponse,
+				},
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.ConfirmTransaction(context.Background(), sig, ConfirmTransactionConfig{
+						Commitment:           rpc.CommitmentConfirmed,
+						LastValidBlockHeight: 150,
+						PollInterval:         time.Millisecond,
+					})
+				},
+				ExpectedValue: &rpc.SignatureStatus{
+					ConfirmationStatus: (*rpc.Commitment)(pointer.Get(string(rpc.CommitmentConfirmed))),
+					Confirmations:      pointer.Get[uint64](25),
+					Err:                nil,
+					Slot:               86136551,
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name: "failed",
+				RequestBodies: []string{
+					getSignatureStatusesRequest,
+				},
+				ResponseBodies: []string{
+					`{"jsonrpc":"2.0","result":{"context":{"slot":86136583},"value":[{"confirmationStatus":"confirmed","confirmations":25,"err":{"InstructionError":[0,{"Custom":1}]},"slot":86136551,"status":{"Err":{"InstructionError":[0,{"Custom":1}]}}}]},"id":1}`,
+				},
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					_, err := c.ConfirmTransaction(context.Background(), sig, ConfirmTransactionConfig{
+						LastValidBlockHeight: 150,
+					})
+					return nil, err
+				},
+				ExpectedValue: nil,
+				ExpectedError: &TransactionFailedError{
+					Signature: sig,
+					Slot:      86136551,
+					Err:       &rpc.TransactionError{Kind: rpc.TransactionErrorInstructionError, InstructionError: &rpc.InstructionError{Kind: rpc.InstructionErrorCustom, Code: 1}},
+				},
+			},
+			{
+				Name: "durable nonce confirmed",
+				RequestBodies: []string{
+					getSignatureStatusesRequest,
+					getNonceAccountRequest,
+					getSignatureStatusesRequest,
+				},
+				ResponseBodies: []string{
+					notFoundResponse,
+					nonceAccountResponse,
+					confirmedResponse,
+				},
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.ConfirmTransaction(context.Background(), sig, ConfirmTransactionConfig{
+						Commitment:   rpc.CommitmentConfirmed,
+						NonceAccount: &nonceAccount,
+						Nonce:        "US517G5965aydkZ46HS38QLi7UQiSojurfbQfKCELFx",
+						PollInterval: time.Millisecond,
+					})
+				},
+				ExpectedValue: &rpc.SignatureStatus{
+					ConfirmationStatus: (*rpc.Commitment)(pointer.Get(string(rpc.CommitmentConfirmed))),
+					Confirmations:      pointer.Get[uint64](25),
+					Err:                nil,
+					Slot:               86136551,
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name: "durable nonce advanced by the landed transaction",
+				RequestBodies: []string{
+					getSignatureStatusesRequest,
+					getSignatureStatusesRequest,
+				},
+				ResponseBodies: []string{
+					processedResponse,
+					confirmedResponse,
+				},
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.ConfirmTransaction(context.Background(), sig, ConfirmTransactionConfig{
+						Commitment:   rpc.CommitmentConfirmed,
+						NonceAccount: &nonceAccount,
+						Nonce:        "4wBqpZM9xaSheZzJSMawUKKwhdpChKbZ5eu5ky4Vigw",
+						PollInterval: time.Millisecond,
+					})
+				},
+				ExpectedValue: &rpc.SignatureStatus{
+					ConfirmationStatus: (*rpc.Commitment)(pointer.Get(string(rpc.CommitmentConfirmed))),
+					Confirmations:      pointer.Get[uint64](25),
+					Err:                nil,
+					Slot:               86136551,
+				},
+				ExpectedError: nil,
+			},
+			{
+				Name: "durable nonce advanced",
+				RequestBodies: []string{
+					getSignatureStatusesRequest,
+					getNonceAccountRequest,
+					getSignatureStatusesRequest,
+				},
+				ResponseBodies: []string{
+					notFoundResponse,
+					nonceAccountResponse,
+					notFoundResponse,
+				},
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.ConfirmTransaction(context.Background(), sig, ConfirmTransactionConfig{
+						NonceAccount: &nonceAccount,
+						Nonce:        "4wBqpZM9xaSheZzJSMawUKKwhdpChKbZ5eu5ky4Vigw",
+						PollInterval: time.Millisecond,
+					})
+				},
+				ExpectedValue: (*rpc.SignatureStatus)(nil),
+				ExpectedError: &NonceAdvancedError{
+					Signature:    sig,
+					NonceAccount: nonceAccount,
+					Nonce:        "4wBqpZM9xaSheZzJSMawUKKwhdpChKbZ5eu5ky4Vigw",
+					CurrentNonce: "US517G5965aydkZ46HS38QLi7UQiSojurfbQfKCELFx",
+				},
+			},
+		},
+	)
+}
+
+func TestClient_ConfirmTransaction_MissingExpiry(t *testing.T) {
+	c := NewClient(rpc.LocalnetRPCEndpoint)
+	_, err := c.ConfirmTransaction(context.Background(), "sig", ConfirmTransactionConfig{})
+	assert.Equal(t, errors.New("either LastValidBlockHeight or NonceAccount is required"), err)
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/blocto/solana-go-sdk/rpc"
+)
+
+// MaxMultipleAccounts is the max number of addresses the node takes in a getMultipleAccounts
+const MaxMultipleAccounts = 100
+
+const defaultMultipleAccountsConcurrency = 4
+
+type GetMultipleAccountsInPagesConfig struct {
+	Commitment rpc.Commitment
+	DataSlice  *rpc.DataSlice
+	// MinContextSlot is the min slot every page is read at, default: the context slot of the first page
+	MinContextSlot *uint64
+	// Concurrency is the max number of pages which are fetched at the same time, default: 4
+	Concurrency int
+}
+
+// GetMultipleAccountsInPages fetches any number of accounts in pages of MaxMultipleAccounts addresses.
+// the accounts are in the order of addrs and the missing ones are nil.
+func (c *Client) GetMultipleAccountsInPages(ctx context.Context, addrs []string, cfg GetMultipleAccountsInPagesConfig) ([]*AccountInfo, error) {
+	v, err := c.GetMultipleAccountsInPagesAndContext(ctx, addrs, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return v.Value, nil
+}
+
+// GetMultipleAccountsInPagesAndContext fetches any number of accounts in pages of MaxMultipleAccounts addresses.
+// every page is read at or after the slot of the first page, so the pages aren't a snapshot of one slot
+// unless the context slot, which is the highest one of the pages, equals the slot of the first page.
+func (c *Client) GetMultipleAccountsInPagesAndContext(ctx context.Context, addrs []string, cfg GetMultipleAccountsInPagesConfig) (rpc.ValueWithContext[[]*AccountInfo], error) {
+	accounts := make([]*AccountInfo, len(addrs))
+	if len(addrs) == 0 {
+		return rpc.ValueWithContext[[]*AccountInfo]{Value: accounts}, nil
+	}
+
+	pageCfg := GetMultipleAccountsConfig{
+		Commitment:     cfg.Commitment,
+		DataSlice:      cfg.DataSlice,
+		MinContextSlot: cfg.MinContextSlot,
+	}
+	var maxSlot uint64
+	start := 0
+	if pageCfg.MinContextSlot == nil {
+		// the first page decides the min slot of the others
+		end := pageEnd(start, len(addrs))
+		slot, err := c.getMultipleAccountsPage(ctx, addrs, start, end, pageCfg, accounts)
+		if err != nil {
+			return rpc.ValueWithContext[[]*AccountInfo]{}, err
+		}
+		pageCfg.MinContextSlot = &slot
+		maxSlot = slot
+		start = end
+	}
+
+	concurrency := cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultMultipleAccountsConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var firstErr error
+	var once sync.Once
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for ; start < len(addrs); start = pageEnd(start, len(addrs)) {
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				fail(ctx.Err())
+				return
+			}
+			defer func() { <-sem }()
+
+			slot, err := c.getMultipleAccountsPage(ctx, addrs, start, end, pageCfg, accounts)
+			if err != nil {
+				fail(err)
+				return
+			}
+			mu.Lock()
+			if slot > maxSlot {
+				maxSlot = slot
+			}
+			mu.Unlock()
+		}(start, pageEnd(start, len(addrs)))
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return rpc.ValueWithContext[[]*AccountInfo]{}, firstErr
+	}
+	return rpc.ValueWithContext[[]*AccountInfo]{
+		Context: rpc.Context{Slot: maxSlot},
+		Value:   accounts,
+	}, nil
+}
+
+func pageEnd(start, n int) int {
+	if n-start > MaxMultipleAccounts {
+		return start + MaxMultipleAccounts
+	}
+	return n
+}
+
+// getMultipleAccountsPage fetches addrs[start:end] into accounts[start:end] and returns the context slot
+func (c *Client) getMultipleAccountsPage(ctx context.Context, addrs []string, start, end int, cfg GetMultipleAccountsConfig, accounts []*AccountInfo) (uint64, error) {
+	page, err := process(
+		func() (rpc.JsonRpcResponse[rpc.ValueWithContext[[]rpc.AccountInfo]], error) {
+			return c.RpcClient.GetMultipleAccountsWithConfig(ctx, addrs[start:end], cfg.toRpc())
+		},
+		convertGetMultipleAccountsPage,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get accounts [%v, %v), err: %w", start, end, err)
+	}
+	if len(page.Value) != end-start {
+		return 0, fmt.Errorf("failed to get accounts [%v, %v), expected %v accounts, got %v", start, end, end-start, len(page.Value))
+	}
+	copy(accounts[start:end], page.Value)
+	return page.Context.Slot, nil
+}
+
+func convertGetMultipleAccountsPage(v rpc.ValueWithContext[[]rpc.AccountInfo]) (rpc.ValueWithContext[[]*AccountInfo], error) {
+	output := make([]*AccountInfo, 0, len(v.Value))
+	for _, rac := range v.Value {
+		if rac == (rpc.AccountInfo{}) {
+			output = append(output, nil)
+			continue
+		}
+		ac, err := convertAccountInfo(rac)
+		if err != nil {
+			return rpc.ValueWithContext[[]*AccountInfo]{}, err
+		}
+		output = append(output, &ac)
+	}
+	return rpc.ValueWithContext[[]*AccountInfo]{
+		Context: v.Context,
+		Value:   output,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/pointer"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/blocto/solana-go-sdk/rpc/rpctest"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetMultipleAccountsInPages(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := NewClient(s.URL)
+	ctx := context.Background()
+
+	addrs := make([]string, 0, 250)
+	for i := 0; i < 250; i++ {
+		pubkey := types.NewAccount().PublicKey
+		if i%3 != 0 {
+			s.SetAccount(pubkey, rpctest.Account{Lamports: uint64(i), Owner: common.SystemProgramID, Data: []byte{byte(i)}})
+		}
+		addrs = append(addrs, pubkey.ToBase58())
+	}
+
+	_, err := c.GetMultipleAccounts(ctx, addrs)
+	assert.NotNil(t, err)
+
+	for _, concurrency := range []int{0, 1} {
+		accounts, err := c.GetMultipleAccountsInPages(ctx, addrs, GetMultipleAccountsInPagesConfig{Concurrency: concurrency})
+		assert.Nil(t, err)
+		assert.Len(t, accounts, len(addrs))
+		for i, account := range accounts {
+			if i%3 == 0 {
+				assert.Nil(t, account)
+				continue
+			}
+			assert.Equal(t, &AccountInfo{Lamports: uint64(i), Owner: common.SystemProgramID, Data: []byte{byte(i)}}, account)
+		}
+	}
+
+	accounts, err := c.GetMultipleAccountsInPages(ctx, nil, GetMultipleAccountsInPagesConfig{})
+	assert.Nil(t, err)
+	assert.Empty(t, accounts)
+
+	slot, err := c.GetSlot(ctx)
+	assert.Nil(t, err)
+	_, err = c.GetMultipleAccountsInPages(ctx, addrs, GetMultipleAccountsInPagesConfig{MinContextSlot: pointer.Get(slot)})
+	assert.Nil(t, err)
+
+	_, err = c.GetMultipleAccountsInPages(ctx, addrs, GetMultipleAccountsInPagesConfig{MinContextSlot: pointer.Get(slot + 1)})
+	var rpcErr *rpc.JsonRpcError
+	assert.True(t, errors.As(err, &rpcErr))
+	assert.Equal(t, rpctest.ErrCodeMinContextSlotNotReached, rpcErr.Code)
+}
+
+func TestClient_GetMultipleAccountsInPagesAndContext(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := NewClient(s.URL)
+	ctx := context.Background()
+
+	addrs := make([]string, 0, 150)
+	for i := 0; i < 150; i++ {
+		addrs = append(addrs, types.NewAccount().PublicKey.ToBase58())
+	}
+
+	got, err := c.GetMultipleAccountsInPagesAndContext(ctx, addrs, GetMultipleAccountsInPagesConfig{})
+	assert.Nil(t, err)
+	assert.Len(t, got.Value, len(addrs))
+	assert.Equal(t, s.Slot(), got.Context.Slot)
+
+	// the pages are read after the min slot, the context slot tells they aren't from the same slot
+	slot := s.Slot()
+	s.ProduceBlocks(3)
+	got, err = c.GetMultipleAccountsInPagesAndContext(ctx, addrs, GetMultipleAccountsInPagesConfig{MinContextSlot: pointer.Get(slot)})
+	assert.Nil(t, err)
+	assert.Equal(t, slot+3, got.Context.Slot)
+
+	got, err = c.GetMultipleAccountsInPagesAndContext(ctx, nil, GetMultipleAccountsInPagesConfig{})
+	assert.Nil(t, err)
+	assert.Empty(t, got.Value)
+}
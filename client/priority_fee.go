@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+// maxPrioritizationFeeAccounts is the max number of accounts getRecentPrioritizationFees accepts
+const maxPrioritizationFeeAccounts = 128
+
+// PriorityFeeEstimate is the compute unit prices in micro-lamports of recent slots at some percentiles
+type PriorityFeeEstimate struct {
+	Low    uint64 // p25
+	Medium uint64 // p50
+	High   uint64 // p75
+	P90    uint64
+	// Samples are the sorted fees of the recent slots
+	Samples []uint64
+}
+
+// Percentile returns the fee at the percentile p (0-100) of the samples by the nearest rank
+func (e PriorityFeeEstimate) Percentile(p float64) uint64 {
+	if len(e.Samples) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(e.Samples))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(e.Samples) {
+		rank = len(e.Samples)
+	}
+	return e.Samples[rank-1]
+}
+
+// EstimatePriorityFee looks up the recent fees of the transactions which write the writable accounts of the message.
+// the accounts from lookup tables aren't in the message so they are not counted.
+func (c *Client) EstimatePriorityFee(ctx context.Context, message types.Message) (PriorityFeeEstimate, error) {
+	fees, err := c.GetRecentPrioritizationFees(ctx, writableAccounts(message))
+	if err != nil {
+		return PriorityFeeEstimate{}, fmt.Errorf("failed to get recent prioritization fees, err: %w", err)
+	}
+
+	samples := make([]uint64, 0, len(fees))
+	for _, fee := range fees {
+		samples = append(samples, fee.PrioritizationFee)
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+
+	estimate := PriorityFeeEstimate{Samples: samples}
+	estimate.Low = estimate.Percentile(25)
+	estimate.Medium = estimate.Percentile(50)
+	estimate.High = estimate.Percentile(75)
+	estimate.P90 = estimate.Percentile(90)
+	return estimate, nil
+}
+
+// WithPriorityFee estimates the fee at the percentile p (0-100) and puts it in the SetComputeUnitPrice of the message
+func (c *Client) WithPriorityFee(ctx context.Context, message types.Message, p float64) (types.Message, uint64, error) {
+	estimate, err := c.EstimatePriorityFee(ctx, message)
+	if err != nil {
+		return types.Message{}, 0, err
+	}
+	fee := estimate.Percentile(p)
+	return WithComputeUnitPrice(message, fee), fee, nil
+}
+
+// writableAccounts returns the static writable accounts, at most maxPrioritizationFeeAccounts
+func writableAccounts(message types.Message) []common.PublicKey {
+	numSigners := int(message.Header.NumRequireSignatures)
+	accounts := []common.PublicKey{}
+	for i, account := range message.Accounts {
+		var writable bool
+		if i < numSigners {
+			writable = i < numSigners-int(message.Header.NumReadonlySignedAccounts)
+		} else {
+			writable = i < len(message.Accounts)-int(message.Header.NumReadonlyUnsignedAccounts)
+		}
+		if writable {
+			accounts = append(accounts, account)
+		}
+		if len(accounts) == maxPrioritizationFeeAccounts {
+			break
+		}
+	}
+	return accounts
+}
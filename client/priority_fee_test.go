@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/compute_budget"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/rpc/rpctest"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityFeeEstimate_Percentile(t *testing.T) {
+	e := PriorityFeeEstimate{Samples: []uint64{0, 0, 10, 20, 30, 40, 50, 60, 70, 100}}
+	assert.Equal(t, uint64(0), e.Percentile(0))
+	assert.Equal(t, uint64(10), e.Percentile(25))
+	assert.Equal(t, uint64(30), e.Percentile(50))
+	assert.Equal(t, uint64(70), e.Percentile(90))
+	assert.Equal(t, uint64(100), e.Percentile(100))
+	assert.Equal(t, uint64(0), PriorityFeeEstimate{}.Percentile(50))
+}
+
+func TestWithComputeUnitPrice(t *testing.T) {
+	feePayer, to := types.NewAccount().PublicKey, types.NewAccount().PublicKey
+	transfer := system.Transfer(system.TransferParam{From: feePayer, To: to, Amount: 1})
+	price := func(microLamports uint64) types.Instruction {
+		return compute_budget.SetComputeUnitPrice(compute_budget.SetComputeUnitPriceParam{MicroLamports: microLamports})
+	}
+
+	// prepend
+	message := types.NewMessage(types.NewMessageParam{
+		FeePayer:        feePayer,
+		Instructions:    []types.Instruction{transfer},
+		RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+	})
+	got := WithComputeUnitPrice(message, 100)
+	assert.Equal(t, []types.Instruction{price(100), transfer}, got.DecompileInstructions())
+	assert.Nil(t, got.Validate())
+	assert.Len(t, message.Instructions, 1)
+
+	// replace
+	message = types.NewMessage(types.NewMessageParam{
+		FeePayer:        feePayer,
+		Instructions:    []types.Instruction{transfer, price(1)},
+		RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+	})
+	got = WithComputeUnitPrice(message, 100)
+	assert.Equal(t, []types.Instruction{transfer, price(100)}, got.DecompileInstructions())
+	assert.Equal(t, price(1).Data, message.Instructions[1].Data)
+
+	// the indexes of the accounts from lookup tables move
+	message = types.NewMessage(types.NewMessageParam{
+		FeePayer:        feePayer,
+		Instructions:    []types.Instruction{transfer},
+		RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+		AddressLookupTableAccounts: []types.AddressLookupTableAccount{
+			{Key: types.NewAccount().PublicKey, Addresses: []common.PublicKey{to}},
+		},
+	})
+	assert.Equal(t, []int{0, 2}, message.Instructions[0].Accounts)
+	got = WithComputeUnitPrice(message, 100)
+	assert.Equal(t, []common.PublicKey{feePayer, common.SystemProgramID, common.ComputeBudgetProgramID}, got.Accounts)
+	assert.Equal(t, uint8(2), got.Header.NumReadonlyUnsignedAccounts)
+	assert.Equal(t, []int{0, 3}, got.Instructions[1].Accounts)
+	assert.Equal(t, []int{0, 2}, message.Instructions[0].Accounts)
+}
+
+func TestClient_WithPriorityFee(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := NewClient(s.URL)
+	ctx := context.Background()
+
+	alice, bob, hot, cold := types.NewAccount(), types.NewAccount(), types.NewAccount().PublicKey, types.NewAccount().PublicKey
+	s.Airdrop(alice.PublicKey, 1_000_000_000)
+	s.Airdrop(bob.PublicKey, 1_000_000_000)
+	send := func(feePayer types.Account, message types.Message) {
+		latest, err := c.GetLatestBlockhash(ctx)
+		assert.Nil(t, err)
+		message.RecentBlockHash = latest.Blockhash
+		tx, err := types.NewTransaction(types.NewTransactionParam{Message: message, Signers: []types.Account{feePayer}})
+		assert.Nil(t, err)
+		_, err = c.SendAndConfirmTransaction(ctx, tx, SendAndConfirmTransactionConfig{
+			Confirm: ConfirmTransactionConfig{LastValidBlockHeight: latest.LatestValidBlockHeight, PollInterval: time.Millisecond},
+		})
+		assert.Nil(t, err)
+	}
+	transferMessage := func(feePayer types.Account, to common.PublicKey) types.Message {
+		return types.NewMessage(types.NewMessageParam{
+			FeePayer: feePayer.PublicKey,
+			Instructions: []types.Instruction{
+				system.Transfer(system.TransferParam{From: feePayer.PublicKey, To: to, Amount: rpctest.MinimumBalanceForRentExemption(0)}),
+			},
+		})
+	}
+
+	for _, price := range []uint64{1000, 2000, 3000, 4000} {
+		send(alice, WithComputeUnitPrice(transferMessage(alice, hot), price))
+	}
+	send(bob, WithComputeUnitPrice(transferMessage(bob, cold), 9999))
+	s.ProduceBlocks(1)
+
+	estimate, err := c.EstimatePriorityFee(ctx, transferMessage(alice, hot))
+	assert.Nil(t, err)
+	assert.NotContains(t, estimate.Samples, uint64(9999))
+	assert.Equal(t, []uint64{1000, 2000, 3000, 4000}, estimate.Samples[len(estimate.Samples)-4:])
+	assert.Equal(t, estimate.Percentile(90), estimate.P90)
+
+	message, fee, err := c.WithPriorityFee(ctx, transferMessage(alice, hot), 100)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4000), fee)
+	assert.Equal(t, compute_budget.SetComputeUnitPrice(compute_budget.SetComputeUnitPriceParam{MicroLamports: 4000}).Data, message.Instructions[0].Data)
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"errors"
+
+	"github.com/blocto/solana-go-sdk/program/programerror"
+	"github.com/blocto/solana-go-sdk/rpc"
+)
+
+// ParseCustomError finds the custom program error in the error returned by sendTransaction (preflight) or any
+// other rpc call which reports a transaction error in JsonRpcError.Data.
+func ParseCustomError(err error) (*programerror.CustomError, bool) {
+	var rpcErr *rpc.JsonRpcError
+	if !errors.As(err, &rpcErr) {
+		return nil, false
+	}
+	data, ok := rpcErr.Data.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	var logs []string
+	if l, ok := data["logs"].([]any); ok {
+		for _, v := range l {
+			if s, ok := v.(string); ok {
+				logs = append(logs, s)
+			}
+		}
+	}
+	transactionError, err := rpc.ParseTransactionError(data["err"])
+	if err != nil {
+		return nil, false
+	}
+	return CustomErrorFromTransactionError(transactionError, logs)
+}
+
+// CustomErrorFromTransactionError finds the custom program error in a transaction error, e.g. the `err` of a
+// signature status or a simulation. the program which failed is taken from the logs.
+func CustomErrorFromTransactionError(transactionError *rpc.TransactionError, logs []string) (*programerror.CustomError, bool) {
+	if transactionError == nil {
+		return nil, false
+	}
+	instructionError := transactionError.InstructionError
+	if transactionError.Kind != rpc.TransactionErrorInstructionError || instructionError == nil || instructionError.Kind != rpc.InstructionErrorCustom {
+		return nil, false
+	}
+	return programerror.NewCustomError(transactionError.InstructionIndex, instructionError.Code, logs), true
+}
@@ -0,0 +1,167 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/programerror"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testCustomErrorProgramID = common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	errTestCustomError       = errors.New("test error")
+)
+
+func init() {
+	programerror.Register(testCustomErrorProgramID, map[uint32]error{
+		16: errTestCustomError,
+	})
+}
+
+func TestParseCustomError(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   *programerror.CustomError
+		wantOk bool
+	}{
+		{
+			name: "registered",
+			args: args{
+				err: &rpc.JsonRpcError{
+					Code:    -32002,
+					Message: "Transaction simulation failed: Error processing Instruction 1: custom program error: 0x10",
+					Data: map[string]any{
+						"err": map[string]any{"InstructionError": []any{float64(1), map[string]any{"Custom": float64(16)}}},
+						"logs": []any{
+							"Program 11111111111111111111111111111111 invoke [1]",
+							"Program 11111111111111111111111111111111 success",
+							"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 invoke [1]",
+							"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 consumed 2000 of 200000 compute units",
+							"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 failed: custom program error: 0x10",
+						},
+					},
+				},
+			},
+			want: &programerror.CustomError{
+				InstructionIndex: 1,
+				ProgramID:        testCustomErrorProgramID,
+				Code:             16,
+				Err:              errTestCustomError,
+			},
+			wantOk: true,
+		},
+		{
+			name: "unknown program",
+			args: args{
+				err: &rpc.JsonRpcError{
+					Code: -32002,
+					Data: map[string]any{
+						"err": map[string]any{"InstructionError": []any{float64(0), map[string]any{"Custom": float64(1)}}},
+						"logs": []any{
+							"Program BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ invoke [1]",
+							"Program BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ failed: custom program error: 0x1",
+						},
+					},
+				},
+			},
+			want: &programerror.CustomError{
+				InstructionIndex: 0,
+				ProgramID:        common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+				Code:             1,
+				Err:              nil,
+			},
+			wantOk: true,
+		},
+		{
+			name: "malformed log before the failed program",
+			args: args{
+				err: &rpc.JsonRpcError{
+					Code: -32002,
+					Data: map[string]any{
+						"err": map[string]any{"InstructionError": []any{float64(0), map[string]any{"Custom": float64(16)}}},
+						"logs": []any{
+							"Program not-a-key failed: custom program error: 0x10",
+							"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 failed: custom program error: 0x10",
+						},
+					},
+				},
+			},
+			want: &programerror.CustomError{
+				InstructionIndex: 0,
+				ProgramID:        testCustomErrorProgramID,
+				Code:             16,
+				Err:              errTestCustomError,
+			},
+			wantOk: true,
+		},
+		{
+			name: "without logs",
+			args: args{
+				err: &rpc.JsonRpcError{
+					Code: -32002,
+					Data: map[string]any{
+						"err": map[string]any{"InstructionError": []any{float64(0), map[string]any{"Custom": float64(1)}}},
+					},
+				},
+			},
+			want: &programerror.CustomError{
+				InstructionIndex: 0,
+				Code:             1,
+			},
+			wantOk: true,
+		},
+		{
+			name: "not a custom error",
+			args: args{
+				err: &rpc.JsonRpcError{
+					Code: -32002,
+					Data: map[string]any{
+						"err": map[string]any{"InstructionError": []any{float64(0), "InvalidAccountData"}},
+					},
+				},
+			},
+			want:   nil,
+			wantOk: false,
+		},
+		{
+			name: "not a rpc error",
+			args: args{
+				err: errors.New("timeout"),
+			},
+			want:   nil,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseCustomError(tt.args.err)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomErrorFromTransactionError(t *testing.T) {
+	customError, ok := CustomErrorFromTransactionError(
+		&rpc.TransactionError{
+			Kind:             rpc.TransactionErrorInstructionError,
+			InstructionIndex: 2,
+			InstructionError: &rpc.InstructionError{Kind: rpc.InstructionErrorCustom, Code: 16},
+		},
+		[]string{"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 failed: custom program error: 0x10"},
+	)
+	assert.True(t, ok)
+	assert.Equal(t, &programerror.CustomError{InstructionIndex: 2, ProgramID: testCustomErrorProgramID, Code: 16, Err: errTestCustomError}, customError)
+
+	_, ok = CustomErrorFromTransactionError(&rpc.TransactionError{Kind: rpc.TransactionErrorBlockhashNotFound}, nil)
+	assert.False(t, ok)
+	_, ok = CustomErrorFromTransactionError(nil, nil)
+	assert.False(t, ok)
+}
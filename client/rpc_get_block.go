@@ -28,13 +28,13 @@ func (c GetBlockConfig) toRpc() rpc.GetBlockConfig {
 }
 
 type Block struct {
-	Blockhash         string
+	Blockhash         common.Hash
 	BlockTime         *time.Time
 	BlockHeight       *int64
-	PreviousBlockhash string
+	PreviousBlockhash common.Hash
 	ParentSlot        uint64
 	Transactions      []BlockTransaction
-	Signatures        []string
+	Signatures        []common.Signature
 	Rewards           []Reward
 }
 
@@ -25,13 +25,13 @@ func TestClient_BlockWithConfig(t *testing.T) {
 					return c.GetBlockWithConfig(context.Background(), 33, GetBlockConfig{TransactionDetails: rpc.GetBlockConfigTransactionDetailsSignatures})
 				},
 				ExpectedValue: &Block{
-					Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+					Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 					BlockTime:         pointer.Get[time.Time](time.Unix(1631803928, 0)),
 					BlockHeight:       pointer.Get[int64](33),
-					PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
+					PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
 					ParentSlot:        32,
 					Transactions:      nil,
-					Signatures:        []string{"3Me2gWFGDFwWnhugNt5u1fFvU2CyVtY4WcRzBXRKUWtgnYSxnt72p5fWiNrAkEoNTLL6FdLmk34kC41Ph91LKr6A"},
+					Signatures:        []common.Signature{common.MustParseSignature("3Me2gWFGDFwWnhugNt5u1fFvU2CyVtY4WcRzBXRKUWtgnYSxnt72p5fWiNrAkEoNTLL6FdLmk34kC41Ph91LKr6A")},
 					Rewards: []Reward{
 						{
 							Pubkey:       common.PublicKeyFromString("9HvwukipCq1TVcSWoNQW7ajTUDFyC16KrARqnXppBdwX"),
@@ -53,10 +53,10 @@ func TestClient_BlockWithConfig(t *testing.T) {
 					return c.GetBlockWithConfig(context.Background(), 33, GetBlockConfig{TransactionDetails: rpc.GetBlockConfigTransactionDetailsNone})
 				},
 				ExpectedValue: &Block{
-					Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+					Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 					BlockTime:         pointer.Get[time.Time](time.Unix(1631803928, 0)),
 					BlockHeight:       pointer.Get[int64](33),
-					PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
+					PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
 					ParentSlot:        32,
 					Transactions:      nil,
 					Signatures:        nil,
@@ -81,10 +81,10 @@ func TestClient_BlockWithConfig(t *testing.T) {
 					return c.GetBlockWithConfig(context.Background(), 33, GetBlockConfig{Rewards: pointer.Get(false)})
 				},
 				ExpectedValue: &Block{
-					Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+					Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 					BlockTime:         pointer.Get[time.Time](time.Unix(1631803928, 0)),
 					BlockHeight:       pointer.Get[int64](33),
-					PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
+					PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
 					ParentSlot:        32,
 					Transactions: []BlockTransaction{
 						{
@@ -115,8 +115,8 @@ func TestClient_BlockWithConfig(t *testing.T) {
 							},
 							Transaction: types.Transaction{
 								Signatures: []types.Signature{
-									mustBase58Decode(t, "3Me2gWFGDFwWnhugNt5u1fFvU2CyVtY4WcRzBXRKUWtgnYSxnt72p5fWiNrAkEoNTLL6FdLmk34kC41Ph91LKr6A"),
-									mustBase58Decode(t, "4cWqSVUcxTujZ6eHtNWESwCrBUfidbZ1J124VU2jY9TQpXxyHSDku1NiZhw95SzXe1mGihiP9AdQNEkLMAdvBYPQ"),
+									common.MustParseSignature("3Me2gWFGDFwWnhugNt5u1fFvU2CyVtY4WcRzBXRKUWtgnYSxnt72p5fWiNrAkEoNTLL6FdLmk34kC41Ph91LKr6A"),
+									common.MustParseSignature("4cWqSVUcxTujZ6eHtNWESwCrBUfidbZ1J124VU2jY9TQpXxyHSDku1NiZhw95SzXe1mGihiP9AdQNEkLMAdvBYPQ"),
 								},
 								Message: types.Message{
 									Version: types.MessageVersionLegacy,
@@ -132,7 +132,7 @@ func TestClient_BlockWithConfig(t *testing.T) {
 										common.PublicKeyFromString("SysvarC1ock11111111111111111111111111111111"),
 										common.PublicKeyFromString("Vote111111111111111111111111111111111111111"),
 									},
-									RecentBlockHash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
+									RecentBlockHash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
 									Instructions: []types.CompiledInstruction{
 										{
 											ProgramIDIndex: 4,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/blocto/solana-go-sdk/common"
 	"github.com/blocto/solana-go-sdk/internal/client_test"
 	"github.com/blocto/solana-go-sdk/rpc"
 )
@@ -20,7 +21,7 @@ func TestClient_GetLatestBlockhash(t *testing.T) {
 					return c.GetLatestBlockhash(context.Background())
 				},
 				ExpectedValue: rpc.GetLatestBlockhashValue{
-					Blockhash:              "DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ",
+					Blockhash:              common.MustParseHash("DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ"),
 					LatestValidBlockHeight: 177067026,
 				},
 				ExpectedError: nil,
@@ -46,7 +47,7 @@ func TestClient_GetLatestBlockhashWithConfig(t *testing.T) {
 					)
 				},
 				ExpectedValue: rpc.GetLatestBlockhashValue{
-					Blockhash:              "DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ",
+					Blockhash:              common.MustParseHash("DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ"),
 					LatestValidBlockHeight: 177067026,
 				},
 				ExpectedError: nil,
@@ -72,7 +73,7 @@ func TestClient_GetLatestBlockhashAndContext(t *testing.T) {
 						ApiVersion: "1.14.10",
 					},
 					Value: rpc.GetLatestBlockhashValue{
-						Blockhash:              "DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ",
+						Blockhash:              common.MustParseHash("DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ"),
 						LatestValidBlockHeight: 177067026,
 					},
 				},
@@ -104,7 +105,7 @@ func TestClient_GetLatestBlockhashAndContextWithConfig(t *testing.T) {
 						ApiVersion: "1.14.10",
 					},
 					Value: rpc.GetLatestBlockhashValue{
-						Blockhash:              "DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ",
+						Blockhash:              common.MustParseHash("DjQ4csyDJ9ZQvNNbK838ATs5UrqMq8s4Pd5i1ts22HAQ"),
 						LatestValidBlockHeight: 177067026,
 					},
 				},
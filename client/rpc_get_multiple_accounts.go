@@ -7,15 +7,17 @@ import (
 )
 
 type GetMultipleAccountsConfig struct {
-	Commitment rpc.Commitment
-	DataSlice  *rpc.DataSlice
+	Commitment     rpc.Commitment
+	DataSlice      *rpc.DataSlice
+	MinContextSlot *uint64
 }
 
 func (c GetMultipleAccountsConfig) toRpc() rpc.GetMultipleAccountsConfig {
 	return rpc.GetMultipleAccountsConfig{
-		Encoding:   rpc.AccountEncodingBase64,
-		Commitment: c.Commitment,
-		DataSlice:  c.DataSlice,
+		Encoding:       rpc.AccountEncodingBase64,
+		Commitment:     c.Commitment,
+		DataSlice:      c.DataSlice,
+		MinContextSlot: c.MinContextSlot,
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/blocto/solana-go-sdk/common"
 	"github.com/blocto/solana-go-sdk/internal/client_test"
 	"github.com/blocto/solana-go-sdk/pkg/pointer"
 	"github.com/blocto/solana-go-sdk/rpc"
@@ -25,27 +26,27 @@ func TestClient_GetSignaturesForAddress(t *testing.T) {
 				},
 				ExpectedValue: rpc.GetSignaturesForAddress{
 					{
-						Signature: "26UNKcerqcmHhRgFqtvtWUJZod91dGpkkAtRzKewvsZSoah33VZiFgKPmnHmMhBwsHT4bQjKdCgH88Faex5WkChh",
+						Signature: common.MustParseSignature("26UNKcerqcmHhRgFqtvtWUJZod91dGpkkAtRzKewvsZSoah33VZiFgKPmnHmMhBwsHT4bQjKdCgH88Faex5WkChh"),
 						Slot:      63372,
 						BlockTime: pointer.Get[int64](1633580920),
 					},
 					{
-						Signature: "vB73C8nWXv2ZwLjCRhTQXnkqqKDafE9uWyycQqajFzQcNFzjRUYq8ZqmtCm4qnwpGxXJWbkkRuyhiQ26zEpfk28",
+						Signature: common.MustParseSignature("vB73C8nWXv2ZwLjCRhTQXnkqqKDafE9uWyycQqajFzQcNFzjRUYq8ZqmtCm4qnwpGxXJWbkkRuyhiQ26zEpfk28"),
 						Slot:      63370,
 						BlockTime: pointer.Get[int64](1633580919),
 					},
 					{
-						Signature: "4ZAtdQ7wF8EPXVf43ZpyqMiKqpaiGppysSxQ54X31Q92ht1pKgQymiJwRnUc3h4cUjRJfFrVprNJhW6UfybJMAQP",
+						Signature: common.MustParseSignature("4ZAtdQ7wF8EPXVf43ZpyqMiKqpaiGppysSxQ54X31Q92ht1pKgQymiJwRnUc3h4cUjRJfFrVprNJhW6UfybJMAQP"),
 						Slot:      63369,
 						BlockTime: pointer.Get[int64](1633580918),
 					},
 					{
-						Signature: "63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y",
+						Signature: common.MustParseSignature("63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y"),
 						Slot:      63367,
 						BlockTime: pointer.Get[int64](1633580916),
 					},
 					{
-						Signature: "pxS5UZhzvk8p5qiKonkAeVBjP1ipujERPMMNZ2ZexK1PyU8RvZxQwYjiv9YJYP4CpRrHAxTET2rNZ6LNf4aYvDN",
+						Signature: common.MustParseSignature("pxS5UZhzvk8p5qiKonkAeVBjP1ipujERPMMNZ2ZexK1PyU8RvZxQwYjiv9YJYP4CpRrHAxTET2rNZ6LNf4aYvDN"),
 						Slot:      63365,
 						BlockTime: pointer.Get[int64](1633580915),
 					},
@@ -76,7 +77,7 @@ func TestClient_GetSignaturesForAddressWithConfig(t *testing.T) {
 				},
 				ExpectedValue: rpc.GetSignaturesForAddress{
 					{
-						Signature: "63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y",
+						Signature: common.MustParseSignature("63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y"),
 						Slot:      63367,
 						BlockTime: pointer.Get[int64](1633580916),
 					},
@@ -99,7 +100,7 @@ func TestClient_GetSignaturesForAddressWithConfig(t *testing.T) {
 				},
 				ExpectedValue: rpc.GetSignaturesForAddress{
 					{
-						Signature: "63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y",
+						Signature: common.MustParseSignature("63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y"),
 						Slot:      63367,
 						BlockTime: pointer.Get[int64](1633580916),
 					},
@@ -122,7 +123,7 @@ func TestClient_GetSignaturesForAddressWithConfig(t *testing.T) {
 				},
 				ExpectedValue: rpc.GetSignaturesForAddress{
 					{
-						Signature: "63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y",
+						Signature: common.MustParseSignature("63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y"),
 						Slot:      63367,
 						BlockTime: pointer.Get[int64](1633580916),
 					},
@@ -145,7 +146,7 @@ func TestClient_GetSignaturesForAddressWithConfig(t *testing.T) {
 				},
 				ExpectedValue: rpc.GetSignaturesForAddress{
 					{
-						Signature: "63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y",
+						Signature: common.MustParseSignature("63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y"),
 						Slot:      63367,
 						BlockTime: pointer.Get[int64](1633580916),
 					},
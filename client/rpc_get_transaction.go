@@ -39,8 +39,32 @@ func (t Transaction) Version() types.MessageVersion {
 	return t.Transaction.Message.Version
 }
 
+// DecompileInstructions decompiles the instructions of the transaction, the accounts a v0 transaction loads
+// from lookup tables are taken from the meta
+func (t Transaction) DecompileInstructions() ([]types.Instruction, error) {
+	var loaded types.LoadedAddresses
+	if t.Meta != nil {
+		loaded = convertLoadedAddresses(t.Meta.LoadedAddresses)
+	}
+	return t.Transaction.Message.DecompileInstructionsWithLoadedAddresses(loaded)
+}
+
+func convertLoadedAddresses(v rpc.TransactionLoadedAddresses) types.LoadedAddresses {
+	loaded := types.LoadedAddresses{
+		Writable: make([]common.PublicKey, 0, len(v.Writable)),
+		Readonly: make([]common.PublicKey, 0, len(v.Readonly)),
+	}
+	for _, s := range v.Writable {
+		loaded.Writable = append(loaded.Writable, common.PublicKeyFromString(s))
+	}
+	for _, s := range v.Readonly {
+		loaded.Readonly = append(loaded.Readonly, common.PublicKeyFromString(s))
+	}
+	return loaded
+}
+
 type TransactionMeta struct {
-	Err                  any
+	Err                  *rpc.TransactionError
 	Fee                  uint64
 	PreBalances          []int64
 	PostBalances         []int64
@@ -198,12 +222,9 @@ func parseBase64Tx(raw any, transactionMeta *TransactionMeta) (types.Transaction
 	accountKeys := make([]common.PublicKey, 0, l)
 	accountKeys = append(accountKeys, tx.Message.Accounts...)
 	if transactionMeta != nil {
-		for _, s := range transactionMeta.LoadedAddresses.Writable {
-			accountKeys = append(accountKeys, common.PublicKeyFromString(s))
-		}
-		for _, s := range transactionMeta.LoadedAddresses.Readonly {
-			accountKeys = append(accountKeys, common.PublicKeyFromString(s))
-		}
+		loaded := convertLoadedAddresses(transactionMeta.LoadedAddresses)
+		accountKeys = append(accountKeys, loaded.Writable...)
+		accountKeys = append(accountKeys, loaded.Readonly...)
 	}
 
 	return tx, accountKeys, nil
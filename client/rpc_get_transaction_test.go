@@ -122,7 +122,7 @@ func TestClient_GetTransaction(t *testing.T) {
 						},
 					},
 					Transaction: types.Transaction{
-						Signatures: []types.Signature{[]byte{0xa1, 0x6, 0x96, 0xca, 0xe3, 0xc0, 0x73, 0xa3, 0x5c, 0xe0, 0xc4, 0xbc, 0x41, 0x9b, 0xe5, 0x96, 0x9d, 0x7b, 0xc4, 0x1e, 0x96, 0x45, 0xb1, 0xda, 0x5f, 0x55, 0xbe, 0xc7, 0x8f, 0xfe, 0xd, 0x68, 0x95, 0xec, 0x5, 0xc4, 0xa9, 0x9, 0x13, 0x4, 0x43, 0x27, 0x26, 0x76, 0xc1, 0xe9, 0x7c, 0xa7, 0x60, 0xe2, 0x96, 0x9d, 0xf0, 0x9c, 0x1b, 0xba, 0x0, 0x46, 0xd1, 0x7, 0x82, 0xed, 0x87, 0x0}},
+						Signatures: []types.Signature{{0xa1, 0x6, 0x96, 0xca, 0xe3, 0xc0, 0x73, 0xa3, 0x5c, 0xe0, 0xc4, 0xbc, 0x41, 0x9b, 0xe5, 0x96, 0x9d, 0x7b, 0xc4, 0x1e, 0x96, 0x45, 0xb1, 0xda, 0x5f, 0x55, 0xbe, 0xc7, 0x8f, 0xfe, 0xd, 0x68, 0x95, 0xec, 0x5, 0xc4, 0xa9, 0x9, 0x13, 0x4, 0x43, 0x27, 0x26, 0x76, 0xc1, 0xe9, 0x7c, 0xa7, 0x60, 0xe2, 0x96, 0x9d, 0xf0, 0x9c, 0x1b, 0xba, 0x0, 0x46, 0xd1, 0x7, 0x82, 0xed, 0x87, 0x0}},
 						Message: types.Message{
 							Version: types.MessageVersionLegacy,
 							Header: types.MessageHeader{
@@ -146,7 +146,7 @@ func TestClient_GetTransaction(t *testing.T) {
 									Data:           []byte{},
 								},
 							},
-							RecentBlockHash:     "Gpemb2whtMogoSGVe5KMjuoueeqNNkQ1kKnw7fsYKZHj",
+							RecentBlockHash:     common.MustParseHash("Gpemb2whtMogoSGVe5KMjuoueeqNNkQ1kKnw7fsYKZHj"),
 							AddressLookupTables: []types.CompiledAddressLookupTable{},
 						},
 					},
@@ -205,7 +205,7 @@ func TestClient_GetTransaction(t *testing.T) {
 						PostTokenBalances: []rpc.TransactionMetaTokenBalance{},
 					},
 					Transaction: types.Transaction{
-						Signatures: []types.Signature{[]byte{0x35, 0xa5, 0xa6, 0x33, 0xdd, 0x9b, 0xef, 0x26, 0xba, 0x3d, 0x86, 0xc3, 0x97, 0xad, 0x4, 0x90, 0x1, 0x1e, 0x8, 0x6, 0xb6, 0x1c, 0xc8, 0x89, 0xc, 0x5c, 0x14, 0xef, 0x93, 0x8b, 0x3b, 0x38, 0x92, 0xba, 0xc5, 0x7, 0x6a, 0xd6, 0xba, 0x2d, 0x83, 0x4, 0xdf, 0x99, 0xcf, 0xf3, 0x74, 0xc7, 0xcb, 0x4a, 0xa7, 0xae, 0xf7, 0xd6, 0x5e, 0x59, 0x5a, 0x78, 0xa0, 0x40, 0x3b, 0x8c, 0x41, 0xd}},
+						Signatures: []types.Signature{{0x35, 0xa5, 0xa6, 0x33, 0xdd, 0x9b, 0xef, 0x26, 0xba, 0x3d, 0x86, 0xc3, 0x97, 0xad, 0x4, 0x90, 0x1, 0x1e, 0x8, 0x6, 0xb6, 0x1c, 0xc8, 0x89, 0xc, 0x5c, 0x14, 0xef, 0x93, 0x8b, 0x3b, 0x38, 0x92, 0xba, 0xc5, 0x7, 0x6a, 0xd6, 0xba, 0x2d, 0x83, 0x4, 0xdf, 0x99, 0xcf, 0xf3, 0x74, 0xc7, 0xcb, 0x4a, 0xa7, 0xae, 0xf7, 0xd6, 0x5e, 0x59, 0x5a, 0x78, 0xa0, 0x40, 0x3b, 0x8c, 0x41, 0xd}},
 						Message: types.Message{
 							Version: types.MessageVersionLegacy,
 							Header: types.MessageHeader{
@@ -225,7 +225,7 @@ func TestClient_GetTransaction(t *testing.T) {
 									Data:           []byte{},
 								},
 							},
-							RecentBlockHash:     "GGjz3cjABNTaCA9w1pP3y5FtpsZtKLR5taBk5MF8ijQj",
+							RecentBlockHash:     common.MustParseHash("GGjz3cjABNTaCA9w1pP3y5FtpsZtKLR5taBk5MF8ijQj"),
 							AddressLookupTables: []types.CompiledAddressLookupTable{},
 						},
 					},
@@ -391,7 +391,7 @@ func TestClient_GetTransaction(t *testing.T) {
 					},
 					Transaction: types.Transaction{
 						Signatures: []types.Signature{
-							mustBase58Decode(t, "4fSTSDTTuYa1XXAFxFenuY3SoZWUwCzpMq7kUiya1zW6uqqh6C76GFqTQ3wvegEbZhbPJyr33iDAbieQVWCtVXmf"),
+							common.MustParseSignature("4fSTSDTTuYa1XXAFxFenuY3SoZWUwCzpMq7kUiya1zW6uqqh6C76GFqTQ3wvegEbZhbPJyr33iDAbieQVWCtVXmf"),
 						},
 						Message: types.Message{
 							Version: types.MessageVersionV0,
@@ -405,7 +405,7 @@ func TestClient_GetTransaction(t *testing.T) {
 								common.PublicKeyFromString("HqXcr9ja8jTZAfWN4YSSL8PPWFN3BFJsoxrCvSLaqww1"),
 								common.PublicKeyFromString("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"),
 							},
-							RecentBlockHash: "5dQEKfLJt77vfrw2UxWrPrDFwFmxRui6Rk6FBjGnuZBg",
+							RecentBlockHash: common.MustParseHash("5dQEKfLJt77vfrw2UxWrPrDFwFmxRui6Rk6FBjGnuZBg"),
 							Instructions: []types.CompiledInstruction{
 								{
 									ProgramIDIndex: 2,
@@ -5,16 +5,82 @@ import (
 	"encoding/base64"
 	"fmt"
 
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/programerror"
+	"github.com/blocto/solana-go-sdk/program/programlog"
 	"github.com/blocto/solana-go-sdk/rpc"
 	"github.com/blocto/solana-go-sdk/types"
+	"github.com/mr-tron/base58"
 )
 
 type SimulateTransaction struct {
-	Err          any
+	Err          *rpc.TransactionError
 	Logs         []string
 	Accounts     []*AccountInfo
 	ReturnData   *ReturnData
 	UnitConsumed *uint64
+	// ParsedLogs is nil if there is no log or the logs can't be parsed
+	ParsedLogs *programlog.Logs
+	// InnerInstructions is set if SimulateTransactionConfig.InnerInstructions is true
+	InnerInstructions []SimulateInnerInstruction
+}
+
+// SimulateInnerInstruction is the instructions invoked by the top-level instruction at Index
+type SimulateInnerInstruction struct {
+	Index        uint64
+	Instructions []SimulateInstruction
+}
+
+// SimulateInstruction is an inner instruction of a simulation. the node parses the instructions of the programs it
+// knows, Parsed is set and Accounts and Data are empty for them.
+type SimulateInstruction struct {
+	ProgramID   common.PublicKey
+	Program     string
+	Parsed      any
+	Accounts    []common.PublicKey
+	Data        []byte
+	StackHeight *uint64
+}
+
+// TransactionError returns nil if the simulation succeeded, otherwise a *SimulationFailedError
+func (s SimulateTransaction) TransactionError() error {
+	if s.Err == nil {
+		return nil
+	}
+	e := &SimulationFailedError{
+		Err:  s.Err,
+		Logs: s.Logs,
+	}
+	if s.UnitConsumed != nil {
+		e.UnitsConsumed = *s.UnitConsumed
+	}
+	e.CustomError, _ = CustomErrorFromTransactionError(s.Err, s.Logs)
+	return e
+}
+
+// SimulationFailedError means the simulation of a transaction failed. it unwraps to Err so errors.Is matches
+// the rpc.TransactionErrorKind and rpc.InstructionErrorKind of the failure. get the error with errors.As and
+// check CustomError for the registered error of a custom program error.
+type SimulationFailedError struct {
+	Err           *rpc.TransactionError
+	Logs          []string
+	UnitsConsumed uint64
+	// CustomError is the custom program error decoded from Err, it is nil if the failure isn't a custom one
+	CustomError *programerror.CustomError
+}
+
+func (e *SimulationFailedError) Error() string {
+	if e.CustomError != nil {
+		return fmt.Sprintf("simulation failed, %v", e.CustomError)
+	}
+	return fmt.Sprintf("simulation failed, err: %v", e.Err)
+}
+
+func (e *SimulationFailedError) Unwrap() error {
+	if e.Err == nil {
+		return nil
+	}
+	return e.Err
 }
 
 type SimulateTransactionConfig struct {
@@ -22,6 +88,7 @@ type SimulateTransactionConfig struct {
 	Commitment             rpc.Commitment
 	ReplaceRecentBlockhash bool
 	Addresses              []string
+	InnerInstructions      bool
 }
 
 func (c SimulateTransactionConfig) toRpc() rpc.SimulateTransactionConfig {
@@ -38,6 +105,7 @@ func (c SimulateTransactionConfig) toRpc() rpc.SimulateTransactionConfig {
 		Commitment:             c.Commitment,
 		ReplaceRecentBlockhash: c.ReplaceRecentBlockhash,
 		Accounts:               accounts,
+		InnerInstructions:      c.InnerInstructions,
 	}
 }
 
@@ -135,15 +203,82 @@ func convertSimulateTransaction(v rpc.ValueWithContext[rpc.SimulateTransactionVa
 		returnData = &d
 	}
 
+	var parsedLogs *programlog.Logs
+	if len(v.Value.Logs) > 0 {
+		if logs, err := programlog.Parse(v.Value.Logs); err == nil {
+			parsedLogs = &logs
+		}
+	}
+
+	var innerInstructions []SimulateInnerInstruction
+	if v.Value.InnerInstructions != nil {
+		innerInstructions = make([]SimulateInnerInstruction, 0, len(v.Value.InnerInstructions))
+		for _, inner := range v.Value.InnerInstructions {
+			instructions := make([]SimulateInstruction, 0, len(inner.Instructions))
+			for _, raw := range inner.Instructions {
+				instruction, err := convertSimulateInstruction(raw)
+				if err != nil {
+					return SimulateTransaction{}, fmt.Errorf("failed to convert inner instruction, err: %v", err)
+				}
+				instructions = append(instructions, instruction)
+			}
+			innerInstructions = append(innerInstructions, SimulateInnerInstruction{
+				Index:        inner.Index,
+				Instructions: instructions,
+			})
+		}
+	}
+
 	return SimulateTransaction{
-		Err:          v.Value.Err,
-		Logs:         v.Value.Logs,
-		Accounts:     accountInfos,
-		ReturnData:   returnData,
-		UnitConsumed: v.Value.UnitConsumed,
+		Err:               v.Value.Err,
+		Logs:              v.Value.Logs,
+		Accounts:          accountInfos,
+		ReturnData:        returnData,
+		UnitConsumed:      v.Value.UnitConsumed,
+		ParsedLogs:        parsedLogs,
+		InnerInstructions: innerInstructions,
 	}, nil
 }
 
+func convertSimulateInstruction(v any) (SimulateInstruction, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return SimulateInstruction{}, fmt.Errorf("unexpected instruction type, value: %v", v)
+	}
+
+	var instruction SimulateInstruction
+	programID, ok := m["programId"].(string)
+	if !ok {
+		return SimulateInstruction{}, fmt.Errorf("failed to get program id")
+	}
+	instruction.ProgramID = common.PublicKeyFromString(programID)
+	instruction.Program, _ = m["program"].(string)
+	instruction.Parsed = m["parsed"]
+	if stackHeight, ok := m["stackHeight"].(float64); ok {
+		h := uint64(stackHeight)
+		instruction.StackHeight = &h
+	}
+
+	if rawAccounts, ok := m["accounts"].([]any); ok {
+		instruction.Accounts = make([]common.PublicKey, 0, len(rawAccounts))
+		for _, rawAccount := range rawAccounts {
+			account, ok := rawAccount.(string)
+			if !ok {
+				return SimulateInstruction{}, fmt.Errorf("unexpected account type, value: %v", rawAccount)
+			}
+			instruction.Accounts = append(instruction.Accounts, common.PublicKeyFromString(account))
+		}
+	}
+	if data, ok := m["data"].(string); ok && len(data) > 0 {
+		b, err := base58.Decode(data)
+		if err != nil {
+			return SimulateInstruction{}, fmt.Errorf("failed to base58 decode data, data: %v, err: %v", data, err)
+		}
+		instruction.Data = b
+	}
+	return instruction, nil
+}
+
 func convertSimulateTransactionAndContext(v rpc.ValueWithContext[rpc.SimulateTransactionValue]) (rpc.ValueWithContext[SimulateTransaction], error) {
 	simulateTrasaction, err := convertSimulateTransaction(v)
 	if err != nil {
@@ -2,12 +2,16 @@ package client
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/blocto/solana-go-sdk/common"
 	"github.com/blocto/solana-go-sdk/internal/client_test"
 	"github.com/blocto/solana-go-sdk/pkg/pointer"
+	"github.com/blocto/solana-go-sdk/program/programlog"
+	"github.com/blocto/solana-go-sdk/program/system"
 	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClient_SimulateTransaction(t *testing.T) {
@@ -38,6 +42,18 @@ func TestClient_SimulateTransaction(t *testing.T) {
 						Data:      []byte{1, 2, 3, 4, 5},
 					},
 					UnitConsumed: pointer.Get[uint64](185),
+					ParsedLogs: &programlog.Logs{
+						Invocations: []*programlog.Invocation{
+							{
+								ProgramID:            common.PublicKeyFromString("35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP"),
+								Depth:                1,
+								ReturnData:           []byte{1, 2, 3, 4, 5},
+								ComputeUnitsConsumed: 185,
+								ComputeUnitsLimit:    200000,
+								Success:              true,
+							},
+						},
+					},
 				},
 				ExpectedError: nil,
 			},
@@ -78,6 +94,18 @@ func TestClient_SimulateTransactionAndContext(t *testing.T) {
 							Data:      []byte{1, 2, 3, 4, 5},
 						},
 						UnitConsumed: pointer.Get[uint64](185),
+						ParsedLogs: &programlog.Logs{
+							Invocations: []*programlog.Invocation{
+								{
+									ProgramID:            common.PublicKeyFromString("35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP"),
+									Depth:                1,
+									ReturnData:           []byte{1, 2, 3, 4, 5},
+									ComputeUnitsConsumed: 185,
+									ComputeUnitsLimit:    200000,
+									Success:              true,
+								},
+							},
+						},
 					},
 				},
 				ExpectedError: nil,
@@ -85,3 +113,115 @@ func TestClient_SimulateTransactionAndContext(t *testing.T) {
 		},
 	)
 }
+
+func TestClient_SimulateTransactionWithConfig(t *testing.T) {
+	tx := mustDeserializeBase64Tx(t, "Ab/yMEK7qNgGxaPMg2XaVnwwLMqnY8FTeJrA9qJ1nOBFX08BHycnp3/9WOxOY53+eZnbkT2/+6Mx7w+DsuVN8ggBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4e0EmQh0otX6HS7HumAryrMtxCzacgpjtG6MY9cJWYYEsGZsdWhvaw9ENEPFBEi4eBna4CphPQWWcgU4yARSnVAQEAAA==")
+	client_test.TestAll(
+		t,
+		[]client_test.Param{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"simulateTransaction","params":["Ab/yMEK7qNgGxaPMg2XaVnwwLMqnY8FTeJrA9qJ1nOBFX08BHycnp3/9WOxOY53+eZnbkT2/+6Mx7w+DsuVN8ggBAAECBj5w2ZFXmNyj7tuRN89kxw/6+2LN04KBBSUL12sdbN4e0EmQh0otX6HS7HumAryrMtxCzacgpjtG6MY9cJWYYEsGZsdWhvaw9ENEPFBEi4eBna4CphPQWWcgU4yARSnVAQEAAA==", {"encoding": "base64", "replaceRecentBlockhash": true, "innerInstructions": true}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"apiVersion":"1.18.1","slot":159776096},"value":{"accounts":null,"err":{"InstructionError":[0,{"Custom":1}]},"innerInstructions":[{"index":0,"instructions":[{"accounts":["35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP"],"data":"3Bxs412MvVNQj175","programId":"35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP","stackHeight":2},{"parsed":{"info":{"destination":"35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP","lamports":1,"source":"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"},"type":"transfer"},"program":"system","programId":"11111111111111111111111111111111","stackHeight":2}]}],"logs":["Program 35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP invoke [1]","Program 11111111111111111111111111111111 invoke [2]","Transfer: insufficient lamports 0, need 1","Program 11111111111111111111111111111111 failed: custom program error: 0x1","Program 35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP consumed 300 of 200000 compute units","Program 35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP failed: custom program error: 0x1"],"returnData":null,"unitsConsumed":300}},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewClient(url)
+					return c.SimulateTransactionWithConfig(
+						context.Background(),
+						tx,
+						SimulateTransactionConfig{
+							ReplaceRecentBlockhash: true,
+							InnerInstructions:      true,
+						},
+					)
+				},
+				ExpectedValue: SimulateTransaction{
+					Err: &rpc.TransactionError{Kind: rpc.TransactionErrorInstructionError, InstructionError: &rpc.InstructionError{Kind: rpc.InstructionErrorCustom, Code: 1}},
+					Logs: []string{
+						"Program 35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP invoke [1]",
+						"Program 11111111111111111111111111111111 invoke [2]",
+						"Transfer: insufficient lamports 0, need 1",
+						"Program 11111111111111111111111111111111 failed: custom program error: 0x1",
+						"Program 35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP consumed 300 of 200000 compute units",
+						"Program 35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP failed: custom program error: 0x1",
+					},
+					UnitConsumed: pointer.Get[uint64](300),
+					ParsedLogs: &programlog.Logs{
+						Invocations: []*programlog.Invocation{
+							{
+								ProgramID:            common.PublicKeyFromString("35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP"),
+								Depth:                1,
+								ComputeUnitsConsumed: 300,
+								ComputeUnitsLimit:    200000,
+								Err:                  "custom program error: 0x1",
+								Invocations: []*programlog.Invocation{
+									{
+										ProgramID: common.SystemProgramID,
+										Depth:     2,
+										Logs:      []string{"Transfer: insufficient lamports 0, need 1"},
+										Err:       "custom program error: 0x1",
+									},
+								},
+							},
+						},
+					},
+					InnerInstructions: []SimulateInnerInstruction{
+						{
+							Index: 0,
+							Instructions: []SimulateInstruction{
+								{
+									ProgramID:   common.PublicKeyFromString("35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP"),
+									Accounts:    []common.PublicKey{common.PublicKeyFromString("35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP")},
+									Data:        []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+									StackHeight: pointer.Get[uint64](2),
+								},
+								{
+									ProgramID: common.SystemProgramID,
+									Program:   "system",
+									Parsed: map[string]any{
+										"info": map[string]any{
+											"destination": "35HSbe2xiLfid5QJeETGnUsGhkAiJWRKPrEGdQQ5xXrP",
+											"lamports":    float64(1),
+											"source":      "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+										},
+										"type": "transfer",
+									},
+									StackHeight: pointer.Get[uint64](2),
+								},
+							},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestSimulateTransaction_TransactionError(t *testing.T) {
+	assert.Nil(t, SimulateTransaction{Logs: []string{"Program 11111111111111111111111111111111 success"}}.TransactionError())
+
+	err := SimulateTransaction{
+		Err: &rpc.TransactionError{Kind: rpc.TransactionErrorInstructionError, InstructionError: &rpc.InstructionError{Kind: rpc.InstructionErrorCustom, Code: 1}},
+		Logs: []string{
+			"Program 11111111111111111111111111111111 invoke [1]",
+			"Program 11111111111111111111111111111111 failed: custom program error: 0x1",
+		},
+		UnitConsumed: pointer.Get[uint64](150),
+	}.TransactionError()
+	var simulationErr *SimulationFailedError
+	assert.True(t, errors.As(err, &simulationErr))
+	assert.Equal(t, uint64(150), simulationErr.UnitsConsumed)
+	assert.Equal(t, common.SystemProgramID, simulationErr.CustomError.ProgramID)
+	assert.ErrorIs(t, simulationErr.CustomError, system.ErrResultWithNegativeLamports)
+	// the error unwraps to the transaction error only
+	assert.ErrorIs(t, err, rpc.TransactionErrorInstructionError)
+	assert.ErrorIs(t, err, rpc.InstructionErrorCustom)
+	assert.False(t, errors.Is(err, system.ErrResultWithNegativeLamports))
+
+	err = SimulateTransaction{Err: &rpc.TransactionError{Kind: rpc.TransactionErrorBlockhashNotFound}}.TransactionError()
+	assert.EqualError(t, err, "simulation failed, err: Blockhash not found")
+	assert.ErrorIs(t, err, rpc.TransactionErrorBlockhashNotFound)
+	assert.True(t, errors.As(err, &simulationErr))
+	assert.Nil(t, simulationErr.CustomError)
+	_, ok := ParseCustomError(err)
+	assert.False(t, ok)
+}
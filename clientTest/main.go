@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/blocto/solana-go-sdk/client"
+	"github.com/blocto/solana-go-sdk/common"
 	"github.com/blocto/solana-go-sdk/rpc"
 	"io"
 	"os"
@@ -40,18 +41,18 @@ func getAndSaveFile() {
 		for {
 			slot, err := client.GetSlot(context.Background())
 			if err != nil {
-				fmt.Errorf("GetSlot", err)
+				fmt.Println("GetSlot", err)
 				break
 			}
 
 			for i := curSlot - 1; i < slot; i++ {
 				block, err := client.GetBlock(context.Background(), i)
 				if err != nil {
-					fmt.Errorf("GetConfirmedBlock", err)
+					fmt.Println("GetConfirmedBlock", err)
 					break
 				}
 				var str string
-				if block.Blockhash == "" {
+				if block.Blockhash == (common.Hash{}) {
 					str = fmt.Sprintf("slot:%d ParentSLot:%d PreviousHash:%s size:%d(EMPTY)\n", i, block.ParentSlot, block.PreviousBlockhash, len(block.Transactions))
 				} else {
 					str = fmt.Sprintf("slot:%d ParentSLot:%d PreviousHash:%s size:%d\n", i, block.ParentSlot, block.PreviousBlockhash, len(block.Transactions))
@@ -74,7 +75,7 @@ func getAndSaveFile() {
 	for {
 		slot, err := client.GetSlot(context.Background())
 		if err != nil {
-			fmt.Errorf("GetSlot", err)
+			fmt.Println("GetSlot", err)
 			break
 		}
 		slot -= 20
@@ -82,11 +83,11 @@ func getAndSaveFile() {
 		for i := curSlot - 1; i < slot; i++ {
 			block, err := client.GetBlock(context.Background(), i)
 			if err != nil {
-				fmt.Errorf("GetConfirmedBlock", err)
+				fmt.Println("GetConfirmedBlock", err)
 				break
 			}
 			var str string
-			if block.Blockhash == "" {
+			if block.Blockhash == (common.Hash{}) {
 				str = fmt.Sprintf("slot:%d ParentSLot:%d PreviousHash:%s size:%d(EMPTY)\n", i, block.ParentSlot, block.PreviousBlockhash, len(block.Transactions))
 			} else {
 				str = fmt.Sprintf("slot:%d ParentSLot:%d PreviousHash:%s size:%d\n", i, block.ParentSlot, block.PreviousBlockhash, len(block.Transactions))
@@ -154,7 +155,7 @@ func checkEmptyBlock() {
 			continue
 		}
 		var str string
-		if block.Blockhash == "" {
+		if block.Blockhash == (common.Hash{}) {
 			str = fmt.Sprintf("slot:%d ParentSLot:%d PreviousHash:%s size:%d(EMPTY)\n", height, block.ParentSlot, block.PreviousBlockhash, len(block.Transactions))
 		} else {
 			str = fmt.Sprintf("slot:%d ParentSLot:%d PreviousHash:%s size:%d\n", height, block.ParentSlot, block.PreviousBlockhash, len(block.Transactions))
@@ -169,12 +170,12 @@ func checkBlock() {
 	for {
 		slot, err := client11.GetSlot(context.Background())
 		if err != nil {
-			fmt.Errorf("GetSlot", err)
+			fmt.Println("GetSlot", err)
 			break
 		}
 
 		block, err := client11.GetBlock(context.Background(), slot)
-		if err != nil || block.Blockhash == "" {
+		if err != nil || block.Blockhash == (common.Hash{}) {
 			if rpcErr, ok := err.(*rpc.JsonRpcError); ok {
 				//Slot 被跳过打印日志记录，按正常逻辑处理
 				//目前发现这两种是可以被忽略的情况,
@@ -185,7 +186,7 @@ func checkBlock() {
 		}
 
 		var str string
-		if block.Blockhash == "" {
+		if block.Blockhash == (common.Hash{}) {
 			str = fmt.Sprintf("slot:%d hash:%s ParentSLot:%d PreviousHash:%s size:%d(EMPTY)\n", slot, block.Blockhash, block.ParentSlot, block.PreviousBlockhash, len(block.Transactions))
 		} else {
 			str = fmt.Sprintf("slot:%d hash:%s ParentSLot:%d PreviousHash:%s size:%d\n", slot, block.Blockhash, block.ParentSlot, block.PreviousBlockhash, len(block.Transactions))
@@ -0,0 +1,111 @@
+package common
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mr-tron/base58"
+)
+
+const HashLength = 32
+
+var ErrInvalidHash = errors.New("invalid hash")
+
+// Hash is a sha256 hash, e.g. a blockhash or a durable nonce
+type Hash [HashLength]byte
+
+// ParseHash decodes a base58 hash. it returns ErrInvalidHash if the string isn't base58 or isn't 32 bytes.
+func ParseHash(s string) (Hash, error) {
+	b, err := base58.Decode(s)
+	if err != nil {
+		return Hash{}, fmt.Errorf("%w: %v", ErrInvalidHash, err)
+	}
+	return ParseHashFromBytes(b)
+}
+
+// ParseHashFromBytes returns ErrInvalidHash if the bytes aren't 32 bytes
+func ParseHashFromBytes(b []byte) (Hash, error) {
+	var h Hash
+	if len(b) != HashLength {
+		return h, fmt.Errorf("%w: expected %v bytes, got %v", ErrInvalidHash, HashLength, len(b))
+	}
+	copy(h[:], b)
+	return h, nil
+}
+
+// MustParseHash is ParseHash but it panics on error
+func MustParseHash(s string) Hash {
+	h, err := ParseHash(s)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+func (h Hash) String() string {
+	return base58.Encode(h[:])
+}
+
+func (h Hash) Bytes() []byte {
+	return h[:]
+}
+
+func (h Hash) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+func (h *Hash) UnmarshalText(text []byte) error {
+	v, err := ParseHash(string(text))
+	if err != nil {
+		return err
+	}
+	*h = v
+	return nil
+}
+
+func (h Hash) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
+
+func (h *Hash) UnmarshalJSON(data []byte) error {
+	var s string
+	// a null leaves it unchanged like encoding/json does
+	if string(data) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return h.UnmarshalText([]byte(s))
+}
+
+// Value stores the hash as a base58 string
+func (h Hash) Value() (driver.Value, error) {
+	return h.String(), nil
+}
+
+// Scan reads a base58 string, a null is the zero hash
+func (h *Hash) Scan(src any) error {
+	s, ok, err := scanString(src)
+	if err != nil || !ok {
+		*h = Hash{}
+		return err
+	}
+	return h.UnmarshalText([]byte(s))
+}
+
+// scanString converts the src of sql.Scanner to a string. ok is false for a null.
+func scanString(src any) (s string, ok bool, err error) {
+	switch v := src.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return v, true, nil
+	case []byte:
+		return string(v), true, nil
+	default:
+		return "", false, fmt.Errorf("unsupported scan type %T", src)
+	}
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ encoding.TextMarshaler   = Hash{}
+	_ encoding.TextUnmarshaler = (*Hash)(nil)
+	_ driver.Valuer            = Hash{}
+	_ sql.Scanner              = (*Hash)(nil)
+)
+
+func TestParseHash(t *testing.T) {
+	h, err := ParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5")
+	assert.Nil(t, err)
+	assert.Equal(t, "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5", h.String())
+
+	for _, s := range []string{"", "0", "FwRYtT", "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5123"} {
+		_, err := ParseHash(s)
+		assert.ErrorIs(t, err, ErrInvalidHash, s)
+	}
+
+	_, err = ParseHashFromBytes(make([]byte, 31))
+	assert.ErrorIs(t, err, ErrInvalidHash)
+	assert.Equal(t, "11111111111111111111111111111111", Hash{}.String())
+	assert.Panics(t, func() { MustParseHash("0") })
+}
+
+func TestHash_JSON(t *testing.T) {
+	type A struct {
+		H  Hash  `json:"hash"`
+		P  *Hash `json:"p"`
+		Hs []Hash
+	}
+	a1 := A{H: MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"), Hs: []Hash{{}}}
+	b, err := json.Marshal(a1)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"hash":"FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5","p":null,"Hs":["11111111111111111111111111111111"]}`, string(b))
+
+	var a2 A
+	assert.Nil(t, json.Unmarshal(b, &a2))
+	assert.Equal(t, a1, a2)
+
+	var a3 A
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"hash":"0"}`), &a3), ErrInvalidHash)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"hash":"EvN4"}`), &a3), ErrInvalidHash)
+	assert.Nil(t, json.Unmarshal([]byte(`{"hash":null}`), &a3))
+}
+
+func TestHash_SQL(t *testing.T) {
+	h := MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5")
+	v, err := h.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5", v)
+
+	var got Hash
+	assert.Nil(t, got.Scan(v))
+	assert.Equal(t, h, got)
+	assert.Nil(t, got.Scan([]byte("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5")))
+	assert.Equal(t, h, got)
+	assert.Nil(t, got.Scan(nil))
+	assert.Equal(t, Hash{}, got)
+	assert.ErrorIs(t, got.Scan("0"), ErrInvalidHash)
+	assert.NotNil(t, got.Scan(1))
+}
@@ -17,17 +17,52 @@ const (
 	MaxSeed         = 16
 )
 
+var ErrInvalidPublicKey = errors.New("invalid public key")
+
 type PublicKey [PublicKeyLength]byte
 
 func (p PublicKey) String() string {
 	return p.ToBase58()
 }
 
+// ParsePublicKey decodes a base58 public key. it returns ErrInvalidPublicKey if the string isn't
+// base58 or isn't 32 bytes.
+func ParsePublicKey(s string) (PublicKey, error) {
+	b, err := base58.Decode(s)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	return ParsePublicKeyFromBytes(b)
+}
+
+// ParsePublicKeyFromBytes returns ErrInvalidPublicKey if the bytes aren't 32 bytes
+func ParsePublicKeyFromBytes(b []byte) (PublicKey, error) {
+	var pubkey PublicKey
+	if len(b) != PublicKeyLength {
+		return pubkey, fmt.Errorf("%w: expected %v bytes, got %v", ErrInvalidPublicKey, PublicKeyLength, len(b))
+	}
+	copy(pubkey[:], b)
+	return pubkey, nil
+}
+
+// MustParsePublicKey is ParsePublicKey but it panics on error
+func MustParsePublicKey(s string) PublicKey {
+	pubkey, err := ParsePublicKey(s)
+	if err != nil {
+		panic(err)
+	}
+	return pubkey
+}
+
+// PublicKeyFromString ignores the decode error and pads or truncates like PublicKeyFromBytes.
+// use ParsePublicKey for the input which isn't trusted.
 func PublicKeyFromString(s string) PublicKey {
 	d, _ := base58.Decode(s)
 	return PublicKeyFromBytes(d)
 }
 
+// PublicKeyFromBytes left-pads the bytes shorter than 32 bytes and truncates the longer ones.
+// use ParsePublicKeyFromBytes for the input which isn't trusted.
 func PublicKeyFromBytes(b []byte) PublicKey {
 	var pubkey PublicKey
 	if len(b) > PublicKeyLength {
@@ -300,3 +300,22 @@ func TestPublicKey_JSON(t *testing.T) {
 	err = json.Unmarshal([]byte(`{"pubkey":"EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx123"}`), &a4)
 	assert.Equal(t, err, errors.New("a valid pubkey should be a 32-byte array. got: 34"))
 }
+
+func TestParsePublicKey(t *testing.T) {
+	got, err := ParsePublicKey("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	assert.Nil(t, err)
+	assert.Equal(t, PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), got)
+
+	for _, s := range []string{"", "0", "EvN4kg", "EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx123"} {
+		_, err := ParsePublicKey(s)
+		assert.ErrorIs(t, err, ErrInvalidPublicKey, s)
+	}
+
+	_, err = ParsePublicKeyFromBytes([]byte{1})
+	assert.ErrorIs(t, err, ErrInvalidPublicKey)
+	got, err = ParsePublicKeyFromBytes(make([]byte, 32))
+	assert.Nil(t, err)
+	assert.Equal(t, PublicKey{}, got)
+
+	assert.Panics(t, func() { MustParsePublicKey("0") })
+}
@@ -0,0 +1,97 @@
+package common
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mr-tron/base58"
+)
+
+const SignatureLength = 64
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Signature is an ed25519 signature, the first signature of a transaction is its id
+type Signature [SignatureLength]byte
+
+// ParseSignature decodes a base58 signature. it returns ErrInvalidSignature if the string isn't base58 or isn't 64 bytes.
+func ParseSignature(s string) (Signature, error) {
+	b, err := base58.Decode(s)
+	if err != nil {
+		return Signature{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return ParseSignatureFromBytes(b)
+}
+
+// ParseSignatureFromBytes returns ErrInvalidSignature if the bytes aren't 64 bytes
+func ParseSignatureFromBytes(b []byte) (Signature, error) {
+	var sig Signature
+	if len(b) != SignatureLength {
+		return sig, fmt.Errorf("%w: expected %v bytes, got %v", ErrInvalidSignature, SignatureLength, len(b))
+	}
+	copy(sig[:], b)
+	return sig, nil
+}
+
+// MustParseSignature is ParseSignature but it panics on error
+func MustParseSignature(s string) Signature {
+	sig, err := ParseSignature(s)
+	if err != nil {
+		panic(err)
+	}
+	return sig
+}
+
+func (s Signature) String() string {
+	return base58.Encode(s[:])
+}
+
+func (s Signature) Bytes() []byte {
+	return s[:]
+}
+
+func (s Signature) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *Signature) UnmarshalText(text []byte) error {
+	v, err := ParseSignature(string(text))
+	if err != nil {
+		return err
+	}
+	*s = v
+	return nil
+}
+
+func (s Signature) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (s *Signature) UnmarshalJSON(data []byte) error {
+	var str string
+	// a null leaves it unchanged like encoding/json does
+	if string(data) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return s.UnmarshalText([]byte(str))
+}
+
+// Value stores the signature as a base58 string
+func (s Signature) Value() (driver.Value, error) {
+	return s.String(), nil
+}
+
+// Scan reads a base58 string, a null is the zero signature
+func (s *Signature) Scan(src any) error {
+	str, ok, err := scanString(src)
+	if err != nil || !ok {
+		*s = Signature{}
+		return err
+	}
+	return s.UnmarshalText([]byte(str))
+}
@@ -0,0 +1,71 @@
+package common
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ encoding.TextMarshaler   = Signature{}
+	_ encoding.TextUnmarshaler = (*Signature)(nil)
+	_ driver.Valuer            = Signature{}
+	_ sql.Scanner              = (*Signature)(nil)
+)
+
+const testSignature = "4bDVpjM6Dwg5fn7JcJx5kd47X7oUmm34cLkNxtV9jq5sjfwH3ysaLZ8L1wrFKPhu8A9cBLUtsBYPZTsqsdzYpxHD"
+
+func TestParseSignature(t *testing.T) {
+	sig, err := ParseSignature(testSignature)
+	assert.Nil(t, err)
+	assert.Equal(t, testSignature, sig.String())
+
+	for _, s := range []string{"", "0", "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"} {
+		_, err := ParseSignature(s)
+		assert.ErrorIs(t, err, ErrInvalidSignature, s)
+	}
+
+	_, err = ParseSignatureFromBytes(make([]byte, 32))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	got, err := ParseSignatureFromBytes(sig.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, sig, got)
+	assert.Panics(t, func() { MustParseSignature("0") })
+}
+
+func TestSignature_JSON(t *testing.T) {
+	type A struct {
+		S Signature `json:"signature"`
+	}
+	a1 := A{S: MustParseSignature(testSignature)}
+	b, err := json.Marshal(a1)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"signature":"`+testSignature+`"}`, string(b))
+
+	var a2 A
+	assert.Nil(t, json.Unmarshal(b, &a2))
+	assert.Equal(t, a1, a2)
+
+	var a3 A
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"signature":"FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"}`), &a3), ErrInvalidSignature)
+}
+
+func TestSignature_SQL(t *testing.T) {
+	sig := MustParseSignature(testSignature)
+	v, err := sig.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, testSignature, v)
+
+	var got Signature
+	assert.Nil(t, got.Scan(v))
+	assert.Equal(t, sig, got)
+	assert.Nil(t, got.Scan([]byte(testSignature)))
+	assert.Equal(t, sig, got)
+	assert.Nil(t, got.Scan(nil))
+	assert.Equal(t, Signature{}, got)
+	assert.ErrorIs(t, got.Scan("0"), ErrInvalidSignature)
+}
@@ -34,7 +34,7 @@ func main() {
 		Signers: []types.Account{feePayer, alice},
 		Message: types.NewMessage(types.NewMessageParam{
 			FeePayer:        feePayer.PublicKey,
-			RecentBlockhash: nonceAccount.Nonce.ToBase58(),
+			RecentBlockhash: common.Hash(nonceAccount.Nonce),
 			Instructions: []types.Instruction{
 				system.AdvanceNonceAccount(system.AdvanceNonceAccountParam{
 					Nonce: nonceAccountPubkey,
@@ -4,6 +4,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,3 +48,61 @@ func Test(t *testing.T, param Param) {
 
 	server.Close()
 }
+
+// SequenceParam describes a test which sends several requests in order
+type SequenceParam struct {
+	Name           string
+	RequestBodies  []string
+	ResponseBodies []string
+	F              func(url string) (any, error)
+	ExpectedValue  any
+	ExpectedError  error
+}
+
+func TestAllSequence(t *testing.T, params []SequenceParam) {
+	for _, param := range params {
+		t.Run(param.Name, func(t *testing.T) {
+			TestSequence(t, param)
+		})
+	}
+}
+
+func TestSequence(t *testing.T, param SequenceParam) {
+	var mu sync.Mutex
+	idx := 0
+
+	// setup test server
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if idx >= len(param.RequestBodies) {
+			t.Errorf("unexpected request #%d", idx+1)
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		// check request body match
+		body, err := io.ReadAll(req.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, param.RequestBodies[idx], string(body), "request #%d", idx+1)
+
+		// check write response body success
+		n, err := rw.Write([]byte(param.ResponseBodies[idx]))
+		assert.Nil(t, err)
+		assert.Equal(t, len([]byte(param.ResponseBodies[idx])), n)
+
+		idx++
+	}))
+
+	// test function
+	got, err := param.F(server.URL)
+	assert.Equal(t, param.ExpectedValue, got)
+	assert.Equal(t, param.ExpectedError, err)
+
+	mu.Lock()
+	assert.Equal(t, len(param.RequestBodies), idx, "not all requests are sent")
+	mu.Unlock()
+
+	server.Close()
+}
@@ -2,41 +2,102 @@ package bytes_decoder
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 )
 
-func GetUint64(curr *int, data []byte) (uint64, error) {
+var (
+	ErrInvalidIndex     = errors.New("invalid index")
+	ErrInsufficientData = errors.New("insufficient data length")
+	ErrTrailingBytes    = errors.New("trailing bytes")
+)
+
+// next returns the next n bytes and moves the index after them
+func next(curr *int, data []byte, n int) ([]byte, error) {
 	if curr == nil {
-		return 0, fmt.Errorf("index is nil")
+		return nil, fmt.Errorf("%w: index is nil", ErrInvalidIndex)
 	}
-	if data == nil {
-		return 0, fmt.Errorf("data is nil")
+	if *curr < 0 || *curr > len(data) {
+		return nil, fmt.Errorf("%w: %v, data length: %v", ErrInvalidIndex, *curr, len(data))
 	}
-	if len(data[*curr:]) < 8 {
-		return 0, fmt.Errorf("insufficient data length")
+	if n < 0 || len(data)-*curr < n {
+		return nil, fmt.Errorf("%w: need %v bytes from %v, data length: %v", ErrInsufficientData, n, *curr, len(data))
 	}
 
-	v := binary.LittleEndian.Uint64(data[*curr : *curr+8])
-	*curr += 8
+	v := data[*curr : *curr+n]
+	*curr += n
 
 	return v, nil
 }
 
-func GetBytes32(curr *int, data []byte) ([32]byte, error) {
-	var v [32]byte
+func GetUint8(curr *int, data []byte) (uint8, error) {
+	b, err := next(curr, data, 1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
 
-	if curr == nil {
-		return v, fmt.Errorf("index is nil")
+func GetUint16(curr *int, data []byte) (uint16, error) {
+	b, err := next(curr, data, 2)
+	if err != nil {
+		return 0, err
 	}
-	if data == nil {
-		return v, fmt.Errorf("data is nil")
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func GetUint32(curr *int, data []byte) (uint32, error) {
+	b, err := next(curr, data, 4)
+	if err != nil {
+		return 0, err
 	}
-	if len(data[*curr:]) < 32 {
-		return v, fmt.Errorf("insufficient data length")
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func GetUint64(curr *int, data []byte) (uint64, error) {
+	b, err := next(curr, data, 8)
+	if err != nil {
+		return 0, err
 	}
+	return binary.LittleEndian.Uint64(b), nil
+}
 
-	copy(v[:], data[*curr:*curr+32])
-	*curr += 32
+func GetBytes32(curr *int, data []byte) ([32]byte, error) {
+	var v [32]byte
+
+	b, err := next(curr, data, 32)
+	if err != nil {
+		return v, err
+	}
+	copy(v[:], b)
 
 	return v, nil
 }
+
+// GetBytes returns the next n bytes, it shares the memory with the data
+func GetBytes(curr *int, data []byte, n int) ([]byte, error) {
+	return next(curr, data, n)
+}
+
+// CheckCount checks the rest of the data has enough bytes for count items of size bytes.
+// call it before making a slice by a count from the data.
+func CheckCount(curr *int, data []byte, count uint64, size int) error {
+	if _, err := next(curr, data, 0); err != nil {
+		return err
+	}
+	if size > 0 && count > uint64((len(data)-*curr)/size) {
+		return fmt.Errorf("%w: %v items of %v bytes from %v, data length: %v", ErrInsufficientData, count, size, *curr, len(data))
+	}
+	return nil
+}
+
+// CheckEnd returns ErrTrailingBytes if the index isn't at the end of the data
+func CheckEnd(curr *int, data []byte) error {
+	if _, err := next(curr, data, 0); err != nil {
+		return err
+	}
+	if *curr != len(data) {
+		return fmt.Errorf("%w: %v bytes", ErrTrailingBytes, len(data)-*curr)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package bytes_decoder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoder(t *testing.T) {
+	data := []byte{1, 2, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 4, 5}
+	current := 0
+
+	u8, err := GetUint8(&current, data)
+	assert.Nil(t, err)
+	assert.Equal(t, uint8(1), u8)
+
+	u32, err := GetUint32(&current, data)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), u32)
+
+	u64, err := GetUint64(&current, data)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), u64)
+
+	assert.ErrorIs(t, CheckEnd(&current, data), ErrTrailingBytes)
+	assert.ErrorIs(t, CheckCount(&current, data, 3, 1), ErrInsufficientData)
+	assert.Nil(t, CheckCount(&current, data, 2, 1))
+
+	_, err = GetBytes32(&current, data)
+	assert.ErrorIs(t, err, ErrInsufficientData)
+	assert.Equal(t, 13, current)
+
+	b, err := GetBytes(&current, data, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{4, 5}, b)
+	assert.Nil(t, CheckEnd(&current, data))
+
+	_, err = GetUint8(&current, data)
+	assert.ErrorIs(t, err, ErrInsufficientData)
+
+	current = 100
+	_, err = GetUint64(&current, data)
+	assert.ErrorIs(t, err, ErrInvalidIndex)
+
+	current = -1
+	_, err = GetUint8(&current, data)
+	assert.ErrorIs(t, err, ErrInvalidIndex)
+
+	_, err = GetUint8(nil, data)
+	assert.ErrorIs(t, err, ErrInvalidIndex)
+
+	current = 0
+	_, err = GetBytes(&current, data, -1)
+	assert.ErrorIs(t, err, ErrInsufficientData)
+
+	current = 13
+	u16, err := GetUint16(&current, data)
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(0x0504), u16)
+	_, err = GetUint16(&current, data)
+	assert.ErrorIs(t, err, ErrInsufficientData)
+}
+
+func FuzzDecoder(f *testing.F) {
+	f.Add([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9}, 0, 4)
+	f.Fuzz(func(t *testing.T, data []byte, current int, n int) {
+		start := current
+		if _, err := GetBytes(&current, data, n); err != nil {
+			if current != start {
+				t.Fatalf("the index moves on error")
+			}
+			return
+		}
+		if current != start+n || current > len(data) {
+			t.Fatalf("unexpected index %v, start: %v, n: %v", current, start, n)
+		}
+		_, _ = GetUint64(&current, data)
+		_ = CheckCount(&current, data, uint64(n), n)
+		_ = CheckEnd(&current, data)
+	})
+}
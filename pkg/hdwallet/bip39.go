@@ -0,0 +1,190 @@
+package hdwallet
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidEntropySize      = errors.New("entropy size should be a multiple of 32 bits between 128 and 256")
+	ErrInvalidWordCount        = errors.New("word count should be 12, 15, 18, 21 or 24")
+	ErrInvalidMnemonicWord     = errors.New("invalid mnemonic word")
+	ErrInvalidMnemonicChecksum = errors.New("invalid mnemonic checksum")
+)
+
+//go:embed bip39_english.txt
+var english string
+
+// EnglishWordlist is the bip39 english wordlist
+var EnglishWordlist = strings.Split(strings.TrimSpace(english), "\n")
+
+var englishWordIndex = func() map[string]int {
+	m := make(map[string]int, len(EnglishWordlist))
+	for i, word := range EnglishWordlist {
+		m[word] = i
+	}
+	return m
+}()
+
+const (
+	seedIterations = 2048
+	seedLength     = 64
+)
+
+// NewEntropy returns random entropy of the bit size, 128 bits for 12 words and 256 bits for 24 words
+func NewEntropy(bitSize int) ([]byte, error) {
+	if !isValidEntropyBitSize(bitSize) {
+		return nil, ErrInvalidEntropySize
+	}
+	entropy := make([]byte, bitSize/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, fmt.Errorf("failed to read random entropy, err: %v", err)
+	}
+	return entropy, nil
+}
+
+// GenerateMnemonic returns a random mnemonic of the word count
+func GenerateMnemonic(wordCount int) (string, error) {
+	if wordCount%3 != 0 || wordCount < 12 || wordCount > 24 {
+		return "", ErrInvalidWordCount
+	}
+	entropy, err := NewEntropy(wordCount / 3 * 32)
+	if err != nil {
+		return "", err
+	}
+	return NewMnemonic(entropy)
+}
+
+// NewMnemonic encodes the entropy with its checksum into english words
+func NewMnemonic(entropy []byte) (string, error) {
+	bitSize := len(entropy) * 8
+	if !isValidEntropyBitSize(bitSize) {
+		return "", ErrInvalidEntropySize
+	}
+	checksumBitSize := bitSize / 32
+	hash := sha256.Sum256(entropy)
+
+	// the checksum is at most 8 bits so it always fits in the first byte of the hash
+	bits := make([]byte, 0, len(entropy)+1)
+	bits = append(bits, entropy...)
+	bits = append(bits, hash[0])
+
+	wordCount := (bitSize + checksumBitSize) / 11
+	words := make([]string, 0, wordCount)
+	for i := 0; i < wordCount; i++ {
+		words = append(words, EnglishWordlist[readBits(bits, i*11, 11)])
+	}
+	return strings.Join(words, " "), nil
+}
+
+// MnemonicToEntropy decodes the mnemonic back to its entropy and checks the checksum
+func MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words)%3 != 0 || len(words) < 12 || len(words) > 24 {
+		return nil, ErrInvalidWordCount
+	}
+
+	bits := make([]byte, (len(words)*11+7)/8)
+	for i, word := range words {
+		index, ok := englishWordIndex[word]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidMnemonicWord, word)
+		}
+		writeBits(bits, i*11, 11, uint32(index))
+	}
+
+	bitSize := len(words) * 11 * 32 / 33
+	checksumBitSize := bitSize / 32
+	entropy := bits[:bitSize/8]
+	hash := sha256.Sum256(entropy)
+	if readBits(bits, bitSize, checksumBitSize) != uint32(hash[0]>>(8-checksumBitSize)) {
+		return nil, ErrInvalidMnemonicChecksum
+	}
+	return append([]byte{}, entropy...), nil
+}
+
+// ValidateMnemonic checks the words and the checksum of the mnemonic
+func ValidateMnemonic(mnemonic string) error {
+	_, err := MnemonicToEntropy(mnemonic)
+	return err
+}
+
+// NewSeed derives the 64 bytes seed from the mnemonic and an optional passphrase. it doesn't validate
+// the mnemonic, call ValidateMnemonic first if the mnemonic comes from a user.
+// the passphrase is expected to be NFKD normalized.
+func NewSeed(mnemonic, passphrase string) []byte {
+	return pbkdf2SHA512(
+		[]byte(strings.Join(strings.Fields(mnemonic), " ")),
+		[]byte("mnemonic"+passphrase),
+		seedIterations,
+		seedLength,
+	)
+}
+
+// NewSeedWithErrorChecking is NewSeed which validates the mnemonic first
+func NewSeedWithErrorChecking(mnemonic, passphrase string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	return NewSeed(mnemonic, passphrase), nil
+}
+
+func isValidEntropyBitSize(bitSize int) bool {
+	return bitSize%32 == 0 && bitSize >= 128 && bitSize <= 256
+}
+
+// readBits reads n (<= 32) big endian bits from the offset
+func readBits(b []byte, offset, n int) uint32 {
+	var v uint32
+	for i := 0; i < n; i++ {
+		bit := (b[(offset+i)/8] >> (7 - uint((offset+i)%8))) & 1
+		v = v<<1 | uint32(bit)
+	}
+	return v
+}
+
+// writeBits writes the lowest n bits of v big endian to the offset
+func writeBits(b []byte, offset, n int, v uint32) {
+	for i := 0; i < n; i++ {
+		if (v>>(uint(n-1-i)))&1 == 1 {
+			b[(offset+i)/8] |= 1 << (7 - uint((offset+i)%8))
+		}
+	}
+}
+
+// pbkdf2SHA512 is PBKDF2 (RFC 8018) with HMAC-SHA512
+func pbkdf2SHA512(password, salt []byte, iterations, keyLength int) []byte {
+	prf := hmac.New(sha512.New, password)
+	hashLength := prf.Size()
+	blockCount := (keyLength + hashLength - 1) / hashLength
+
+	key := make([]byte, 0, blockCount*hashLength)
+	buf := make([]byte, 4)
+	for block := 1; block <= blockCount; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		binary.BigEndian.PutUint32(buf, uint32(block))
+		prf.Write(buf)
+		u := prf.Sum(nil)
+
+		t := make([]byte, len(u))
+		copy(t, u)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+	return key[:keyLength]
+}
@@ -0,0 +1,159 @@
+package hdwallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMnemonic(t *testing.T) {
+	type args struct {
+		entropy []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			args: args{
+				entropy: mustDecodeHex("00000000000000000000000000000000"),
+			},
+			want: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		},
+		{
+			args: args{
+				entropy: mustDecodeHex("7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f"),
+			},
+			want: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		},
+		{
+			args: args{
+				entropy: mustDecodeHex("0000000000000000000000000000000000000000000000000000000000000000"),
+			},
+			want: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		},
+		{
+			args: args{
+				entropy: mustDecodeHex("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+			},
+			want: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+		},
+		{
+			args: args{
+				entropy: mustDecodeHex("0000000000000000000000000000"),
+			},
+			wantErr: ErrInvalidEntropySize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewMnemonic(tt.args.entropy)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMnemonicToEntropy(t *testing.T) {
+	type args struct {
+		mnemonic string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr error
+	}{
+		{
+			args: args{
+				mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+			},
+			want: mustDecodeHex("7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f"),
+		},
+		{
+			args: args{
+				mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+			},
+			want: mustDecodeHex("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+		},
+		{
+			args: args{
+				mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+			},
+			wantErr: ErrInvalidMnemonicChecksum,
+		},
+		{
+			args: args{
+				mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon solana",
+			},
+			wantErr: ErrInvalidMnemonicWord,
+		},
+		{
+			args: args{
+				mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			},
+			wantErr: ErrInvalidWordCount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MnemonicToEntropy(tt.args.mnemonic)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewSeed(t *testing.T) {
+	type args struct {
+		mnemonic   string
+		passphrase string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []byte
+	}{
+		{
+			args: args{
+				mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+				passphrase: "TREZOR",
+			},
+			want: mustDecodeHex("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04"),
+		},
+		{
+			args: args{
+				mnemonic:   "legal winner thank year wave sausage worth useful legal winner thank yellow",
+				passphrase: "TREZOR",
+			},
+			want: mustDecodeHex("2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607"),
+		},
+		{
+			args: args{
+				mnemonic:   "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+				passphrase: "TREZOR",
+			},
+			want: mustDecodeHex("dd48c104698c30cfe2b6142103248622fb7bb0ff692eebb00089b32d22484e1613912f0a5b694407be899ffd31ed3992c456cdf60f5d4564b8ba3f05a69890ad"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewSeed(tt.args.mnemonic, tt.args.passphrase))
+		})
+	}
+}
+
+func TestGenerateMnemonic(t *testing.T) {
+	for _, wordCount := range []int{12, 15, 18, 21, 24} {
+		mnemonic, err := GenerateMnemonic(wordCount)
+		assert.Nil(t, err)
+		assert.Len(t, strings.Fields(mnemonic), wordCount)
+		assert.Nil(t, ValidateMnemonic(mnemonic))
+	}
+
+	_, err := GenerateMnemonic(13)
+	assert.ErrorIs(t, err, ErrInvalidWordCount)
+}
@@ -0,0 +1,66 @@
+package address_lookup_table
+
+import (
+	"fmt"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+func init() {
+	instructiondecoder.Register(common.AddressLookupTableProgramID, "address-lookup-table", decodeInstruction)
+}
+
+func decodeInstruction(instruction types.Instruction) (instructiondecoder.Instruction, error) {
+	r := instructiondecoder.NewReader(instruction.Data)
+	build := func(name string, names []string, param func(k []common.PublicKey) any) (instructiondecoder.Instruction, error) {
+		return instructiondecoder.Build(r, instruction.Accounts, name, names, "", param)
+	}
+
+	switch i := Instruction(r.Uint32()); i {
+	case InstructionCreateLookupTable:
+		recentSlot, bumpSeed := r.Uint64(), r.Uint8()
+		return build("CreateLookupTable", []string{"lookupTable", "authority", "payer", "systemProgram"}, func(k []common.PublicKey) any {
+			return CreateLookupTableParams{LookupTable: k[0], Authority: k[1], Payer: k[2], RecentSlot: recentSlot, BumpSeed: bumpSeed}
+		})
+	case InstructionFreezeLookupTable:
+		return build("FreezeLookupTable", []string{"lookupTable", "authority"}, func(k []common.PublicKey) any {
+			return FreezeLookupTableParams{LookupTable: k[0], Authority: k[1]}
+		})
+	case InstructionExtendLookupTable:
+		n := r.Uint64()
+		if r.Err() == nil && n > uint64(r.Len()/common.PublicKeyLength) {
+			return instructiondecoder.Instruction{}, fmt.Errorf("%w: %v addresses exceed the data", instructiondecoder.ErrInvalidInstructionData, n)
+		}
+		var addresses []common.PublicKey
+		for j := uint64(0); j < n; j++ {
+			addresses = append(addresses, r.PublicKey())
+		}
+		names := []string{"lookupTable", "authority"}
+		// the payer is only passed when the table needs more lamports
+		if len(instruction.Accounts) > 2 {
+			names = append(names, "payer", "systemProgram")
+		}
+		return build("ExtendLookupTable", names, func(k []common.PublicKey) any {
+			var payer *common.PublicKey
+			if len(k) > 2 {
+				payer = &k[2]
+			}
+			return ExtendLookupTableParams{LookupTable: k[0], Authority: k[1], Payer: payer, Addresses: addresses}
+		})
+	case InstructionDeactivateLookupTable:
+		return build("DeactivateLookupTable", []string{"lookupTable", "authority"}, func(k []common.PublicKey) any {
+			return DeactivateLookupTableParams{LookupTable: k[0], Authority: k[1]}
+		})
+	case InstructionCloseLookupTable:
+		return build("CloseLookupTable", []string{"lookupTable", "authority", "recipient"}, func(k []common.PublicKey) any {
+			return CloseLookupTableParams{LookupTable: k[0], Authority: k[1], Recipient: k[2]}
+		})
+	default:
+		if err := r.Err(); err != nil {
+			return instructiondecoder.Instruction{}, err
+		}
+		return instructiondecoder.Instruction{}, fmt.Errorf("%w: %v", instructiondecoder.ErrUnknownInstruction, i)
+	}
+}
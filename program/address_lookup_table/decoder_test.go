@@ -0,0 +1,51 @@
+package address_lookup_table
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeInstruction(t *testing.T) {
+	a := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	b := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	c := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		param       any
+	}{
+		{"CreateLookupTable", CreateLookupTable(CreateLookupTableParams{LookupTable: a, Authority: b, Payer: c, RecentSlot: 100, BumpSeed: 255}), CreateLookupTableParams{LookupTable: a, Authority: b, Payer: c, RecentSlot: 100, BumpSeed: 255}},
+		{"FreezeLookupTable", FreezeLookupTable(FreezeLookupTableParams{LookupTable: a, Authority: b}), FreezeLookupTableParams{LookupTable: a, Authority: b}},
+		{"ExtendLookupTable", ExtendLookupTable(ExtendLookupTableParams{LookupTable: a, Authority: b, Addresses: []common.PublicKey{b, c}}), ExtendLookupTableParams{LookupTable: a, Authority: b, Addresses: []common.PublicKey{b, c}}},
+		{"ExtendLookupTable", ExtendLookupTable(ExtendLookupTableParams{LookupTable: a, Authority: b, Payer: &c, Addresses: []common.PublicKey{c}}), ExtendLookupTableParams{LookupTable: a, Authority: b, Payer: &c, Addresses: []common.PublicKey{c}}},
+		{"DeactivateLookupTable", DeactivateLookupTable(DeactivateLookupTableParams{LookupTable: a, Authority: b}), DeactivateLookupTableParams{LookupTable: a, Authority: b}},
+		{"CloseLookupTable", CloseLookupTable(CloseLookupTableParams{LookupTable: a, Authority: b, Recipient: c}), CloseLookupTableParams{LookupTable: a, Authority: b, Recipient: c}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instructiondecoder.Decode(tt.instruction)
+			assert.Nil(t, err)
+			assert.Equal(t, "address-lookup-table", got.Program)
+			assert.Equal(t, tt.name, got.Name)
+			assert.Equal(t, tt.param, got.Param)
+			assert.Len(t, got.Accounts, len(tt.instruction.Accounts))
+			for i, account := range got.Accounts {
+				assert.NotEmpty(t, account.Name)
+				assert.Equal(t, tt.instruction.Accounts[i], account.AccountMeta)
+			}
+		})
+	}
+}
+
+func TestDecodeInstruction_Failed(t *testing.T) {
+	// claims a billion addresses
+	data := []byte{2, 0, 0, 0, 0, 0xca, 0x9a, 0x3b, 0, 0, 0, 0}
+	_, err := instructiondecoder.Decode(types.Instruction{ProgramID: common.AddressLookupTableProgramID, Data: data})
+	assert.True(t, errors.Is(err, instructiondecoder.ErrInvalidInstructionData), err)
+}
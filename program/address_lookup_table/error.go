@@ -1,9 +1,19 @@
 package address_lookup_table
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/programerror"
+)
 
 var (
 	ErrInvalidAccountOwner    = errors.New("invalid account owner")
 	ErrInvalidAccountDataSize = errors.New("invalid account data size")
 	ErrInvalidAccountData     = errors.New("invalid account data")
 )
+
+func init() {
+	// the address lookup table program only fails with builtin instruction errors
+	programerror.Register(common.AddressLookupTableProgramID, map[uint32]error{})
+}
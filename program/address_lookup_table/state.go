@@ -72,17 +72,21 @@ func DeserializeLookupTable(data []byte, accountOwner common.PublicKey) (Address
 		addressLookupTable.LastExtendedSlotStartIndex = data[current]
 		current += 1
 
+		// the authority is an option of a pubkey, its 32 bytes are kept even if it is none
 		some := bool(data[current] == 1)
 		current += 1
 		if some {
 			pubkey := common.PublicKeyFromBytes(data[current : current+32])
-			current += 32
 			addressLookupTable.Authority = &pubkey
 		}
+		current += 32
 
 		addressLookupTable.padding = binary.LittleEndian.Uint16(data[current : current+2])
 		current += 2
 
+		if (len(data)-current)%32 != 0 {
+			return AddressLookupTable{}, ErrInvalidAccountDataSize
+		}
 		l := (len(data) - current) / 32
 		addresses := make([]common.PublicKey, 0, l)
 		for i := 0; i < l; i++ {
@@ -96,6 +96,43 @@ func TestDeserializeLookupTable(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "frozen",
+			args: args{
+				data:         []byte{1, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255, 230, 107, 61, 9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127, 96, 107, 250, 152, 133, 208, 224, 73, 251, 113, 151, 128, 139, 86, 80, 101, 70, 138, 50, 141, 153, 218, 110, 56, 39, 122, 181, 120, 55, 86, 185},
+				accountOwner: common.AddressLookupTableProgramID,
+			},
+			want: AddressLookupTable{
+				ProgramState:               ProgramStateLookupTable,
+				DeactivationSlot:           ^uint64(0),
+				LastExtendedSlot:           155020262,
+				LastExtendedSlotStartIndex: 0,
+				Authority:                  nil,
+				padding:                    0,
+				Addresses: []common.PublicKey{
+					common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "truncated meta",
+			args: args{
+				data:         []byte{1, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 215, 20},
+				accountOwner: common.AddressLookupTableProgramID,
+			},
+			want:    AddressLookupTable{},
+			wantErr: ErrInvalidAccountDataSize,
+		},
+		{
+			name: "truncated address",
+			args: args{
+				data:         []byte{1, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 215, 20, 147, 30, 186, 106, 25, 168, 244, 220, 108, 1, 154, 255, 38, 79, 95, 191, 104, 197, 162, 142, 224, 179, 185, 135, 85, 206, 57, 214, 73, 211, 0, 0, 127, 96, 107},
+				accountOwner: common.AddressLookupTableProgramID,
+			},
+			want:    AddressLookupTable{},
+			wantErr: ErrInvalidAccountDataSize,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -105,3 +142,11 @@ func TestDeserializeLookupTable(t *testing.T) {
 		})
 	}
 }
+
+func FuzzDeserializeLookupTable(f *testing.F) {
+	f.Add([]byte{1, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 215, 20, 147, 30, 186, 106, 25, 168, 244, 220, 108, 1, 154, 255, 38, 79, 95, 191, 104, 197, 162, 142, 224, 179, 185, 135, 85, 206, 57, 214, 73, 211, 0, 0})
+	f.Add([]byte{0, 0, 0, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = DeserializeLookupTable(data, common.AddressLookupTableProgramID)
+	})
+}
@@ -0,0 +1,55 @@
+package associated_token_account
+
+import (
+	"fmt"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+func init() {
+	instructiondecoder.Register(common.SPLAssociatedTokenAccountProgramID, "spl-associated-token-account", decodeInstruction)
+}
+
+func decodeInstruction(instruction types.Instruction) (instructiondecoder.Instruction, error) {
+	r := instructiondecoder.NewReader(instruction.Data)
+	build := func(name string, names []string, param func(k []common.PublicKey) any) (instructiondecoder.Instruction, error) {
+		return instructiondecoder.Build(r, instruction.Accounts, name, names, "", param)
+	}
+	createNames := []string{"funder", "associatedTokenAccount", "owner", "mint", "systemProgram", "tokenProgram"}
+	// the rent sysvar is no longer required
+	if len(instruction.Accounts) > len(createNames) {
+		createNames = append(createNames, "rentSysvar")
+	}
+
+	// the first version of the program takes no data and creates the account
+	i := InstructionCreate
+	if len(instruction.Data) > 0 {
+		i = Instruction(r.Uint8())
+	}
+	switch i {
+	case InstructionCreate:
+		return build("Create", createNames, func(k []common.PublicKey) any {
+			return CreateParam{Funder: k[0], Owner: k[2], Mint: k[3], AssociatedTokenAccount: k[1], ProgramID: k[5]}
+		})
+	case InstructionCreateIdempotent:
+		return build("CreateIdempotent", createNames, func(k []common.PublicKey) any {
+			return CreateIdempotentParam{Funder: k[0], Owner: k[2], Mint: k[3], AssociatedTokenAccount: k[1]}
+		})
+	case InstructionRecoverNested:
+		names := []string{"nestedAssociatedTokenAccount", "nestedMint", "destinationAssociatedTokenAccount", "ownerAssociatedTokenAccount", "ownerMint", "owner", "tokenProgram"}
+		return build("RecoverNested", names, func(k []common.PublicKey) any {
+			return RecoverNestedParam{
+				Owner:                             k[5],
+				OwnerMint:                         k[4],
+				OwnerAssociatedTokenAccount:       k[3],
+				NestedMint:                        k[1],
+				NestedMintAssociatedTokenAccount:  k[0],
+				DestinationAssociatedTokenAccount: k[2],
+			}
+		})
+	default:
+		return instructiondecoder.Instruction{}, fmt.Errorf("%w: %v", instructiondecoder.ErrUnknownInstruction, i)
+	}
+}
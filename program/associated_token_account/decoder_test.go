@@ -0,0 +1,76 @@
+package associated_token_account
+
+import (
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeInstruction(t *testing.T) {
+	a := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	b := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	c := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+	d := common.PublicKeyFromString("S1gner1111111111111111111111111111111111111")
+
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		wantName    string
+		param       any
+	}{
+		{
+			name:        "create",
+			instruction: Create(CreateParam{Funder: a, Owner: b, Mint: c, AssociatedTokenAccount: d, ProgramID: common.Token2022ProgramID}),
+			wantName:    "Create",
+			param:       CreateParam{Funder: a, Owner: b, Mint: c, AssociatedTokenAccount: d, ProgramID: common.Token2022ProgramID},
+		},
+		{
+			name:        "create without data",
+			instruction: CreateAssociatedTokenAccount(CreateAssociatedTokenAccountParam{Funder: a, Owner: b, Mint: c, AssociatedTokenAccount: d}),
+			wantName:    "Create",
+			param:       CreateParam{Funder: a, Owner: b, Mint: c, AssociatedTokenAccount: d, ProgramID: common.TokenProgramID},
+		},
+		{
+			name:        "create idempotent",
+			instruction: CreateIdempotent(CreateIdempotentParam{Funder: a, Owner: b, Mint: c, AssociatedTokenAccount: d}),
+			wantName:    "CreateIdempotent",
+			param:       CreateIdempotentParam{Funder: a, Owner: b, Mint: c, AssociatedTokenAccount: d},
+		},
+		{
+			name: "recover nested",
+			instruction: RecoverNested(RecoverNestedParam{
+				Owner:                             a,
+				OwnerMint:                         b,
+				OwnerAssociatedTokenAccount:       c,
+				NestedMint:                        d,
+				NestedMintAssociatedTokenAccount:  b,
+				DestinationAssociatedTokenAccount: c,
+			}),
+			wantName: "RecoverNested",
+			param: RecoverNestedParam{
+				Owner:                             a,
+				OwnerMint:                         b,
+				OwnerAssociatedTokenAccount:       c,
+				NestedMint:                        d,
+				NestedMintAssociatedTokenAccount:  b,
+				DestinationAssociatedTokenAccount: c,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instructiondecoder.Decode(tt.instruction)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantName, got.Name)
+			assert.Equal(t, tt.param, got.Param)
+			assert.Len(t, got.Accounts, len(tt.instruction.Accounts))
+			for i, account := range got.Accounts {
+				assert.NotEmpty(t, account.Name)
+				assert.Equal(t, tt.instruction.Accounts[i], account.AccountMeta)
+			}
+		})
+	}
+}
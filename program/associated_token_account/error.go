@@ -0,0 +1,19 @@
+package associated_token_account
+
+import (
+	"errors"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/programerror"
+)
+
+// custom errors of the associated token account program
+var (
+	ErrInvalidOwner = errors.New("associated token account owner does not match address derivation")
+)
+
+func init() {
+	programerror.Register(common.SPLAssociatedTokenAccountProgramID, map[uint32]error{
+		0: ErrInvalidOwner,
+	})
+}
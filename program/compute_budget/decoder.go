@@ -0,0 +1,38 @@
+package compute_budget
+
+import (
+	"fmt"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+func init() {
+	instructiondecoder.Register(common.ComputeBudgetProgramID, "compute-budget", decodeInstruction)
+}
+
+func decodeInstruction(instruction types.Instruction) (instructiondecoder.Instruction, error) {
+	r := instructiondecoder.NewReader(instruction.Data)
+	build := func(name string, param any) (instructiondecoder.Instruction, error) {
+		return instructiondecoder.Build(r, instruction.Accounts, name, nil, "", func([]common.PublicKey) any {
+			return param
+		})
+	}
+
+	switch i := Instruction(r.Uint8()); i {
+	case InstructionRequestUnits:
+		return build("RequestUnits", RequestUnitsParam{Units: r.Uint32(), AdditionalFee: r.Uint32()})
+	case InstructionRequestHeapFrame:
+		return build("RequestHeapFrame", RequestHeapFrameParam{Bytes: r.Uint32()})
+	case InstructionSetComputeUnitLimit:
+		return build("SetComputeUnitLimit", SetComputeUnitLimitParam{Units: r.Uint32()})
+	case InstructionSetComputeUnitPrice:
+		return build("SetComputeUnitPrice", SetComputeUnitPriceParam{MicroLamports: r.Uint64()})
+	default:
+		if err := r.Err(); err != nil {
+			return instructiondecoder.Instruction{}, err
+		}
+		return instructiondecoder.Instruction{}, fmt.Errorf("%w: %v", instructiondecoder.ErrUnknownInstruction, i)
+	}
+}
@@ -0,0 +1,42 @@
+package compute_budget
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeInstruction(t *testing.T) {
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		param       any
+	}{
+		{"RequestUnits", RequestUnits(RequestUnitsParam{Units: 1_000_000, AdditionalFee: 1}), RequestUnitsParam{Units: 1_000_000, AdditionalFee: 1}},
+		{"RequestHeapFrame", RequestHeapFrame(RequestHeapFrameParam{Bytes: 256 * 1024}), RequestHeapFrameParam{Bytes: 256 * 1024}},
+		{"SetComputeUnitLimit", SetComputeUnitLimit(SetComputeUnitLimitParam{Units: 300_000}), SetComputeUnitLimitParam{Units: 300_000}},
+		{"SetComputeUnitPrice", SetComputeUnitPrice(SetComputeUnitPriceParam{MicroLamports: 10_000}), SetComputeUnitPriceParam{MicroLamports: 10_000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instructiondecoder.Decode(tt.instruction)
+			assert.Nil(t, err)
+			assert.Equal(t, "compute-budget", got.Program)
+			assert.Equal(t, tt.name, got.Name)
+			assert.Equal(t, tt.param, got.Param)
+			assert.Empty(t, got.Accounts)
+		})
+	}
+}
+
+func TestDecodeInstruction_Failed(t *testing.T) {
+	_, err := instructiondecoder.Decode(types.Instruction{ProgramID: common.ComputeBudgetProgramID, Data: []byte{3, 1, 0}})
+	assert.True(t, errors.Is(err, instructiondecoder.ErrInvalidInstructionData), err)
+
+	_, err = instructiondecoder.Decode(types.Instruction{ProgramID: common.ComputeBudgetProgramID, Data: []byte{4}})
+	assert.True(t, errors.Is(err, instructiondecoder.ErrUnknownInstruction), err)
+}
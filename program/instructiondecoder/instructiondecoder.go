@@ -0,0 +1,155 @@
+// Package instructiondecoder turns the instructions of a transaction back into the params of the
+// builders in the program packages. program packages register their decoders in init so importing
+// a program package is enough to get its instructions decoded.
+package instructiondecoder
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+var (
+	ErrUnknownProgram         = errors.New("no decoder is registered for the program")
+	ErrUnknownInstruction     = errors.New("unknown instruction")
+	ErrInvalidInstructionData = errors.New("invalid instruction data")
+	ErrNotEnoughAccounts      = errors.New("not enough accounts")
+	ErrInvalidAccountIndex    = errors.New("account index out of range")
+)
+
+// Account is an account of an instruction with the name the program gives it
+type Account struct {
+	Name string
+	types.AccountMeta
+}
+
+// Instruction is a decoded instruction. Param is the param of the builder, e.g. system.TransferParam,
+// so passing it to the builder gives the instruction back.
+type Instruction struct {
+	ProgramID common.PublicKey
+	Program   string
+	Name      string
+	Param     any
+	Accounts  []Account
+}
+
+// DecodeFunc decodes an instruction of a program, it fills Name, Param and Accounts
+type DecodeFunc func(instruction types.Instruction) (Instruction, error)
+
+type decoder struct {
+	program string
+	decode  DecodeFunc
+}
+
+var (
+	mu       sync.RWMutex
+	registry = map[common.PublicKey]decoder{}
+)
+
+// Register adds the decoder of a program. a later decoder of the same program replaces the earlier one.
+func Register(programID common.PublicKey, program string, decode DecodeFunc) {
+	mu.Lock()
+	defer mu.Unlock()
+	registry[programID] = decoder{program: program, decode: decode}
+}
+
+// Decode decodes an instruction with the decoder registered for its program
+func Decode(instruction types.Instruction) (Instruction, error) {
+	mu.RLock()
+	d, ok := registry[instruction.ProgramID]
+	mu.RUnlock()
+	if !ok {
+		return Instruction{}, fmt.Errorf("%w: %v", ErrUnknownProgram, instruction.ProgramID)
+	}
+
+	decoded, err := d.decode(instruction)
+	if err != nil {
+		return Instruction{}, fmt.Errorf("failed to decode %v instruction, err: %w", d.program, err)
+	}
+	decoded.ProgramID = instruction.ProgramID
+	decoded.Program = d.program
+	return decoded, nil
+}
+
+// DecodeCompiled decodes a compiled instruction, e.g. an inner instruction of a transaction.
+// accountKeys are the keys the indexes point to: the account keys of the message followed by the
+// loaded writable and readonly addresses. IsSigner and IsWritable of the accounts are left false.
+func DecodeCompiled(accountKeys []common.PublicKey, instruction types.CompiledInstruction) (Instruction, error) {
+	if instruction.ProgramIDIndex < 0 || instruction.ProgramIDIndex >= len(accountKeys) {
+		return Instruction{}, fmt.Errorf("%w: program id index %v", ErrInvalidAccountIndex, instruction.ProgramIDIndex)
+	}
+	accounts := make([]types.AccountMeta, 0, len(instruction.Accounts))
+	for _, index := range instruction.Accounts {
+		if index < 0 || index >= len(accountKeys) {
+			return Instruction{}, fmt.Errorf("%w: account index %v", ErrInvalidAccountIndex, index)
+		}
+		accounts = append(accounts, types.AccountMeta{PubKey: accountKeys[index]})
+	}
+	return Decode(types.Instruction{
+		ProgramID: accountKeys[instruction.ProgramIDIndex],
+		Accounts:  accounts,
+		Data:      instruction.Data,
+	})
+}
+
+// DecodeInstructions decodes the instructions in order, it stops at the first one which fails
+func DecodeInstructions(instructions []types.Instruction) ([]Instruction, error) {
+	decoded := make([]Instruction, 0, len(instructions))
+	for i, instruction := range instructions {
+		d, err := Decode(instruction)
+		if err != nil {
+			return nil, fmt.Errorf("instruction #%d: %w", i, err)
+		}
+		decoded = append(decoded, d)
+	}
+	return decoded, nil
+}
+
+// LabelAccounts names the accounts in order. it returns ErrNotEnoughAccounts if there are fewer accounts
+// than names. the accounts after the names, e.g. the signers of a multisig, are named rest.
+func LabelAccounts(metas []types.AccountMeta, rest string, names ...string) ([]Account, error) {
+	if len(metas) < len(names) {
+		return nil, fmt.Errorf("%w: expected at least %v, got %v", ErrNotEnoughAccounts, len(names), len(metas))
+	}
+	accounts := make([]Account, 0, len(metas))
+	for i, meta := range metas {
+		name := rest
+		if i < len(names) {
+			name = names[i]
+		}
+		accounts = append(accounts, Account{Name: name, AccountMeta: meta})
+	}
+	return accounts, nil
+}
+
+// Build finishes a DecodeFunc. it returns the error of the reader, labels the accounts and builds
+// the param from the public keys of the accounts, which are at least as many as the names.
+func Build(r *Reader, metas []types.AccountMeta, name string, names []string, rest string, param func(pubkeys []common.PublicKey) any) (Instruction, error) {
+	if err := r.Err(); err != nil {
+		return Instruction{}, err
+	}
+	accounts, err := LabelAccounts(metas, rest, names...)
+	if err != nil {
+		return Instruction{}, err
+	}
+	pubkeys := make([]common.PublicKey, 0, len(metas))
+	for _, meta := range metas {
+		pubkeys = append(pubkeys, meta.PubKey)
+	}
+	return Instruction{
+		Name:     name,
+		Param:    param(pubkeys),
+		Accounts: accounts,
+	}, nil
+}
+
+// Rest returns the public keys after the first n, it returns nil if there is none
+func Rest(pubkeys []common.PublicKey, n int) []common.PublicKey {
+	if len(pubkeys) <= n {
+		return nil
+	}
+	return pubkeys[n:]
+}
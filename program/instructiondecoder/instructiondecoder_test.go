@@ -0,0 +1,155 @@
+package instructiondecoder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testProgramID = common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	testFrom      = common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	testTo        = common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+)
+
+type testTransferParam struct {
+	From   common.PublicKey
+	To     common.PublicKey
+	Amount uint64
+	Memo   string
+}
+
+func init() {
+	Register(testProgramID, "test", func(instruction types.Instruction) (Instruction, error) {
+		r := NewReader(instruction.Data)
+		switch i := r.Uint8(); i {
+		case 1:
+			amount, memo := r.Uint64(), r.BincodeString()
+			return Build(r, instruction.Accounts, "Transfer", []string{"from", "to"}, "", func(k []common.PublicKey) any {
+				return testTransferParam{From: k[0], To: k[1], Amount: amount, Memo: memo}
+			})
+		default:
+			if err := r.Err(); err != nil {
+				return Instruction{}, err
+			}
+			return Instruction{}, fmt.Errorf("%w: %v", ErrUnknownInstruction, i)
+		}
+	})
+}
+
+func testTransferData() []byte {
+	return []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 'h', 'i'}
+}
+
+func TestDecode(t *testing.T) {
+	got, err := Decode(types.Instruction{
+		ProgramID: testProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: testFrom, IsSigner: true, IsWritable: true},
+			{PubKey: testTo, IsSigner: false, IsWritable: true},
+		},
+		Data: testTransferData(),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, Instruction{
+		ProgramID: testProgramID,
+		Program:   "test",
+		Name:      "Transfer",
+		Param:     testTransferParam{From: testFrom, To: testTo, Amount: 1, Memo: "hi"},
+		Accounts: []Account{
+			{Name: "from", AccountMeta: types.AccountMeta{PubKey: testFrom, IsSigner: true, IsWritable: true}},
+			{Name: "to", AccountMeta: types.AccountMeta{PubKey: testTo, IsSigner: false, IsWritable: true}},
+		},
+	}, got)
+}
+
+func TestDecode_Failed(t *testing.T) {
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		wantErr     error
+	}{
+		{
+			name:        "unknown program",
+			instruction: types.Instruction{ProgramID: testTo, Data: testTransferData()},
+			wantErr:     ErrUnknownProgram,
+		},
+		{
+			name:        "unknown instruction",
+			instruction: types.Instruction{ProgramID: testProgramID, Data: []byte{2}},
+			wantErr:     ErrUnknownInstruction,
+		},
+		{
+			name:        "short data",
+			instruction: types.Instruction{ProgramID: testProgramID, Data: testTransferData()[:18]},
+			wantErr:     ErrInvalidInstructionData,
+		},
+		{
+			name:        "not enough accounts",
+			instruction: types.Instruction{ProgramID: testProgramID, Accounts: []types.AccountMeta{{PubKey: testFrom}}, Data: testTransferData()},
+			wantErr:     ErrNotEnoughAccounts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.instruction)
+			assert.True(t, errors.Is(err, tt.wantErr), err)
+		})
+	}
+}
+
+func TestDecodeCompiled(t *testing.T) {
+	accountKeys := []common.PublicKey{testFrom, testProgramID, testTo}
+
+	got, err := DecodeCompiled(accountKeys, types.CompiledInstruction{ProgramIDIndex: 1, Accounts: []int{2, 0}, Data: testTransferData()})
+	assert.Nil(t, err)
+	assert.Equal(t, testTransferParam{From: testTo, To: testFrom, Amount: 1, Memo: "hi"}, got.Param)
+	assert.Equal(t, []Account{
+		{Name: "from", AccountMeta: types.AccountMeta{PubKey: testTo}},
+		{Name: "to", AccountMeta: types.AccountMeta{PubKey: testFrom}},
+	}, got.Accounts)
+
+	_, err = DecodeCompiled(accountKeys, types.CompiledInstruction{ProgramIDIndex: 3, Data: testTransferData()})
+	assert.True(t, errors.Is(err, ErrInvalidAccountIndex), err)
+	_, err = DecodeCompiled(accountKeys, types.CompiledInstruction{ProgramIDIndex: 1, Accounts: []int{0, 3}, Data: testTransferData()})
+	assert.True(t, errors.Is(err, ErrInvalidAccountIndex), err)
+}
+
+func TestDecodeInstructions(t *testing.T) {
+	transfer := types.Instruction{
+		ProgramID: testProgramID,
+		Accounts:  []types.AccountMeta{{PubKey: testFrom}, {PubKey: testTo}},
+		Data:      testTransferData(),
+	}
+
+	got, err := DecodeInstructions([]types.Instruction{transfer, transfer})
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+
+	_, err = DecodeInstructions([]types.Instruction{transfer, {ProgramID: testTo}})
+	assert.True(t, errors.Is(err, ErrUnknownProgram), err)
+	assert.Contains(t, err.Error(), "instruction #1")
+}
+
+func TestReader(t *testing.T) {
+	r := NewReader(append([]byte{1, 0xff, 0xff, 1}, testFrom.Bytes()...))
+	assert.True(t, r.Bool())
+	assert.Equal(t, int16(-1), int16(r.Uint16()))
+	assert.Equal(t, &testFrom, r.OptionalPublicKey())
+	assert.Nil(t, r.Err())
+	assert.Equal(t, 0, r.Len())
+
+	// a failed read fails the ones after it
+	assert.Equal(t, uint32(0), r.Uint32())
+	assert.True(t, errors.Is(r.Err(), ErrInvalidInstructionData))
+	r.data = append(r.data, 1)
+	assert.Equal(t, uint8(0), r.Uint8())
+
+	r = NewReader(make([]byte, 32))
+	assert.Nil(t, r.NonZeroPublicKey())
+	assert.Nil(t, r.Err())
+}
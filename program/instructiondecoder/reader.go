@@ -0,0 +1,144 @@
+package instructiondecoder
+
+import (
+	"fmt"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/bytes_decoder"
+)
+
+// Reader reads the little-endian fields of instruction data with bytes_decoder. a read past the end fails
+// the reader, the following reads return zero values and Err reports ErrInvalidInstructionData.
+type Reader struct {
+	data   []byte
+	offset int
+	err    error
+}
+
+func NewReader(data []byte) *Reader {
+	return &Reader{data: data}
+}
+
+// Err returns the first error the reader met
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// Len returns the number of unread bytes
+func (r *Reader) Len() int {
+	return len(r.data) - r.offset
+}
+
+// ok records the error of a read and reports whether the reader is still fine
+func (r *Reader) ok(err error) bool {
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("%w: %v", ErrInvalidInstructionData, err)
+	}
+	return r.err == nil
+}
+
+func (r *Reader) Uint8() uint8 {
+	if r.err != nil {
+		return 0
+	}
+	v, err := bytes_decoder.GetUint8(&r.offset, r.data)
+	if !r.ok(err) {
+		return 0
+	}
+	return v
+}
+
+func (r *Reader) Bool() bool {
+	return r.Uint8() != 0
+}
+
+func (r *Reader) Uint16() uint16 {
+	if r.err != nil {
+		return 0
+	}
+	v, err := bytes_decoder.GetUint16(&r.offset, r.data)
+	if !r.ok(err) {
+		return 0
+	}
+	return v
+}
+
+func (r *Reader) Uint32() uint32 {
+	if r.err != nil {
+		return 0
+	}
+	v, err := bytes_decoder.GetUint32(&r.offset, r.data)
+	if !r.ok(err) {
+		return 0
+	}
+	return v
+}
+
+func (r *Reader) Uint64() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, err := bytes_decoder.GetUint64(&r.offset, r.data)
+	if !r.ok(err) {
+		return 0
+	}
+	return v
+}
+
+// Bytes reads n bytes, the returned slice is a copy
+func (r *Reader) Bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	b, err := bytes_decoder.GetBytes(&r.offset, r.data, n)
+	if !r.ok(err) {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
+
+// Rest reads all the unread bytes
+func (r *Reader) Rest() []byte {
+	return r.Bytes(r.Len())
+}
+
+func (r *Reader) PublicKey() common.PublicKey {
+	if r.err != nil {
+		return common.PublicKey{}
+	}
+	b, err := bytes_decoder.GetBytes32(&r.offset, r.data)
+	if !r.ok(err) {
+		return common.PublicKey{}
+	}
+	return common.PublicKey(b)
+}
+
+// OptionalPublicKey reads a one byte tag and a public key if the tag is 1
+func (r *Reader) OptionalPublicKey() *common.PublicKey {
+	if !r.Bool() || r.err != nil {
+		return nil
+	}
+	pubkey := r.PublicKey()
+	if r.err != nil {
+		return nil
+	}
+	return &pubkey
+}
+
+// NonZeroPublicKey reads a public key which is absent when it is the zero public key
+func (r *Reader) NonZeroPublicKey() *common.PublicKey {
+	pubkey := r.PublicKey()
+	if r.err != nil || pubkey == (common.PublicKey{}) {
+		return nil
+	}
+	return &pubkey
+}
+
+// BincodeString reads a string with an u64 length prefix
+func (r *Reader) BincodeString() string {
+	n := r.Uint64()
+	if r.err != nil || !r.ok(bytes_decoder.CheckCount(&r.offset, r.data, n, 1)) {
+		return ""
+	}
+	return string(r.Bytes(int(n)))
+}
@@ -0,0 +1,19 @@
+package memo
+
+import (
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+func init() {
+	instructiondecoder.Register(common.MemoProgramID, "spl-memo", decodeInstruction)
+}
+
+// the whole data is the memo and every account is a signer
+func decodeInstruction(instruction types.Instruction) (instructiondecoder.Instruction, error) {
+	r := instructiondecoder.NewReader(instruction.Data)
+	return instructiondecoder.Build(r, instruction.Accounts, "Memo", nil, "signer", func(k []common.PublicKey) any {
+		return BuildMemoParam{SignerPubkeys: instructiondecoder.Rest(k, 0), Memo: r.Rest()}
+	})
+}
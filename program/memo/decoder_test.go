@@ -0,0 +1,41 @@
+package memo
+
+import (
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeInstruction(t *testing.T) {
+	signer := common.PublicKeyFromString("S1gner1111111111111111111111111111111111111")
+	tests := []struct {
+		name  string
+		param BuildMemoParam
+	}{
+		{
+			name:  "without signers",
+			param: BuildMemoParam{Memo: []byte("👻")},
+		},
+		{
+			name:  "with signers",
+			param: BuildMemoParam{SignerPubkeys: []common.PublicKey{signer}, Memo: []byte("hello")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instructiondecoder.Decode(BuildMemo(tt.param))
+			assert.Nil(t, err)
+			assert.Equal(t, "Memo", got.Name)
+			assert.Equal(t, tt.param, got.Param)
+			for i, account := range got.Accounts {
+				assert.Equal(t, instructiondecoder.Account{
+					Name:        "signer",
+					AccountMeta: types.AccountMeta{PubKey: tt.param.SignerPubkeys[i], IsSigner: true},
+				}, account)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package token_metadata
+
+import (
+	"errors"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/programerror"
+)
+
+// custom errors of the token metadata program
+var (
+	ErrInstructionUnpack                                      = errors.New("failed to unpack instruction data")
+	ErrInstructionPack                                        = errors.New("failed to pack instruction data")
+	ErrNotRentExempt                                          = errors.New("lamport balance below rent-exempt threshold")
+	ErrAlreadyInitialized                                     = errors.New("already initialized")
+	ErrUninitialized                                          = errors.New("uninitialized")
+	ErrInvalidMetadataKey                                     = errors.New("metadata's key must match seed of ['metadata', program id, mint] provided")
+	ErrInvalidEditionKey                                      = errors.New("edition's key must match seed of ['metadata', program id, name, 'edition'] provided")
+	ErrUpdateAuthorityIncorrect                               = errors.New("update authority given does not match")
+	ErrUpdateAuthorityIsNotSigner                             = errors.New("update authority needs to be signer to update metadata")
+	ErrNotMintAuthority                                       = errors.New("you must be the mint authority and signer on this transaction")
+	ErrInvalidMintAuthority                                   = errors.New("mint authority provided does not match the authority on the mint")
+	ErrNameTooLong                                            = errors.New("name too long")
+	ErrSymbolTooLong                                          = errors.New("symbol too long")
+	ErrUriTooLong                                             = errors.New("uri too long")
+	ErrUpdateAuthorityMustBeEqualToMetadataAuthorityAndSigner = errors.New("update authority must be equivalent to the metadata's authority and also signer of this transaction")
+	ErrMintMismatch                                           = errors.New("mint given does not match mint on metadata")
+	ErrEditionsMustHaveExactlyOneToken                        = errors.New("editions must have exactly one token")
+	ErrMaxEditionsMintedAlready                               = errors.New("maximum editions printed already")
+	ErrTokenMintToFailed                                      = errors.New("token mint to failed")
+	ErrMasterRecordMismatch                                   = errors.New("the master edition record passed must match the master record on the edition given")
+	ErrDestinationMintMismatch                                = errors.New("the destination account does not have the right mint")
+	ErrEditionAlreadyMinted                                   = errors.New("an edition can only mint one of its kind")
+	ErrPrintingMintDecimalsShouldBeZero                       = errors.New("printing mint decimals should be zero")
+	ErrOneTimePrintingAuthorizationMintDecimalsShouldBeZero   = errors.New("onetimeprintingauthorization mint decimals should be zero")
+	ErrEditionMintDecimalsShouldBeZero                        = errors.New("edition mint decimals should be zero")
+	ErrTokenBurnFailed                                        = errors.New("token burn failed")
+	ErrTokenAccountOneTimeAuthMintMismatch                    = errors.New("the one time authorization mint does not match that on the token account")
+	ErrDerivedKeyInvalid                                      = errors.New("derived key invalid")
+	ErrPrintingMintMismatch                                   = errors.New("the printing mint does not match that on the master edition")
+	ErrOneTimePrintingAuthMintMismatch                        = errors.New("the one time printing auth mint does not match that on the master edition")
+	ErrTokenAccountMintMismatch                               = errors.New("the mint of the token account does not match the printing mint")
+	ErrTokenAccountMintMismatchV2                             = errors.New("the mint of the token account does not match the master metadata mint")
+	ErrNotEnoughTokens                                        = errors.New("not enough tokens to mint a limited edition")
+	ErrPrintingMintAuthorizationAccountMismatch               = errors.New("the mint on your authorization token holding account does not match your printing mint")
+	ErrAuthorizationTokenAccountOwnerMismatch                 = errors.New("the authorization token account has a different owner than the update authority for the master edition")
+	ErrDisabled                                               = errors.New("this feature is currently disabled")
+	ErrCreatorsTooLong                                        = errors.New("creators list too long")
+	ErrCreatorsMustBeAtleastOne                               = errors.New("creators must be at least one if set")
+	ErrMustBeOneOfCreators                                    = errors.New("if using a creators array, you must be one of the creators listed")
+	ErrNoCreatorsPresentOnMetadata                            = errors.New("this metadata does not have creators")
+	ErrCreatorNotFound                                        = errors.New("this creator address was not found")
+	ErrInvalidBasisPoints                                     = errors.New("basis points cannot be more than 10000")
+	ErrPrimarySaleCanOnlyBeFlippedToTrue                      = errors.New("primary sale can only be flipped to true and is immutable")
+	ErrOwnerMismatch                                          = errors.New("owner does not match that on the account given")
+	ErrNoBalanceInAccountForAuthorization                     = errors.New("this account has no tokens to be used for authorization")
+	ErrShareTotalMustBe100                                    = errors.New("share total must equal 100 for creator array")
+	ErrReservationExists                                      = errors.New("this reservation list already exists")
+	ErrReservationDoesNotExist                                = errors.New("this reservation list does not exist")
+	ErrReservationNotSet                                      = errors.New("this reservation list exists but was never set with reservations")
+	ErrReservationAlreadyMade                                 = errors.New("this reservation list has already been set")
+	ErrBeyondMaxAddressSize                                   = errors.New("provided more addresses than max allowed in single reservation")
+	ErrNumericalOverflow                                      = errors.New("numerical overflow error")
+)
+
+func init() {
+	programerror.Register(common.MetaplexTokenMetaProgramID, map[uint32]error{
+		0:  ErrInstructionUnpack,
+		1:  ErrInstructionPack,
+		2:  ErrNotRentExempt,
+		3:  ErrAlreadyInitialized,
+		4:  ErrUninitialized,
+		5:  ErrInvalidMetadataKey,
+		6:  ErrInvalidEditionKey,
+		7:  ErrUpdateAuthorityIncorrect,
+		8:  ErrUpdateAuthorityIsNotSigner,
+		9:  ErrNotMintAuthority,
+		10: ErrInvalidMintAuthority,
+		11: ErrNameTooLong,
+		12: ErrSymbolTooLong,
+		13: ErrUriTooLong,
+		14: ErrUpdateAuthorityMustBeEqualToMetadataAuthorityAndSigner,
+		15: ErrMintMismatch,
+		16: ErrEditionsMustHaveExactlyOneToken,
+		17: ErrMaxEditionsMintedAlready,
+		18: ErrTokenMintToFailed,
+		19: ErrMasterRecordMismatch,
+		20: ErrDestinationMintMismatch,
+		21: ErrEditionAlreadyMinted,
+		22: ErrPrintingMintDecimalsShouldBeZero,
+		23: ErrOneTimePrintingAuthorizationMintDecimalsShouldBeZero,
+		24: ErrEditionMintDecimalsShouldBeZero,
+		25: ErrTokenBurnFailed,
+		26: ErrTokenAccountOneTimeAuthMintMismatch,
+		27: ErrDerivedKeyInvalid,
+		28: ErrPrintingMintMismatch,
+		29: ErrOneTimePrintingAuthMintMismatch,
+		30: ErrTokenAccountMintMismatch,
+		31: ErrTokenAccountMintMismatchV2,
+		32: ErrNotEnoughTokens,
+		33: ErrPrintingMintAuthorizationAccountMismatch,
+		34: ErrAuthorizationTokenAccountOwnerMismatch,
+		35: ErrDisabled,
+		36: ErrCreatorsTooLong,
+		37: ErrCreatorsMustBeAtleastOne,
+		38: ErrMustBeOneOfCreators,
+		39: ErrNoCreatorsPresentOnMetadata,
+		40: ErrCreatorNotFound,
+		41: ErrInvalidBasisPoints,
+		42: ErrPrimarySaleCanOnlyBeFlippedToTrue,
+		43: ErrOwnerMismatch,
+		44: ErrNoBalanceInAccountForAuthorization,
+		45: ErrShareTotalMustBe100,
+		46: ErrReservationExists,
+		47: ErrReservationDoesNotExist,
+		48: ErrReservationNotSet,
+		49: ErrReservationAlreadyMade,
+		50: ErrBeyondMaxAddressSize,
+		51: ErrNumericalOverflow,
+	})
+}
@@ -161,3 +161,11 @@ func TestMetadataDeserialize(t *testing.T) {
 		})
 	}
 }
+
+func FuzzMetadataDeserialize(f *testing.F) {
+	f.Add([]byte{4})
+	f.Add(make([]byte, 679))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = MetadataDeserialize(data)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/bytes_decoder"
 	"github.com/near/borsh-go"
 )
 
@@ -122,6 +123,10 @@ type ProgrammableConfigV1 struct {
 }
 
 func MetadataDeserialize(data []byte) (Metadata, error) {
+	if err := checkDataStrings(data); err != nil {
+		return Metadata{}, fmt.Errorf("failed to deserialize data, err: %w", err)
+	}
+
 	var metadata Metadata
 	err := borsh.Deserialize(&metadata, data)
 	if err != nil {
@@ -149,6 +154,22 @@ func MetadataDeserialize(data []byte) (Metadata, error) {
 	return metadata, nil
 }
 
+// checkDataStrings checks the name, symbol and uri fit in the data.
+// borsh allocates a string by its length before reading it.
+func checkDataStrings(data []byte) error {
+	current := 1 + 32 + 32
+	for i := 0; i < 3; i++ {
+		l, err := bytes_decoder.GetUint32(&current, data)
+		if err != nil {
+			return err
+		}
+		if _, err := bytes_decoder.GetBytes(&current, data, int(l)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type MasterEditionV2 struct {
 	Key       Key
 	Supply    uint64
@@ -46,3 +46,10 @@ func TestNameRecordHeaderFromData(t *testing.T) {
 		})
 	}
 }
+
+func FuzzNameRecordHeaderFromData(f *testing.F) {
+	f.Add(make([]byte, 96))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = NameRecordHeaderFromData(data)
+	})
+}
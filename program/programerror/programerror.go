@@ -0,0 +1,89 @@
+// Package programerror maps the custom error codes which programs return on chain back to go errors.
+// program packages register their tables in init so importing a program package is enough to
+// get its errors resolved.
+package programerror
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/mr-tron/base58"
+)
+
+var (
+	mu       sync.RWMutex
+	registry = map[common.PublicKey]map[uint32]error{}
+)
+
+// Register adds the custom error table of a program. a later table of the same program replaces the earlier one.
+func Register(programID common.PublicKey, errs map[uint32]error) {
+	mu.Lock()
+	defer mu.Unlock()
+	table := make(map[uint32]error, len(errs))
+	for code, err := range errs {
+		table[code] = err
+	}
+	registry[programID] = table
+}
+
+// Lookup returns the error the program registered for the code, it returns nil if there is none
+func Lookup(programID common.PublicKey, code uint32) error {
+	mu.RLock()
+	defer mu.RUnlock()
+	return registry[programID][code]
+}
+
+// CustomError is an InstructionError with a custom code. Err is the registered error and nil if the
+// program or the code is unknown. ProgramID is the zero public key if it can't be found in the logs.
+type CustomError struct {
+	InstructionIndex uint8
+	ProgramID        common.PublicKey
+	Code             uint32
+	Err              error
+}
+
+func (e *CustomError) Error() string {
+	s := fmt.Sprintf("instruction %v failed, program: %v, custom program error: 0x%x", e.InstructionIndex, e.ProgramID, e.Code)
+	if e.Err != nil {
+		s += ", err: " + e.Err.Error()
+	}
+	return s
+}
+
+func (e *CustomError) Unwrap() error {
+	return e.Err
+}
+
+// NewCustomError makes the error of the instruction which failed with the custom code. the program which
+// failed is taken from the logs and its registered error is looked up.
+func NewCustomError(instructionIndex uint8, code uint32, logs []string) *CustomError {
+	customError := &CustomError{
+		InstructionIndex: instructionIndex,
+		Code:             code,
+	}
+	if programID, ok := failedProgramID(logs, code); ok {
+		customError.ProgramID = programID
+		customError.Err = Lookup(programID, code)
+	}
+	return customError
+}
+
+// failedProgramID returns the first program which failed with the code. when the error comes from
+// a cpi, the callee fails first and the callers fail with the same error after it.
+func failedProgramID(logs []string, code uint32) (common.PublicKey, bool) {
+	suffix := " failed: custom program error: 0x" + strconv.FormatUint(uint64(code), 16)
+	for _, log := range logs {
+		if !strings.HasPrefix(log, "Program ") || !strings.HasSuffix(log, suffix) {
+			continue
+		}
+		b, err := base58.Decode(strings.TrimSuffix(strings.TrimPrefix(log, "Program "), suffix))
+		if err != nil || len(b) != common.PublicKeyLength {
+			continue
+		}
+		return common.PublicKeyFromBytes(b), true
+	}
+	return common.PublicKey{}, false
+}
@@ -0,0 +1,105 @@
+package programerror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testProgramID = common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	errTest       = errors.New("test error")
+)
+
+func init() {
+	Register(testProgramID, map[uint32]error{
+		16: errTest,
+	})
+}
+
+func TestNewCustomError(t *testing.T) {
+	type args struct {
+		code uint32
+		logs []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *CustomError
+	}{
+		{
+			name: "registered",
+			args: args{
+				code: 16,
+				logs: []string{
+					"Program 11111111111111111111111111111111 invoke [1]",
+					"Program 11111111111111111111111111111111 success",
+					"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 invoke [1]",
+					"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 consumed 2000 of 200000 compute units",
+					"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 failed: custom program error: 0x10",
+				},
+			},
+			want: &CustomError{
+				InstructionIndex: 1,
+				ProgramID:        testProgramID,
+				Code:             16,
+				Err:              errTest,
+			},
+		},
+		{
+			name: "unknown program",
+			args: args{
+				code: 1,
+				logs: []string{
+					"Program BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ invoke [1]",
+					"Program BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ failed: custom program error: 0x1",
+				},
+			},
+			want: &CustomError{
+				InstructionIndex: 1,
+				ProgramID:        common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+				Code:             1,
+				Err:              nil,
+			},
+		},
+		{
+			name: "malformed log before the failed program",
+			args: args{
+				code: 16,
+				logs: []string{
+					"Program not-a-key failed: custom program error: 0x10",
+					"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 failed: custom program error: 0x10",
+				},
+			},
+			want: &CustomError{
+				InstructionIndex: 1,
+				ProgramID:        testProgramID,
+				Code:             16,
+				Err:              errTest,
+			},
+		},
+		{
+			name: "without logs",
+			args: args{
+				code: 1,
+			},
+			want: &CustomError{
+				InstructionIndex: 1,
+				Code:             1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewCustomError(1, tt.args.code, tt.args.logs))
+		})
+	}
+}
+
+func TestCustomError_Is(t *testing.T) {
+	customError := NewCustomError(2, 16, []string{"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 failed: custom program error: 0x10"})
+	assert.ErrorIs(t, customError, errTest)
+	assert.Equal(t, "instruction 2 failed, program: EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7, custom program error: 0x10, err: test error", customError.Error())
+}
@@ -0,0 +1,96 @@
+package programlog
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/near/borsh-go"
+)
+
+// AnchorEventDecodeFunc decodes the event data after the discriminator
+type AnchorEventDecodeFunc func(data []byte) (any, error)
+
+type anchorEvent struct {
+	name   string
+	decode AnchorEventDecodeFunc
+}
+
+var (
+	anchorMu       sync.RWMutex
+	anchorRegistry = map[common.PublicKey]map[[8]byte]anchorEvent{}
+)
+
+// AnchorEvent is an event which an anchor program emits by `emit!`
+type AnchorEvent struct {
+	ProgramID common.PublicKey
+	Name      string
+	Data      any
+}
+
+// AnchorEventDiscriminator returns the first 8 bytes of sha256("event:<name>")
+func AnchorEventDiscriminator(name string) [8]byte {
+	var discriminator [8]byte
+	h := sha256.Sum256([]byte("event:" + name))
+	copy(discriminator[:], h[:8])
+	return discriminator
+}
+
+// RegisterAnchorEvent adds an event of the program. name is the struct name in the program, e.g. `TradeEvent`.
+func RegisterAnchorEvent(programID common.PublicKey, name string, decode AnchorEventDecodeFunc) {
+	anchorMu.Lock()
+	defer anchorMu.Unlock()
+	if anchorRegistry[programID] == nil {
+		anchorRegistry[programID] = map[[8]byte]anchorEvent{}
+	}
+	anchorRegistry[programID][AnchorEventDiscriminator(name)] = anchorEvent{name: name, decode: decode}
+}
+
+// RegisterAnchorEventType adds an event which is decoded into T by borsh
+func RegisterAnchorEventType[T any](programID common.PublicKey, name string) {
+	RegisterAnchorEvent(programID, name, func(data []byte) (any, error) {
+		var v T
+		if err := borsh.Deserialize(&v, data); err != nil {
+			return nil, err
+		}
+		return v, nil
+	})
+}
+
+// AnchorEvents decodes the registered events in `Program data:` of every invocation. the data which
+// doesn't match a registered event is skipped.
+func (l Logs) AnchorEvents() ([]AnchorEvent, error) {
+	anchorMu.RLock()
+	defer anchorMu.RUnlock()
+
+	events := []AnchorEvent{}
+	var err error
+	l.Walk(func(invocation *Invocation) {
+		programEvents, ok := anchorRegistry[invocation.ProgramID]
+		if !ok || err != nil {
+			return
+		}
+		for _, fields := range invocation.Data {
+			if len(fields) != 1 || len(fields[0]) < 8 {
+				continue
+			}
+			var discriminator [8]byte
+			copy(discriminator[:], fields[0][:8])
+			event, ok := programEvents[discriminator]
+			if !ok {
+				continue
+			}
+			data, e := event.decode(fields[0][8:])
+			if e != nil {
+				err = fmt.Errorf("failed to decode %v event of %v, err: %v", event.name, invocation.ProgramID, e)
+				return
+			}
+			events = append(events, AnchorEvent{ProgramID: invocation.ProgramID, Name: event.name, Data: data})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,211 @@
+// Package programlog parses the log messages of a transaction, e.g. TransactionMeta.LogMessages or the
+// logs of a simulation, into the tree of program invocations which produced them.
+package programlog
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/mr-tron/base58"
+)
+
+var ErrInvalidLog = errors.New("invalid log")
+
+const (
+	prefixProgram   = "Program "
+	prefixLog       = "Program log: "
+	prefixData      = "Program data: "
+	prefixReturn    = "Program return: "
+	logTruncated    = "Log truncated"
+	infixInvoke     = " invoke ["
+	infixConsumed   = " consumed "
+	infixConsumedOf = " of "
+	suffixConsumed  = " compute units"
+	suffixSuccess   = " success"
+	infixFailed     = " failed: "
+)
+
+// Invocation is a program invocation, a cpi is a child of the invocation which calls it
+type Invocation struct {
+	ProgramID common.PublicKey
+	// Depth is the stack height, the top-level invocation is 1
+	Depth int
+	// Logs are the messages of `Program log:` and the lines this parser doesn't know
+	Logs []string
+	// Data are the `Program data:` lines, each of them is split into its base64 decoded fields
+	Data [][][]byte
+	// ReturnData is the decoded `Program return:` payload
+	ReturnData []byte
+	// ComputeUnitsConsumed and ComputeUnitsLimit come from `consumed N of M compute units`, builtin programs don't log them
+	ComputeUnitsConsumed uint64
+	ComputeUnitsLimit    uint64
+	Success              bool
+	// Err is the message after `failed: `
+	Err         string
+	Invocations []*Invocation
+}
+
+// Logs are the parsed logs of a transaction
+type Logs struct {
+	Invocations []*Invocation
+	// Truncated means the runtime truncated the logs, the invocations after it are missing and
+	// the ones still running have neither Success nor Err
+	Truncated bool
+}
+
+// Walk calls fn on every invocation in the executed order
+func (l Logs) Walk(fn func(*Invocation)) {
+	var walk func([]*Invocation)
+	walk = func(invocations []*Invocation) {
+		for _, invocation := range invocations {
+			fn(invocation)
+			walk(invocation.Invocations)
+		}
+	}
+	walk(l.Invocations)
+}
+
+// Parse builds the invocation tree of the logs. the lines outside any invocation are skipped.
+func Parse(logs []string) (Logs, error) {
+	var result Logs
+	var stack []*Invocation
+	current := func() *Invocation {
+		if len(stack) == 0 {
+			return nil
+		}
+		return stack[len(stack)-1]
+	}
+
+	for i, line := range logs {
+		if line == logTruncated {
+			result.Truncated = true
+			break
+		}
+
+		switch {
+		case strings.HasPrefix(line, prefixLog):
+			if c := current(); c != nil {
+				c.Logs = append(c.Logs, strings.TrimPrefix(line, prefixLog))
+			}
+			continue
+		case strings.HasPrefix(line, prefixData):
+			data, err := decodeData(strings.TrimPrefix(line, prefixData))
+			if err != nil {
+				return Logs{}, fmt.Errorf("%w: line %v, err: %v", ErrInvalidLog, i, err)
+			}
+			if c := current(); c != nil {
+				c.Data = append(c.Data, data)
+			}
+			continue
+		case strings.HasPrefix(line, prefixReturn):
+			fields := strings.Split(strings.TrimPrefix(line, prefixReturn), " ")
+			if len(fields) != 2 {
+				return Logs{}, fmt.Errorf("%w: line %v, unexpected return data", ErrInvalidLog, i)
+			}
+			data, err := base64.StdEncoding.DecodeString(fields[1])
+			if err != nil {
+				return Logs{}, fmt.Errorf("%w: line %v, err: %v", ErrInvalidLog, i, err)
+			}
+			if c := current(); c != nil {
+				c.ReturnData = data
+			}
+			continue
+		}
+
+		programID, rest, ok := cutProgramID(line)
+		if !ok {
+			if c := current(); c != nil {
+				c.Logs = append(c.Logs, line)
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(rest, infixInvoke) && strings.HasSuffix(rest, "]"):
+			depth, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rest, infixInvoke), "]"))
+			if err != nil {
+				return Logs{}, fmt.Errorf("%w: line %v, err: %v", ErrInvalidLog, i, err)
+			}
+			if depth != len(stack)+1 {
+				return Logs{}, fmt.Errorf("%w: line %v, expected depth %v, got %v", ErrInvalidLog, i, len(stack)+1, depth)
+			}
+			invocation := &Invocation{ProgramID: programID, Depth: depth}
+			if c := current(); c != nil {
+				c.Invocations = append(c.Invocations, invocation)
+			} else {
+				result.Invocations = append(result.Invocations, invocation)
+			}
+			stack = append(stack, invocation)
+
+		case strings.HasPrefix(rest, infixConsumed) && strings.HasSuffix(rest, suffixConsumed):
+			c := current()
+			if c == nil || c.ProgramID != programID {
+				return Logs{}, fmt.Errorf("%w: line %v, %v is not running", ErrInvalidLog, i, programID)
+			}
+			consumed, limit, ok := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(rest, infixConsumed), suffixConsumed), infixConsumedOf)
+			if !ok {
+				return Logs{}, fmt.Errorf("%w: line %v, unexpected compute units", ErrInvalidLog, i)
+			}
+			var err error
+			if c.ComputeUnitsConsumed, err = strconv.ParseUint(consumed, 10, 64); err != nil {
+				return Logs{}, fmt.Errorf("%w: line %v, err: %v", ErrInvalidLog, i, err)
+			}
+			if c.ComputeUnitsLimit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+				return Logs{}, fmt.Errorf("%w: line %v, err: %v", ErrInvalidLog, i, err)
+			}
+
+		case rest == suffixSuccess || strings.HasPrefix(rest, infixFailed):
+			c := current()
+			if c == nil || c.ProgramID != programID {
+				return Logs{}, fmt.Errorf("%w: line %v, %v is not running", ErrInvalidLog, i, programID)
+			}
+			if rest == suffixSuccess {
+				c.Success = true
+			} else {
+				c.Err = strings.TrimPrefix(rest, infixFailed)
+			}
+			stack = stack[:len(stack)-1]
+
+		default:
+			if c := current(); c != nil {
+				c.Logs = append(c.Logs, line)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// cutProgramID splits `Program <program id><rest>`
+func cutProgramID(line string) (common.PublicKey, string, bool) {
+	if !strings.HasPrefix(line, prefixProgram) {
+		return common.PublicKey{}, "", false
+	}
+	s := strings.TrimPrefix(line, prefixProgram)
+	end := strings.IndexByte(s, ' ')
+	if end < 0 {
+		return common.PublicKey{}, "", false
+	}
+	b, err := base58.Decode(s[:end])
+	if err != nil || len(b) != common.PublicKeyLength {
+		return common.PublicKey{}, "", false
+	}
+	return common.PublicKeyFromBytes(b), s[end:], true
+}
+
+func decodeData(s string) ([][]byte, error) {
+	fields := strings.Fields(s)
+	data := make([][]byte, 0, len(fields))
+	for _, field := range fields {
+		b, err := base64.StdEncoding.DecodeString(field)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, b)
+	}
+	return data, nil
+}
@@ -0,0 +1,180 @@
+package programlog
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var testAnchorProgramID = common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+
+func TestParse(t *testing.T) {
+	got, err := Parse([]string{
+		"Program ComputeBudget111111111111111111111111111111 invoke [1]",
+		"Program ComputeBudget111111111111111111111111111111 success",
+		"Program ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL invoke [1]",
+		"Program log: Create",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [2]",
+		"Program log: Instruction: GetAccountDataSize",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA consumed 1622 of 394212 compute units",
+		"Program return: TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA pQAAAAAAAAA=",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA success",
+		"Program 11111111111111111111111111111111 invoke [2]",
+		"Program 11111111111111111111111111111111 success",
+		"Program data: AQI= AwQ=",
+		"Program ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL consumed 24420 of 399850 compute units",
+		"Program ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL success",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, Logs{
+		Invocations: []*Invocation{
+			{
+				ProgramID: common.ComputeBudgetProgramID,
+				Depth:     1,
+				Success:   true,
+			},
+			{
+				ProgramID:            common.SPLAssociatedTokenAccountProgramID,
+				Depth:                1,
+				Logs:                 []string{"Create"},
+				Data:                 [][][]byte{{{1, 2}, {3, 4}}},
+				ComputeUnitsConsumed: 24420,
+				ComputeUnitsLimit:    399850,
+				Success:              true,
+				Invocations: []*Invocation{
+					{
+						ProgramID:            common.TokenProgramID,
+						Depth:                2,
+						Logs:                 []string{"Instruction: GetAccountDataSize"},
+						ReturnData:           []byte{165, 0, 0, 0, 0, 0, 0, 0},
+						ComputeUnitsConsumed: 1622,
+						ComputeUnitsLimit:    394212,
+						Success:              true,
+					},
+					{
+						ProgramID: common.SystemProgramID,
+						Depth:     2,
+						Success:   true,
+					},
+				},
+			},
+		},
+	}, got)
+
+	var programIDs []common.PublicKey
+	got.Walk(func(invocation *Invocation) {
+		programIDs = append(programIDs, invocation.ProgramID)
+	})
+	assert.Equal(t, []common.PublicKey{common.ComputeBudgetProgramID, common.SPLAssociatedTokenAccountProgramID, common.TokenProgramID, common.SystemProgramID}, programIDs)
+}
+
+func TestParse_Failed(t *testing.T) {
+	got, err := Parse([]string{
+		"Program ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL invoke [1]",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [2]",
+		"Program log: Error: insufficient funds",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA consumed 2000 of 200000 compute units",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA failed: custom program error: 0x1",
+		"Program ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL failed: custom program error: 0x1",
+	})
+	assert.Nil(t, err)
+	assert.Len(t, got.Invocations, 1)
+	assert.Equal(t, "custom program error: 0x1", got.Invocations[0].Err)
+	assert.False(t, got.Invocations[0].Success)
+	assert.Equal(t, "custom program error: 0x1", got.Invocations[0].Invocations[0].Err)
+	assert.Equal(t, []string{"Error: insufficient funds"}, got.Invocations[0].Invocations[0].Logs)
+}
+
+func TestParse_Truncated(t *testing.T) {
+	got, err := Parse([]string{
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [1]",
+		"Program log: Instruction: Transfer",
+		"Log truncated",
+	})
+	assert.Nil(t, err)
+	assert.True(t, got.Truncated)
+	assert.Equal(t, []*Invocation{{ProgramID: common.TokenProgramID, Depth: 1, Logs: []string{"Instruction: Transfer"}}}, got.Invocations)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		logs []string
+	}{
+		{
+			name: "skipped depth",
+			logs: []string{"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [2]"},
+		},
+		{
+			name: "success of another program",
+			logs: []string{
+				"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [1]",
+				"Program 11111111111111111111111111111111 success",
+			},
+		},
+		{
+			name: "success without invoke",
+			logs: []string{"Program 11111111111111111111111111111111 success"},
+		},
+		{
+			name: "invalid data",
+			logs: []string{
+				"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [1]",
+				"Program data: !!",
+			},
+		},
+		{
+			name: "invalid compute units",
+			logs: []string{
+				"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [1]",
+				"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA consumed many of 200000 compute units",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.logs)
+			assert.True(t, errors.Is(err, ErrInvalidLog), err)
+		})
+	}
+}
+
+type testTradeEvent struct {
+	Mint   common.PublicKey
+	Amount uint64
+	IsBuy  bool
+}
+
+func TestLogs_AnchorEvents(t *testing.T) {
+	RegisterAnchorEventType[testTradeEvent](testAnchorProgramID, "TradeEvent")
+
+	event := testTradeEvent{Mint: common.TokenProgramID, Amount: 100, IsBuy: true}
+	b, err := borsh.Serialize(event)
+	assert.Nil(t, err)
+	discriminator := AnchorEventDiscriminator("TradeEvent")
+	data := base64.StdEncoding.EncodeToString(append(discriminator[:], b...))
+	unknown := base64.StdEncoding.EncodeToString([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	logs, err := Parse([]string{
+		"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 invoke [1]",
+		"Program data: " + unknown,
+		"Program data: " + data,
+		"Program EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7 success",
+		"Program 11111111111111111111111111111111 invoke [1]",
+		"Program data: " + data,
+		"Program 11111111111111111111111111111111 success",
+	})
+	assert.Nil(t, err)
+
+	events, err := logs.AnchorEvents()
+	assert.Nil(t, err)
+	assert.Equal(t, []AnchorEvent{{ProgramID: testAnchorProgramID, Name: "TradeEvent", Data: event}}, events)
+
+	logs.Invocations[0].Data[1][0] = logs.Invocations[0].Data[1][0][:10]
+	_, err = logs.AnchorEvents()
+	assert.NotNil(t, err)
+}
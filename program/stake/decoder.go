@@ -0,0 +1,93 @@
+package stake
+
+import (
+	"fmt"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+func init() {
+	instructiondecoder.Register(common.StakeProgramID, "stake", decodeInstruction)
+}
+
+func decodeInstruction(instruction types.Instruction) (instructiondecoder.Instruction, error) {
+	r := instructiondecoder.NewReader(instruction.Data)
+	build := func(name string, names []string, param func(k []common.PublicKey) any) (instructiondecoder.Instruction, error) {
+		return instructiondecoder.Build(r, instruction.Accounts, name, names, "", param)
+	}
+	// withCustodian names the custodian which signs when the lockup is in force
+	withCustodian := func(names ...string) []string {
+		if len(instruction.Accounts) > len(names) {
+			names = append(names, "custodian")
+		}
+		return names
+	}
+	custodian := func(k []common.PublicKey, i int) *common.PublicKey {
+		if len(k) <= i {
+			return nil
+		}
+		return &k[i]
+	}
+
+	switch i := Instruction(r.Uint32()); i {
+	case InstructionInitialize:
+		auth := Authorized{Staker: r.PublicKey(), Withdrawer: r.PublicKey()}
+		lockup := Lockup{UnixTimestamp: int64(r.Uint64()), Epoch: r.Uint64(), Cusodian: r.PublicKey()}
+		return build("Initialize", []string{"stake", "rentSysvar"}, func(k []common.PublicKey) any {
+			return InitializeParam{Stake: k[0], Auth: auth, Lockup: lockup}
+		})
+	case InstructionAuthorize:
+		newAuth, authType := r.PublicKey(), StakeAuthorizationType(r.Uint32())
+		return build("Authorize", withCustodian("stake", "clockSysvar", "authority"), func(k []common.PublicKey) any {
+			return AuthorizeParam{Stake: k[0], Auth: k[2], NewAuth: newAuth, AuthType: authType, Custodian: custodian(k, 3)}
+		})
+	case InstructionDelegateStake:
+		return build("DelegateStake", []string{"stake", "vote", "clockSysvar", "stakeHistorySysvar", "stakeConfig", "authority"}, func(k []common.PublicKey) any {
+			return DelegateStakeParam{Stake: k[0], Auth: k[5], Vote: k[1]}
+		})
+	case InstructionSplit:
+		lamports := r.Uint64()
+		return build("Split", []string{"stake", "splitStake", "authority"}, func(k []common.PublicKey) any {
+			return SplitParam{Stake: k[0], Auth: k[2], SplitStake: k[1], Lamports: lamports}
+		})
+	case InstructionWithdraw:
+		lamports := r.Uint64()
+		return build("Withdraw", withCustodian("stake", "to", "clockSysvar", "stakeHistorySysvar", "authority"), func(k []common.PublicKey) any {
+			return WithdrawParam{Stake: k[0], Auth: k[4], To: k[1], Lamports: lamports, Custodian: custodian(k, 5)}
+		})
+	case InstructionDeactivate:
+		return build("Deactivate", []string{"stake", "clockSysvar", "authority"}, func(k []common.PublicKey) any {
+			return DeactivateParam{Stake: k[0], Auth: k[2]}
+		})
+	case InstructionSetLockup:
+		var lockup LockupParam
+		if r.Bool() {
+			unixTimestamp := int64(r.Uint64())
+			lockup.UnixTimestamp = &unixTimestamp
+		}
+		if r.Bool() {
+			epoch := r.Uint64()
+			lockup.Epoch = &epoch
+		}
+		lockup.Cusodian = r.OptionalPublicKey()
+		return build("SetLockup", []string{"stake", "authority"}, func(k []common.PublicKey) any {
+			return SetLockupParam{Stake: k[0], Auth: k[1], Lockup: lockup}
+		})
+	case InstructionMerge:
+		return build("Merge", []string{"to", "from", "clockSysvar", "stakeHistorySysvar", "authority"}, func(k []common.PublicKey) any {
+			return MergeParam{From: k[1], Auth: k[4], To: k[0]}
+		})
+	case InstructionAuthorizeWithSeed:
+		newAuth, authType, seed, owner := r.PublicKey(), StakeAuthorizationType(r.Uint32()), r.BincodeString(), r.PublicKey()
+		return build("AuthorizeWithSeed", withCustodian("stake", "authorityBase", "clockSysvar"), func(k []common.PublicKey) any {
+			return AuthorizeWithSeedParam{Stake: k[0], AuthBase: k[1], AuthSeed: seed, AuthOwner: owner, NewAuth: newAuth, AuthType: authType, Custodian: custodian(k, 3)}
+		})
+	default:
+		if err := r.Err(); err != nil {
+			return instructiondecoder.Instruction{}, err
+		}
+		return instructiondecoder.Instruction{}, fmt.Errorf("%w: %v", instructiondecoder.ErrUnknownInstruction, i)
+	}
+}
@@ -0,0 +1,50 @@
+package stake
+
+import (
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeInstruction(t *testing.T) {
+	a := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	b := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	c := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+	unixTimestamp, epoch := int64(-1), uint64(10)
+
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		param       any
+	}{
+		{"Initialize", Initialize(InitializeParam{Stake: a, Auth: Authorized{Staker: b, Withdrawer: c}, Lockup: Lockup{UnixTimestamp: 1, Epoch: 2, Cusodian: c}}), InitializeParam{Stake: a, Auth: Authorized{Staker: b, Withdrawer: c}, Lockup: Lockup{UnixTimestamp: 1, Epoch: 2, Cusodian: c}}},
+		{"Authorize", Authorize(AuthorizeParam{Stake: a, Auth: b, NewAuth: c, AuthType: StakeAuthorizationTypeWithdrawer}), AuthorizeParam{Stake: a, Auth: b, NewAuth: c, AuthType: StakeAuthorizationTypeWithdrawer}},
+		{"Authorize", Authorize(AuthorizeParam{Stake: a, Auth: b, NewAuth: c, AuthType: StakeAuthorizationTypeStaker, Custodian: &a}), AuthorizeParam{Stake: a, Auth: b, NewAuth: c, AuthType: StakeAuthorizationTypeStaker, Custodian: &a}},
+		{"DelegateStake", DelegateStake(DelegateStakeParam{Stake: a, Auth: b, Vote: c}), DelegateStakeParam{Stake: a, Auth: b, Vote: c}},
+		{"Split", Split(SplitParam{Stake: a, Auth: b, SplitStake: c, Lamports: 1}), SplitParam{Stake: a, Auth: b, SplitStake: c, Lamports: 1}},
+		{"Withdraw", Withdraw(WithdrawParam{Stake: a, Auth: b, To: c, Lamports: 1}), WithdrawParam{Stake: a, Auth: b, To: c, Lamports: 1}},
+		{"Withdraw", Withdraw(WithdrawParam{Stake: a, Auth: b, To: c, Lamports: 1, Custodian: &a}), WithdrawParam{Stake: a, Auth: b, To: c, Lamports: 1, Custodian: &a}},
+		{"Deactivate", Deactivate(DeactivateParam{Stake: a, Auth: b}), DeactivateParam{Stake: a, Auth: b}},
+		{"SetLockup", SetLockup(SetLockupParam{Stake: a, Auth: b, Lockup: LockupParam{UnixTimestamp: &unixTimestamp, Epoch: &epoch, Cusodian: &c}}), SetLockupParam{Stake: a, Auth: b, Lockup: LockupParam{UnixTimestamp: &unixTimestamp, Epoch: &epoch, Cusodian: &c}}},
+		{"SetLockup", SetLockup(SetLockupParam{Stake: a, Auth: b, Lockup: LockupParam{Epoch: &epoch}}), SetLockupParam{Stake: a, Auth: b, Lockup: LockupParam{Epoch: &epoch}}},
+		{"Merge", Merge(MergeParam{From: a, Auth: b, To: c}), MergeParam{From: a, Auth: b, To: c}},
+		{"AuthorizeWithSeed", AuthorizeWithSeed(AuthorizeWithSeedParam{Stake: a, AuthBase: b, AuthSeed: "seed", AuthOwner: common.SystemProgramID, NewAuth: c, AuthType: StakeAuthorizationTypeStaker}), AuthorizeWithSeedParam{Stake: a, AuthBase: b, AuthSeed: "seed", AuthOwner: common.SystemProgramID, NewAuth: c, AuthType: StakeAuthorizationTypeStaker}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instructiondecoder.Decode(tt.instruction)
+			assert.Nil(t, err)
+			assert.Equal(t, "stake", got.Program)
+			assert.Equal(t, tt.name, got.Name)
+			assert.Equal(t, tt.param, got.Param)
+			assert.Len(t, got.Accounts, len(tt.instruction.Accounts))
+			for i, account := range got.Accounts {
+				assert.NotEmpty(t, account.Name)
+				assert.Equal(t, tt.instruction.Accounts[i], account.AccountMeta)
+			}
+		})
+	}
+}
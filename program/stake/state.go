@@ -3,8 +3,10 @@ package stake
 import (
 	"encoding/binary"
 	"fmt"
-	"github.com/blocto/solana-go-sdk/common"
 	"math"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/bytes_decoder"
 )
 
 const StakeAccountSize = 200
@@ -130,30 +132,61 @@ func VoteAccountDeserialize(data []byte) (VoteAccount, error) {
 	if len(data) < VoteAccountSize {
 		return VoteAccount{}, fmt.Errorf("vote account data size is not enough")
 	}
+	account, err := voteAccountFromData(data)
+	if err != nil {
+		return VoteAccount{}, fmt.Errorf("failed to parse vote account, err: %w", err)
+	}
+	return account, nil
+}
+
+func voteAccountFromData(data []byte) (VoteAccount, error) {
 	index := 0
-	stakeType := binary.LittleEndian.Uint32(data[:index+4])
-	index += 4
+	stakeType, err := bytes_decoder.GetUint32(&index, data)
+	if err != nil {
+		return VoteAccount{}, err
+	}
 	_ = stakeType
 
-	account := VoteAccount{
-		NodePubkey:           common.PublicKeyFromBytes(data[index : index+32]),
-		AuthorizedWithdrawer: common.PublicKeyFromBytes(data[index+32 : index+64]),
-		Commission:           data[index+64],
+	var account VoteAccount
+	nodePubkey, err := bytes_decoder.GetBytes32(&index, data)
+	if err != nil {
+		return VoteAccount{}, err
+	}
+	account.NodePubkey = nodePubkey
+	authorizedWithdrawer, err := bytes_decoder.GetBytes32(&index, data)
+	if err != nil {
+		return VoteAccount{}, err
+	}
+	account.AuthorizedWithdrawer = authorizedWithdrawer
+	account.Commission, err = bytes_decoder.GetUint8(&index, data)
+	if err != nil {
+		return VoteAccount{}, err
 	}
-	index += 65
 
 	// Votes
 	{
-		voteCount := int(binary.LittleEndian.Uint64(data[index : index+8]))
-		index += 8
+		voteCount, err := bytes_decoder.GetUint64(&index, data)
+		if err != nil {
+			return VoteAccount{}, err
+		}
 		index += 1 //TODO
+		if err := bytes_decoder.CheckCount(&index, data, voteCount, 13); err != nil {
+			return VoteAccount{}, err
+		}
 		votes := make([]Lockout, 0, voteCount)
-		for i := 0; i < voteCount; i++ {
+		for i := uint64(0); i < voteCount; i++ {
+			slot, err := bytes_decoder.GetUint64(&index, data)
+			if err != nil {
+				return VoteAccount{}, err
+			}
+			confirmationCount, err := bytes_decoder.GetUint32(&index, data)
+			if err != nil {
+				return VoteAccount{}, err
+			}
 			votes = append(votes, Lockout{
-				Slot:              binary.LittleEndian.Uint64(data[index : index+8]),
-				ConfirmationCount: binary.LittleEndian.Uint32(data[index+8 : index+12]),
+				Slot:              slot,
+				ConfirmationCount: confirmationCount,
 			})
-			index += 12
 			index += 1 //TODO
 		}
 		account.Votes = votes
@@ -161,22 +194,36 @@ func VoteAccountDeserialize(data []byte) (VoteAccount, error) {
 
 	//RootSlot
 	{
-		rootslot := binary.LittleEndian.Uint64(data[index : index+8])
+		rootslot, err := bytes_decoder.GetUint64(&index, data)
+		if err != nil {
+			return VoteAccount{}, err
+		}
 		account.RootSlot = &rootslot
-		index += 8
 	}
 
 	// AuthorizedVoters
 	{
-		size := binary.LittleEndian.Uint64(data[index : index+8])
-		index += 8
+		size, err := bytes_decoder.GetUint64(&index, data)
+		if err != nil {
+			return VoteAccount{}, err
+		}
+		if err := bytes_decoder.CheckCount(&index, data, size, 40); err != nil {
+			return VoteAccount{}, err
+		}
 		authorizedVotes := make([]AuthorizedVoters, 0, size)
-		for i := 0; i < int(size); i++ {
+		for i := uint64(0); i < size; i++ {
+			epoch, err := bytes_decoder.GetUint64(&index, data)
+			if err != nil {
+				return VoteAccount{}, err
+			}
+			authorizedVoter, err := bytes_decoder.GetBytes32(&index, data)
+			if err != nil {
+				return VoteAccount{}, err
+			}
 			authorizedVotes = append(authorizedVotes, AuthorizedVoters{
-				Epoch:           binary.LittleEndian.Uint64(data[index : index+8]),
-				AuthorizedVoter: common.PublicKeyFromBytes(data[index+8 : index+40]),
+				Epoch:           epoch,
+				AuthorizedVoter: authorizedVoter,
 			})
-			index += 40
 		}
 		account.AuthorizedVoters = authorizedVotes
 	}
@@ -186,26 +233,44 @@ func VoteAccountDeserialize(data []byte) (VoteAccount, error) {
 
 	//EpochCredits
 	{
-		size := binary.LittleEndian.Uint64(data[index : index+8])
-		index += 8
+		size, err := bytes_decoder.GetUint64(&index, data)
+		if err != nil {
+			return VoteAccount{}, err
+		}
+		if err := bytes_decoder.CheckCount(&index, data, size, 24); err != nil {
+			return VoteAccount{}, err
+		}
 		epochCredits := make([]EpochCredits, 0, size)
-		for i := 0; i < int(size); i++ {
+		for i := uint64(0); i < size; i++ {
+			var v [3]uint64
+			for j := range v {
+				v[j], err = bytes_decoder.GetUint64(&index, data)
+				if err != nil {
+					return VoteAccount{}, err
+				}
+			}
 			epochCredits = append(epochCredits, EpochCredits{
-				Epoch:            binary.LittleEndian.Uint64(data[index : index+8]),
-				Credits:          binary.LittleEndian.Uint64(data[index+8 : index+16]),
-				Previous_credits: binary.LittleEndian.Uint64(data[index+16 : index+24]),
+				Epoch:            v[0],
+				Credits:          v[1],
+				Previous_credits: v[2],
 			})
-			index += 24
 		}
 		account.EpochCredits = epochCredits
 	}
 
 	//LastTimestamp
+	slot, err := bytes_decoder.GetUint64(&index, data)
+	if err != nil {
+		return VoteAccount{}, err
+	}
+	timestamp, err := bytes_decoder.GetUint64(&index, data)
+	if err != nil {
+		return VoteAccount{}, err
+	}
 	account.LastTimestamp = BlockTimestamp{
-		Slot:      binary.LittleEndian.Uint64(data[index : index+8]),
-		Timestamp: int64(binary.LittleEndian.Uint32(data[index+8 : index+16])),
+		Slot:      slot,
+		Timestamp: int64(timestamp),
 	}
-	index += 16
 
 	//其他内容
 
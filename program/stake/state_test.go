@@ -1,9 +1,11 @@
 package stake
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/bytes_decoder"
 	"github.com/stretchr/testify/require"
 	"reflect"
 	"testing"
@@ -95,3 +97,28 @@ func TestStakeAccountSelfTest2(t *testing.T) {
 	fmt.Printf("%+v \n", info)
 	//fmt.Printf("%+v \n", *info.Stake)
 }
+
+func TestVoteAccountDeserialize_Truncated(t *testing.T) {
+	data := make([]byte, VoteAccountSize)
+	// the count of the votes
+	binary.LittleEndian.PutUint64(data[69:77], ^uint64(0))
+	_, err := VoteAccountDeserialize(data)
+	require.ErrorIs(t, err, bytes_decoder.ErrInsufficientData)
+
+	_, err = VoteAccountDeserialize(data[:VoteAccountSize-1])
+	require.Error(t, err)
+}
+
+func FuzzVoteAccountDeserialize(f *testing.F) {
+	f.Add(make([]byte, VoteAccountSize))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = VoteAccountDeserialize(data)
+	})
+}
+
+func FuzzStakeAccountDeserialize(f *testing.F) {
+	f.Add(make([]byte, StakeAccountSize))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = StakeAccountDeserialize(data)
+	})
+}
@@ -0,0 +1,96 @@
+package system
+
+import (
+	"fmt"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+func init() {
+	instructiondecoder.Register(common.SystemProgramID, "system", decodeInstruction)
+}
+
+func decodeInstruction(instruction types.Instruction) (instructiondecoder.Instruction, error) {
+	r := instructiondecoder.NewReader(instruction.Data)
+	build := func(name string, names []string, param func(k []common.PublicKey) any) (instructiondecoder.Instruction, error) {
+		return instructiondecoder.Build(r, instruction.Accounts, name, names, "", param)
+	}
+
+	switch i := Instruction(r.Uint32()); i {
+	case InstructionCreateAccount:
+		lamports, space, owner := r.Uint64(), r.Uint64(), r.PublicKey()
+		return build("CreateAccount", []string{"from", "new"}, func(k []common.PublicKey) any {
+			return CreateAccountParam{From: k[0], New: k[1], Owner: owner, Lamports: lamports, Space: space}
+		})
+	case InstructionAssign:
+		owner := r.PublicKey()
+		return build("Assign", []string{"account"}, func(k []common.PublicKey) any {
+			return AssignParam{From: k[0], Owner: owner}
+		})
+	case InstructionTransfer:
+		amount := r.Uint64()
+		return build("Transfer", []string{"from", "to"}, func(k []common.PublicKey) any {
+			return TransferParam{From: k[0], To: k[1], Amount: amount}
+		})
+	case InstructionCreateAccountWithSeed:
+		base, seed, lamports, space, owner := r.PublicKey(), r.BincodeString(), r.Uint64(), r.Uint64(), r.PublicKey()
+		names := []string{"from", "new"}
+		// the base is only passed when it isn't the funder
+		if len(instruction.Accounts) > 2 {
+			names = append(names, "base")
+		}
+		return build("CreateAccountWithSeed", names, func(k []common.PublicKey) any {
+			return CreateAccountWithSeedParam{From: k[0], New: k[1], Base: base, Owner: owner, Seed: seed, Lamports: lamports, Space: space}
+		})
+	case InstructionAdvanceNonceAccount:
+		return build("AdvanceNonceAccount", []string{"nonce", "recentBlockhashesSysvar", "authority"}, func(k []common.PublicKey) any {
+			return AdvanceNonceAccountParam{Nonce: k[0], Auth: k[2]}
+		})
+	case InstructionWithdrawNonceAccount:
+		amount := r.Uint64()
+		return build("WithdrawNonceAccount", []string{"nonce", "to", "recentBlockhashesSysvar", "rentSysvar", "authority"}, func(k []common.PublicKey) any {
+			return WithdrawNonceAccountParam{Nonce: k[0], Auth: k[4], To: k[1], Amount: amount}
+		})
+	case InstructionInitializeNonceAccount:
+		auth := r.PublicKey()
+		return build("InitializeNonceAccount", []string{"nonce", "recentBlockhashesSysvar", "rentSysvar"}, func(k []common.PublicKey) any {
+			return InitializeNonceAccountParam{Nonce: k[0], Auth: auth}
+		})
+	case InstructionAuthorizeNonceAccount:
+		newAuth := r.PublicKey()
+		return build("AuthorizeNonceAccount", []string{"nonce", "authority"}, func(k []common.PublicKey) any {
+			return AuthorizeNonceAccountParam{Nonce: k[0], Auth: k[1], NewAuth: newAuth}
+		})
+	case InstructionAllocate:
+		space := r.Uint64()
+		return build("Allocate", []string{"account"}, func(k []common.PublicKey) any {
+			return AllocateParam{Account: k[0], Space: space}
+		})
+	case InstructionAllocateWithSeed:
+		base, seed, space, owner := r.PublicKey(), r.BincodeString(), r.Uint64(), r.PublicKey()
+		return build("AllocateWithSeed", []string{"account", "base"}, func(k []common.PublicKey) any {
+			return AllocateWithSeedParam{Account: k[0], Base: base, Owner: owner, Seed: seed, Space: space}
+		})
+	case InstructionAssignWithSeed:
+		base, seed, owner := r.PublicKey(), r.BincodeString(), r.PublicKey()
+		return build("AssignWithSeed", []string{"account", "base"}, func(k []common.PublicKey) any {
+			return AssignWithSeedParam{Account: k[0], Owner: owner, Base: base, Seed: seed}
+		})
+	case InstructionTransferWithSeed:
+		amount, seed, owner := r.Uint64(), r.BincodeString(), r.PublicKey()
+		return build("TransferWithSeed", []string{"from", "base", "to"}, func(k []common.PublicKey) any {
+			return TransferWithSeedParam{From: k[0], To: k[2], Base: k[1], Owner: owner, Seed: seed, Amount: amount}
+		})
+	case InstructionUpgradeNonceAccount:
+		return build("UpgradeNonceAccount", []string{"nonce"}, func(k []common.PublicKey) any {
+			return UpgradeNonceAccountParam{NonceAccountPubkey: k[0]}
+		})
+	default:
+		if err := r.Err(); err != nil {
+			return instructiondecoder.Instruction{}, err
+		}
+		return instructiondecoder.Instruction{}, fmt.Errorf("%w: %v", instructiondecoder.ErrUnknownInstruction, i)
+	}
+}
@@ -0,0 +1,104 @@
+package system
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeInstruction(t *testing.T) {
+	a := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	b := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	c := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		param       any
+	}{
+		{"CreateAccount", CreateAccount(CreateAccountParam{From: a, New: b, Owner: common.StakeProgramID, Lamports: 1, Space: 200}), CreateAccountParam{From: a, New: b, Owner: common.StakeProgramID, Lamports: 1, Space: 200}},
+		{"Assign", Assign(AssignParam{From: a, Owner: common.StakeProgramID}), AssignParam{From: a, Owner: common.StakeProgramID}},
+		{"Transfer", Transfer(TransferParam{From: a, To: b, Amount: 1_000_000_000}), TransferParam{From: a, To: b, Amount: 1_000_000_000}},
+		{"CreateAccountWithSeed", CreateAccountWithSeed(CreateAccountWithSeedParam{From: a, New: b, Base: c, Owner: common.StakeProgramID, Seed: "seed", Lamports: 1, Space: 200}), CreateAccountWithSeedParam{From: a, New: b, Base: c, Owner: common.StakeProgramID, Seed: "seed", Lamports: 1, Space: 200}},
+		{"CreateAccountWithSeed", CreateAccountWithSeed(CreateAccountWithSeedParam{From: a, New: b, Base: a, Owner: common.StakeProgramID, Seed: "seed", Lamports: 1, Space: 200}), CreateAccountWithSeedParam{From: a, New: b, Base: a, Owner: common.StakeProgramID, Seed: "seed", Lamports: 1, Space: 200}},
+		{"AdvanceNonceAccount", AdvanceNonceAccount(AdvanceNonceAccountParam{Nonce: a, Auth: b}), AdvanceNonceAccountParam{Nonce: a, Auth: b}},
+		{"WithdrawNonceAccount", WithdrawNonceAccount(WithdrawNonceAccountParam{Nonce: a, Auth: b, To: c, Amount: 1}), WithdrawNonceAccountParam{Nonce: a, Auth: b, To: c, Amount: 1}},
+		{"InitializeNonceAccount", InitializeNonceAccount(InitializeNonceAccountParam{Nonce: a, Auth: b}), InitializeNonceAccountParam{Nonce: a, Auth: b}},
+		{"AuthorizeNonceAccount", AuthorizeNonceAccount(AuthorizeNonceAccountParam{Nonce: a, Auth: b, NewAuth: c}), AuthorizeNonceAccountParam{Nonce: a, Auth: b, NewAuth: c}},
+		{"Allocate", Allocate(AllocateParam{Account: a, Space: 10}), AllocateParam{Account: a, Space: 10}},
+		{"AllocateWithSeed", AllocateWithSeed(AllocateWithSeedParam{Account: a, Base: b, Owner: common.StakeProgramID, Seed: "seed", Space: 10}), AllocateWithSeedParam{Account: a, Base: b, Owner: common.StakeProgramID, Seed: "seed", Space: 10}},
+		{"AssignWithSeed", AssignWithSeed(AssignWithSeedParam{Account: a, Owner: common.StakeProgramID, Base: b, Seed: "seed"}), AssignWithSeedParam{Account: a, Owner: common.StakeProgramID, Base: b, Seed: "seed"}},
+		{"TransferWithSeed", TransferWithSeed(TransferWithSeedParam{From: a, To: b, Base: c, Owner: common.StakeProgramID, Seed: "seed", Amount: 1}), TransferWithSeedParam{From: a, To: b, Base: c, Owner: common.StakeProgramID, Seed: "seed", Amount: 1}},
+		{"UpgradeNonceAccount", UpgradeNonceAccount(UpgradeNonceAccountParam{NonceAccountPubkey: a}), UpgradeNonceAccountParam{NonceAccountPubkey: a}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instructiondecoder.Decode(tt.instruction)
+			assert.Nil(t, err)
+			assert.Equal(t, "system", got.Program)
+			assert.Equal(t, tt.name, got.Name)
+			assert.Equal(t, tt.param, got.Param)
+			assert.Len(t, got.Accounts, len(tt.instruction.Accounts))
+			for i, account := range got.Accounts {
+				assert.NotEmpty(t, account.Name)
+				assert.Equal(t, tt.instruction.Accounts[i], account.AccountMeta)
+			}
+		})
+	}
+}
+
+func TestDecodeInstruction_Accounts(t *testing.T) {
+	from := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	to := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	got, err := instructiondecoder.Decode(Transfer(TransferParam{From: from, To: to, Amount: 1}))
+	assert.Nil(t, err)
+	assert.Equal(t, []instructiondecoder.Account{
+		{Name: "from", AccountMeta: types.AccountMeta{PubKey: from, IsSigner: true, IsWritable: true}},
+		{Name: "to", AccountMeta: types.AccountMeta{PubKey: to, IsSigner: false, IsWritable: true}},
+	}, got.Accounts)
+}
+
+func TestDecodeInstruction_Failed(t *testing.T) {
+	transfer := Transfer(TransferParam{Amount: 1})
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		wantErr     error
+	}{
+		{
+			name:        "unknown instruction",
+			instruction: types.Instruction{ProgramID: common.SystemProgramID, Data: []byte{99, 0, 0, 0}},
+			wantErr:     instructiondecoder.ErrUnknownInstruction,
+		},
+		{
+			name:        "empty data",
+			instruction: types.Instruction{ProgramID: common.SystemProgramID},
+			wantErr:     instructiondecoder.ErrInvalidInstructionData,
+		},
+		{
+			name:        "short data",
+			instruction: types.Instruction{ProgramID: common.SystemProgramID, Accounts: transfer.Accounts, Data: transfer.Data[:8]},
+			wantErr:     instructiondecoder.ErrInvalidInstructionData,
+		},
+		{
+			name:        "seed length exceeds the data",
+			instruction: types.Instruction{ProgramID: common.SystemProgramID, Data: append(append([]byte{3, 0, 0, 0}, make([]byte, 32)...), 0xe8, 0x03, 0, 0, 0, 0, 0, 0)},
+			wantErr:     instructiondecoder.ErrInvalidInstructionData,
+		},
+		{
+			name:        "not enough accounts",
+			instruction: types.Instruction{ProgramID: common.SystemProgramID, Accounts: transfer.Accounts[:1], Data: transfer.Data},
+			wantErr:     instructiondecoder.ErrNotEnoughAccounts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := instructiondecoder.Decode(tt.instruction)
+			assert.True(t, errors.Is(err, tt.wantErr), err)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package system
+
+import (
+	"errors"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/programerror"
+)
+
+// custom errors of the system program
+var (
+	ErrAccountAlreadyInUse        = errors.New("an account with the same address already exists")
+	ErrResultWithNegativeLamports = errors.New("account does not have enough sol to perform the operation")
+	ErrInvalidProgramId           = errors.New("cannot assign account to this program id")
+	ErrInvalidAccountDataLength   = errors.New("cannot allocate account data of this length")
+	ErrMaxSeedLengthExceeded      = errors.New("length of requested seed is too long")
+	ErrAddressWithSeedMismatch    = errors.New("provided address does not match addressed derived from seed")
+	ErrNonceNoRecentBlockhashes   = errors.New("advancing stored nonce requires a populated recentblockhashes sysvar")
+	ErrNonceBlockhashNotExpired   = errors.New("stored nonce is still in recent_blockhashes")
+	ErrNonceUnexpectedBlockhash   = errors.New("specified nonce does not match stored nonce")
+)
+
+func init() {
+	programerror.Register(common.SystemProgramID, map[uint32]error{
+		0: ErrAccountAlreadyInUse,
+		1: ErrResultWithNegativeLamports,
+		2: ErrInvalidProgramId,
+		3: ErrInvalidAccountDataLength,
+		4: ErrMaxSeedLengthExceeded,
+		5: ErrAddressWithSeedMismatch,
+		6: ErrNonceNoRecentBlockhashes,
+		7: ErrNonceBlockhashNotExpired,
+		8: ErrNonceUnexpectedBlockhash,
+	})
+}
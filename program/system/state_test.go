@@ -46,3 +46,10 @@ func TestNonceAccountDeserialize(t *testing.T) {
 		})
 	}
 }
+
+func FuzzNonceAccountDeserialize(f *testing.F) {
+	f.Add(make([]byte, NonceAccountSize))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = NonceAccountDeserialize(data)
+	})
+}
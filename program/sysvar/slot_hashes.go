@@ -22,6 +22,9 @@ func DeserializeSlotHashes(data []byte, owner common.PublicKey) (SlotHashes, err
 	if err != nil {
 		return SlotHashes{}, err
 	}
+	if err := bytes_decoder.CheckCount(&current, data, len, 40); err != nil {
+		return SlotHashes{}, err
+	}
 
 	v := make([]SlotHash, 0, len)
 	for i := uint64(0); i < len; i++ {
@@ -61,3 +61,10 @@ func TestDeserializeSlotHashes(t *testing.T) {
 		})
 	}
 }
+
+func FuzzDeserializeSlotHashes(f *testing.F) {
+	f.Add([]byte{1, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = DeserializeSlotHashes(data, common.SysVarPubkey)
+	})
+}
@@ -0,0 +1,370 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+func init() {
+	instructiondecoder.Register(common.TokenProgramID, "spl-token", decodeInstruction)
+	instructiondecoder.Register(common.Token2022ProgramID, "spl-token-2022", decodeInstruction)
+}
+
+func decodeInstruction(instruction types.Instruction) (instructiondecoder.Instruction, error) {
+	r := instructiondecoder.NewReader(instruction.Data)
+	programID := instruction.ProgramID
+	// the accounts after the names are the signers of a multisig authority
+	build := func(name string, names []string, param func(k []common.PublicKey) any) (instructiondecoder.Instruction, error) {
+		return instructiondecoder.Build(r, instruction.Accounts, name, names, "signer", param)
+	}
+	signers := instructiondecoder.Rest
+
+	switch i := Instruction(r.Uint8()); i {
+	case InstructionInitializeMint:
+		decimals, mintAuth, freezeAuth := r.Uint8(), r.PublicKey(), r.OptionalPublicKey()
+		return build("InitializeMint", []string{"mint", "rentSysvar"}, func(k []common.PublicKey) any {
+			return InitializeMintParam{Decimals: decimals, Mint: k[0], MintAuth: mintAuth, FreezeAuth: freezeAuth}
+		})
+	case InstructionInitializeAccount:
+		return build("InitializeAccount", []string{"account", "mint", "owner", "rentSysvar"}, func(k []common.PublicKey) any {
+			return InitializeAccountParam{Account: k[0], Mint: k[1], Owner: k[2]}
+		})
+	case InstructionInitializeMultisig:
+		minRequired := r.Uint8()
+		return build("InitializeMultisig", []string{"multisig", "rentSysvar"}, func(k []common.PublicKey) any {
+			return InitializeMultisigParam{Account: k[0], Signers: signers(k, 2), MinRequired: minRequired}
+		})
+	case InstructionTransfer:
+		amount := r.Uint64()
+		return build("Transfer", []string{"source", "destination", "authority"}, func(k []common.PublicKey) any {
+			return TransferParam{From: k[0], To: k[1], Auth: k[2], Signers: signers(k, 3), Amount: amount}
+		})
+	case InstructionApprove:
+		amount := r.Uint64()
+		return build("Approve", []string{"source", "delegate", "owner"}, func(k []common.PublicKey) any {
+			return ApproveParam{From: k[0], To: k[1], Auth: k[2], Signers: signers(k, 3), Amount: amount}
+		})
+	case InstructionRevoke:
+		return build("Revoke", []string{"source", "owner"}, func(k []common.PublicKey) any {
+			return RevokeParam{From: k[0], Auth: k[1], Signers: signers(k, 2)}
+		})
+	case InstructionSetAuthority:
+		authType, newAuth := AuthorityType(r.Uint8()), r.OptionalPublicKey()
+		return build("SetAuthority", []string{"account", "authority"}, func(k []common.PublicKey) any {
+			return SetAuthorityParam{Account: k[0], NewAuth: newAuth, AuthType: authType, Auth: k[1], Signers: signers(k, 2)}
+		})
+	case InstructionMintTo:
+		amount := r.Uint64()
+		return build("MintTo", []string{"mint", "account", "mintAuthority"}, func(k []common.PublicKey) any {
+			return MintToParam{Mint: k[0], To: k[1], Auth: k[2], Signers: signers(k, 3), Amount: amount}
+		})
+	case InstructionBurn:
+		amount := r.Uint64()
+		return build("Burn", []string{"account", "mint", "authority"}, func(k []common.PublicKey) any {
+			return BurnParam{Account: k[0], Mint: k[1], Auth: k[2], Signers: signers(k, 3), Amount: amount}
+		})
+	case InstructionCloseAccount:
+		return build("CloseAccount", []string{"account", "destination", "owner"}, func(k []common.PublicKey) any {
+			return CloseAccountParam{Account: k[0], To: k[1], Auth: k[2], Signers: signers(k, 3)}
+		})
+	case InstructionFreezeAccount:
+		return build("FreezeAccount", []string{"account", "mint", "freezeAuthority"}, func(k []common.PublicKey) any {
+			return FreezeAccountParam{Account: k[0], Mint: k[1], Auth: k[2], Signers: signers(k, 3)}
+		})
+	case InstructionThawAccount:
+		return build("ThawAccount", []string{"account", "mint", "freezeAuthority"}, func(k []common.PublicKey) any {
+			return ThawAccountParam{Account: k[0], Mint: k[1], Auth: k[2], Signers: signers(k, 3)}
+		})
+	case InstructionTransferChecked:
+		amount, decimals := r.Uint64(), r.Uint8()
+		return build("TransferChecked", []string{"source", "mint", "destination", "authority"}, func(k []common.PublicKey) any {
+			return TransferCheckedParam{From: k[0], To: k[2], Mint: k[1], Auth: k[3], Signers: signers(k, 4), Amount: amount, Decimals: decimals, ProgramID: programID}
+		})
+	case InstructionApproveChecked:
+		amount, decimals := r.Uint64(), r.Uint8()
+		return build("ApproveChecked", []string{"source", "mint", "delegate", "owner"}, func(k []common.PublicKey) any {
+			return ApproveCheckedParam{From: k[0], Mint: k[1], To: k[2], Auth: k[3], Signers: signers(k, 4), Amount: amount, Decimals: decimals}
+		})
+	case InstructionMintToChecked:
+		amount, decimals := r.Uint64(), r.Uint8()
+		return build("MintToChecked", []string{"mint", "account", "mintAuthority"}, func(k []common.PublicKey) any {
+			return MintToCheckedParam{Mint: k[0], To: k[1], Auth: k[2], Signers: signers(k, 3), Amount: amount, Decimals: decimals}
+		})
+	case InstructionBurnChecked:
+		amount, decimals := r.Uint64(), r.Uint8()
+		return build("BurnChecked", []string{"account", "mint", "authority"}, func(k []common.PublicKey) any {
+			return BurnCheckedParam{Account: k[0], Mint: k[1], Auth: k[2], Signers: signers(k, 3), Amount: amount, Decimals: decimals}
+		})
+	case InstructionInitializeAccount2:
+		owner := r.PublicKey()
+		return build("InitializeAccount2", []string{"account", "mint", "rentSysvar"}, func(k []common.PublicKey) any {
+			return InitializeAccount2Param{Account: k[0], Mint: k[1], Owner: owner}
+		})
+	case InstructionSyncNative:
+		return build("SyncNative", []string{"account"}, func(k []common.PublicKey) any {
+			return SyncNativeParam{Account: k[0]}
+		})
+	case InstructionInitializeAccount3:
+		owner := r.PublicKey()
+		return build("InitializeAccount3", []string{"account", "mint"}, func(k []common.PublicKey) any {
+			return InitializeAccount3Param{Account: k[0], Mint: k[1], Owner: owner}
+		})
+	case InstructionInitializeMultisig2:
+		minRequired := r.Uint8()
+		return build("InitializeMultisig2", []string{"multisig"}, func(k []common.PublicKey) any {
+			return InitializeMultisig2Param{Account: k[0], Signers: signers(k, 1), MinRequired: minRequired}
+		})
+	case InstructionInitializeMint2:
+		decimals, mintAuth, freezeAuth := r.Uint8(), r.PublicKey(), r.OptionalPublicKey()
+		return build("InitializeMint2", []string{"mint"}, func(k []common.PublicKey) any {
+			return InitializeMint2Param{Decimals: decimals, Mint: k[0], MintAuth: mintAuth, FreezeAuth: freezeAuth}
+		})
+	case InstructionGetAccountDataSize:
+		extensionTypes := readExtensionTypes(r)
+		return build("GetAccountDataSize", []string{"mint"}, func(k []common.PublicKey) any {
+			return GetAccountDataSizeParam{Mint: k[0], ExtensionTypes: extensionTypes, ProgramID: programID}
+		})
+	case InstructionInitializeImmutableOwner:
+		return build("InitializeImmutableOwner", []string{"account"}, func(k []common.PublicKey) any {
+			return InitializeImmutableOwnerParam{Account: k[0], ProgramID: programID}
+		})
+	case InstructionAmountToUiAmount:
+		amount := r.Uint64()
+		return build("AmountToUiAmount", []string{"mint"}, func(k []common.PublicKey) any {
+			return AmountToUiAmountParam{Mint: k[0], Amount: amount, ProgramID: programID}
+		})
+	case InstructionUiAmountToAmount:
+		uiAmount := string(r.Rest())
+		return build("UiAmountToAmount", []string{"mint"}, func(k []common.PublicKey) any {
+			return UiAmountToAmountParam{Mint: k[0], UiAmount: uiAmount, ProgramID: programID}
+		})
+	case InstructionInitializeMintCloseAuthority:
+		closeAuthority := r.OptionalPublicKey()
+		return build("InitializeMintCloseAuthority", []string{"mint"}, func(k []common.PublicKey) any {
+			return InitializeMintCloseAuthorityParam{Mint: k[0], CloseAuthority: closeAuthority, ProgramID: programID}
+		})
+	case InstructionTransferFeeExtension:
+		return decodeTransferFeeInstruction(r, instruction)
+	case InstructionDefaultAccountStateExtension:
+		switch sub := DefaultAccountStateInstruction(r.Uint8()); sub {
+		case DefaultAccountStateInstructionInitialize:
+			state := TokenAccountState(r.Uint8())
+			return build("InitializeDefaultAccountState", []string{"mint"}, func(k []common.PublicKey) any {
+				return InitializeDefaultAccountStateParam{Mint: k[0], State: state, ProgramID: programID}
+			})
+		case DefaultAccountStateInstructionUpdate:
+			state := TokenAccountState(r.Uint8())
+			return build("UpdateDefaultAccountState", []string{"mint", "freezeAuthority"}, func(k []common.PublicKey) any {
+				return UpdateDefaultAccountStateParam{Mint: k[0], FreezeAuth: k[1], Signers: signers(k, 2), State: state, ProgramID: programID}
+			})
+		default:
+			return unknownSubInstruction(r, i, uint8(sub))
+		}
+	case InstructionReallocate:
+		extensionTypes := readExtensionTypes(r)
+		return build("Reallocate", []string{"account", "payer", "systemProgram", "owner"}, func(k []common.PublicKey) any {
+			return ReallocateParam{Account: k[0], Payer: k[1], Owner: k[3], Signers: signers(k, 4), ExtensionTypes: extensionTypes, ProgramID: programID}
+		})
+	case InstructionMemoTransferExtension:
+		switch sub := MemoTransferInstruction(r.Uint8()); sub {
+		case MemoTransferInstructionEnable:
+			return build("EnableRequiredMemoTransfers", []string{"account", "owner"}, func(k []common.PublicKey) any {
+				return EnableRequiredMemoTransfersParam{Account: k[0], Owner: k[1], Signers: signers(k, 2), ProgramID: programID}
+			})
+		case MemoTransferInstructionDisable:
+			return build("DisableRequiredMemoTransfers", []string{"account", "owner"}, func(k []common.PublicKey) any {
+				return DisableRequiredMemoTransfersParam{Account: k[0], Owner: k[1], Signers: signers(k, 2), ProgramID: programID}
+			})
+		default:
+			return unknownSubInstruction(r, i, uint8(sub))
+		}
+	case InstructionCreateNativeMint:
+		return build("CreateNativeMint", []string{"payer", "nativeMint", "systemProgram"}, func(k []common.PublicKey) any {
+			return CreateNativeMintParam{Payer: k[0], ProgramID: programID}
+		})
+	case InstructionInitializeNonTransferableMint:
+		return build("InitializeNonTransferableMint", []string{"mint"}, func(k []common.PublicKey) any {
+			return InitializeNonTransferableMintParam{Mint: k[0], ProgramID: programID}
+		})
+	case InstructionInterestBearingMintExtension:
+		switch sub := InterestBearingMintInstruction(r.Uint8()); sub {
+		case InterestBearingMintInstructionInitialize:
+			rateAuthority, rate := r.NonZeroPublicKey(), int16(r.Uint16())
+			return build("InitializeInterestBearingMint", []string{"mint"}, func(k []common.PublicKey) any {
+				return InitializeInterestBearingMintParam{Mint: k[0], RateAuthority: rateAuthority, Rate: rate, ProgramID: programID}
+			})
+		case InterestBearingMintInstructionUpdateRate:
+			rate := int16(r.Uint16())
+			return build("UpdateInterestBearingMintRate", []string{"mint", "rateAuthority"}, func(k []common.PublicKey) any {
+				return UpdateInterestBearingMintRateParam{Mint: k[0], RateAuthority: k[1], Signers: signers(k, 2), Rate: rate, ProgramID: programID}
+			})
+		default:
+			return unknownSubInstruction(r, i, uint8(sub))
+		}
+	case InstructionCpiGuardExtension:
+		switch sub := CpiGuardInstruction(r.Uint8()); sub {
+		case CpiGuardInstructionEnable:
+			return build("EnableCpiGuard", []string{"account", "owner"}, func(k []common.PublicKey) any {
+				return EnableCpiGuardParam{Account: k[0], Owner: k[1], Signers: signers(k, 2), ProgramID: programID}
+			})
+		case CpiGuardInstructionDisable:
+			return build("DisableCpiGuard", []string{"account", "owner"}, func(k []common.PublicKey) any {
+				return DisableCpiGuardParam{Account: k[0], Owner: k[1], Signers: signers(k, 2), ProgramID: programID}
+			})
+		default:
+			return unknownSubInstruction(r, i, uint8(sub))
+		}
+	case InstructionInitializePermanentDelegate:
+		delegate := r.PublicKey()
+		return build("InitializePermanentDelegate", []string{"mint"}, func(k []common.PublicKey) any {
+			return InitializePermanentDelegateParam{Mint: k[0], Delegate: delegate, ProgramID: programID}
+		})
+	case InstructionTransferHookExtension:
+		switch sub := TransferHookInstruction(r.Uint8()); sub {
+		case TransferHookInstructionInitialize:
+			authority, address := r.NonZeroPublicKey(), r.NonZeroPublicKey()
+			return build("InitializeTransferHook", []string{"mint"}, func(k []common.PublicKey) any {
+				return InitializeTransferHookParam{Mint: k[0], Authority: authority, TransferHookProgramID: address, ProgramID: programID}
+			})
+		case TransferHookInstructionUpdate:
+			address := r.NonZeroPublicKey()
+			return build("UpdateTransferHook", []string{"mint", "authority"}, func(k []common.PublicKey) any {
+				return UpdateTransferHookParam{Mint: k[0], Authority: k[1], Signers: signers(k, 2), TransferHookProgramID: address, ProgramID: programID}
+			})
+		default:
+			return unknownSubInstruction(r, i, uint8(sub))
+		}
+	case InstructionMetadataPointerExtension:
+		switch sub := MetadataPointerInstruction(r.Uint8()); sub {
+		case MetadataPointerInstructionInitialize:
+			authority, address := r.NonZeroPublicKey(), r.NonZeroPublicKey()
+			return build("InitializeMetadataPointer", []string{"mint"}, func(k []common.PublicKey) any {
+				return InitializeMetadataPointerParam{Mint: k[0], Authority: authority, MetadataAddress: address, ProgramID: programID}
+			})
+		case MetadataPointerInstructionUpdate:
+			address := r.NonZeroPublicKey()
+			return build("UpdateMetadataPointer", []string{"mint", "authority"}, func(k []common.PublicKey) any {
+				return UpdateMetadataPointerParam{Mint: k[0], Authority: k[1], Signers: signers(k, 2), MetadataAddress: address, ProgramID: programID}
+			})
+		default:
+			return unknownSubInstruction(r, i, uint8(sub))
+		}
+	case InstructionGroupPointerExtension:
+		switch sub := GroupPointerInstruction(r.Uint8()); sub {
+		case GroupPointerInstructionInitialize:
+			authority, address := r.NonZeroPublicKey(), r.NonZeroPublicKey()
+			return build("InitializeGroupPointer", []string{"mint"}, func(k []common.PublicKey) any {
+				return InitializeGroupPointerParam{Mint: k[0], Authority: authority, GroupAddress: address, ProgramID: programID}
+			})
+		case GroupPointerInstructionUpdate:
+			address := r.NonZeroPublicKey()
+			return build("UpdateGroupPointer", []string{"mint", "authority"}, func(k []common.PublicKey) any {
+				return UpdateGroupPointerParam{Mint: k[0], Authority: k[1], Signers: signers(k, 2), GroupAddress: address, ProgramID: programID}
+			})
+		default:
+			return unknownSubInstruction(r, i, uint8(sub))
+		}
+	case InstructionGroupMemberPointerExtension:
+		switch sub := GroupMemberPointerInstruction(r.Uint8()); sub {
+		case GroupMemberPointerInstructionInitialize:
+			authority, address := r.NonZeroPublicKey(), r.NonZeroPublicKey()
+			return build("InitializeGroupMemberPointer", []string{"mint"}, func(k []common.PublicKey) any {
+				return InitializeGroupMemberPointerParam{Mint: k[0], Authority: authority, MemberAddress: address, ProgramID: programID}
+			})
+		case GroupMemberPointerInstructionUpdate:
+			address := r.NonZeroPublicKey()
+			return build("UpdateGroupMemberPointer", []string{"mint", "authority"}, func(k []common.PublicKey) any {
+				return UpdateGroupMemberPointerParam{Mint: k[0], Authority: k[1], Signers: signers(k, 2), MemberAddress: address, ProgramID: programID}
+			})
+		default:
+			return unknownSubInstruction(r, i, uint8(sub))
+		}
+	default:
+		if err := r.Err(); err != nil {
+			return instructiondecoder.Instruction{}, err
+		}
+		return instructiondecoder.Instruction{}, fmt.Errorf("%w: %v", instructiondecoder.ErrUnknownInstruction, i)
+	}
+}
+
+func decodeTransferFeeInstruction(r *instructiondecoder.Reader, instruction types.Instruction) (instructiondecoder.Instruction, error) {
+	programID := instruction.ProgramID
+	build := func(name string, names []string, rest string, param func(k []common.PublicKey) any) (instructiondecoder.Instruction, error) {
+		return instructiondecoder.Build(r, instruction.Accounts, name, names, rest, param)
+	}
+
+	switch sub := TransferFeeInstruction(r.Uint8()); sub {
+	case TransferFeeInstructionInitializeTransferFeeConfig:
+		configAuthority, withdrawAuthority := r.OptionalPublicKey(), r.OptionalPublicKey()
+		basisPoints, maximumFee := r.Uint16(), r.Uint64()
+		return build("InitializeTransferFeeConfig", []string{"mint"}, "", func(k []common.PublicKey) any {
+			return InitializeTransferFeeConfigParam{
+				Mint:                       k[0],
+				TransferFeeConfigAuthority: configAuthority,
+				WithdrawWithheldAuthority:  withdrawAuthority,
+				TransferFeeBasisPoints:     basisPoints,
+				MaximumFee:                 maximumFee,
+				ProgramID:                  programID,
+			}
+		})
+	case TransferFeeInstructionTransferCheckedWithFee:
+		amount, decimals, fee := r.Uint64(), r.Uint8(), r.Uint64()
+		return build("TransferCheckedWithFee", []string{"source", "mint", "destination", "authority"}, "signer", func(k []common.PublicKey) any {
+			return TransferCheckedWithFeeParam{From: k[0], To: k[2], Mint: k[1], Auth: k[3], Signers: instructiondecoder.Rest(k, 4), Amount: amount, Decimals: decimals, Fee: fee, ProgramID: programID}
+		})
+	case TransferFeeInstructionWithdrawWithheldTokensFromMint:
+		return build("WithdrawWithheldTokensFromMint", []string{"mint", "destination", "withdrawWithheldAuthority"}, "signer", func(k []common.PublicKey) any {
+			return WithdrawWithheldTokensFromMintParam{Mint: k[0], Destination: k[1], Auth: k[2], Signers: instructiondecoder.Rest(k, 3), ProgramID: programID}
+		})
+	case TransferFeeInstructionWithdrawWithheldTokensFromAccounts:
+		numSources := int(r.Uint8())
+		// the signers come before the sources, the number of sources is in the data
+		numSigners := len(instruction.Accounts) - 3 - numSources
+		if r.Err() == nil && numSigners < 0 {
+			return instructiondecoder.Instruction{}, fmt.Errorf("%w: expected at least %v, got %v", instructiondecoder.ErrNotEnoughAccounts, 3+numSources, len(instruction.Accounts))
+		}
+		names := []string{"mint", "destination", "withdrawWithheldAuthority"}
+		for j := 0; j < numSigners; j++ {
+			names = append(names, "signer")
+		}
+		return build("WithdrawWithheldTokensFromAccounts", names, "source", func(k []common.PublicKey) any {
+			return WithdrawWithheldTokensFromAccountsParam{
+				Mint:        k[0],
+				Destination: k[1],
+				Auth:        k[2],
+				Signers:     instructiondecoder.Rest(k[:3+numSigners], 3),
+				Sources:     instructiondecoder.Rest(k, 3+numSigners),
+				ProgramID:   programID,
+			}
+		})
+	case TransferFeeInstructionHarvestWithheldTokensToMint:
+		return build("HarvestWithheldTokensToMint", []string{"mint"}, "source", func(k []common.PublicKey) any {
+			return HarvestWithheldTokensToMintParam{Mint: k[0], Sources: instructiondecoder.Rest(k, 1), ProgramID: programID}
+		})
+	case TransferFeeInstructionSetTransferFee:
+		basisPoints, maximumFee := r.Uint16(), r.Uint64()
+		return build("SetTransferFee", []string{"mint", "transferFeeConfigAuthority"}, "signer", func(k []common.PublicKey) any {
+			return SetTransferFeeParam{Mint: k[0], Auth: k[1], Signers: instructiondecoder.Rest(k, 2), TransferFeeBasisPoints: basisPoints, MaximumFee: maximumFee, ProgramID: programID}
+		})
+	default:
+		return unknownSubInstruction(r, InstructionTransferFeeExtension, uint8(sub))
+	}
+}
+
+func unknownSubInstruction(r *instructiondecoder.Reader, instruction Instruction, sub uint8) (instructiondecoder.Instruction, error) {
+	if err := r.Err(); err != nil {
+		return instructiondecoder.Instruction{}, err
+	}
+	return instructiondecoder.Instruction{}, fmt.Errorf("%w: %v/%v", instructiondecoder.ErrUnknownInstruction, instruction, sub)
+}
+
+func readExtensionTypes(r *instructiondecoder.Reader) []ExtensionType {
+	var extensionTypes []ExtensionType
+	for r.Err() == nil && r.Len() >= 2 {
+		extensionTypes = append(extensionTypes, ExtensionType(r.Uint16()))
+	}
+	return extensionTypes
+}
@@ -0,0 +1,169 @@
+package token
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/instructiondecoder"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeInstruction(t *testing.T) {
+	a := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	b := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	c := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+	d := common.PublicKeyFromString("S1gner1111111111111111111111111111111111111")
+	e := common.PublicKeyFromString("S1gner1111111111111111111111111111111111112")
+	t22 := common.Token2022ProgramID
+
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		param       any
+	}{
+		{"InitializeMint", InitializeMint(InitializeMintParam{Decimals: 6, Mint: a, MintAuth: b, FreezeAuth: &c}), InitializeMintParam{Decimals: 6, Mint: a, MintAuth: b, FreezeAuth: &c}},
+		{"InitializeMint", InitializeMint(InitializeMintParam{Decimals: 6, Mint: a, MintAuth: b}), InitializeMintParam{Decimals: 6, Mint: a, MintAuth: b}},
+		{"InitializeAccount", InitializeAccount(InitializeAccountParam{Account: a, Mint: b, Owner: c}), InitializeAccountParam{Account: a, Mint: b, Owner: c}},
+		{"InitializeMultisig", InitializeMultisig(InitializeMultisigParam{Account: a, Signers: []common.PublicKey{d, e}, MinRequired: 1}), InitializeMultisigParam{Account: a, Signers: []common.PublicKey{d, e}, MinRequired: 1}},
+		{"Transfer", Transfer(TransferParam{From: a, To: b, Auth: c, Amount: 1}), TransferParam{From: a, To: b, Auth: c, Amount: 1}},
+		{"Transfer", Transfer(TransferParam{From: a, To: b, Auth: c, Signers: []common.PublicKey{d, e}, Amount: 1}), TransferParam{From: a, To: b, Auth: c, Signers: []common.PublicKey{d, e}, Amount: 1}},
+		{"Approve", Approve(ApproveParam{From: a, To: b, Auth: c, Amount: 1}), ApproveParam{From: a, To: b, Auth: c, Amount: 1}},
+		{"Revoke", Revoke(RevokeParam{From: a, Auth: b}), RevokeParam{From: a, Auth: b}},
+		{"SetAuthority", SetAuthority(SetAuthorityParam{Account: a, NewAuth: &b, AuthType: AuthorityTypeCloseAccount, Auth: c}), SetAuthorityParam{Account: a, NewAuth: &b, AuthType: AuthorityTypeCloseAccount, Auth: c}},
+		{"SetAuthority", SetAuthority(SetAuthorityParam{Account: a, AuthType: AuthorityTypeMintTokens, Auth: c, Signers: []common.PublicKey{d}}), SetAuthorityParam{Account: a, AuthType: AuthorityTypeMintTokens, Auth: c, Signers: []common.PublicKey{d}}},
+		{"MintTo", MintTo(MintToParam{Mint: a, To: b, Auth: c, Amount: 1}), MintToParam{Mint: a, To: b, Auth: c, Amount: 1}},
+		{"Burn", Burn(BurnParam{Account: a, Mint: b, Auth: c, Amount: 1}), BurnParam{Account: a, Mint: b, Auth: c, Amount: 1}},
+		{"CloseAccount", CloseAccount(CloseAccountParam{Account: a, Auth: b, To: c}), CloseAccountParam{Account: a, Auth: b, To: c}},
+		{"FreezeAccount", FreezeAccount(FreezeAccountParam{Account: a, Mint: b, Auth: c}), FreezeAccountParam{Account: a, Mint: b, Auth: c}},
+		{"ThawAccount", ThawAccount(ThawAccountParam{Account: a, Mint: b, Auth: c}), ThawAccountParam{Account: a, Mint: b, Auth: c}},
+		{"TransferChecked", TransferChecked(TransferCheckedParam{From: a, To: b, Mint: c, Auth: d, Amount: 1, Decimals: 9, ProgramID: common.TokenProgramID}), TransferCheckedParam{From: a, To: b, Mint: c, Auth: d, Amount: 1, Decimals: 9, ProgramID: common.TokenProgramID}},
+		{"TransferChecked", TransferChecked(TransferCheckedParam{From: a, To: b, Mint: c, Auth: d, Signers: []common.PublicKey{e}, Amount: 1, Decimals: 9, ProgramID: t22}), TransferCheckedParam{From: a, To: b, Mint: c, Auth: d, Signers: []common.PublicKey{e}, Amount: 1, Decimals: 9, ProgramID: t22}},
+		{"ApproveChecked", ApproveChecked(ApproveCheckedParam{From: a, Mint: b, To: c, Auth: d, Amount: 1, Decimals: 9}), ApproveCheckedParam{From: a, Mint: b, To: c, Auth: d, Amount: 1, Decimals: 9}},
+		{"MintToChecked", MintToChecked(MintToCheckedParam{Mint: a, Auth: b, To: c, Amount: 1, Decimals: 9}), MintToCheckedParam{Mint: a, Auth: b, To: c, Amount: 1, Decimals: 9}},
+		{"BurnChecked", BurnChecked(BurnCheckedParam{Account: a, Auth: b, Mint: c, Amount: 1, Decimals: 9}), BurnCheckedParam{Account: a, Auth: b, Mint: c, Amount: 1, Decimals: 9}},
+		{"InitializeAccount2", InitializeAccount2(InitializeAccount2Param{Account: a, Mint: b, Owner: c}), InitializeAccount2Param{Account: a, Mint: b, Owner: c}},
+		{"SyncNative", SyncNative(SyncNativeParam{Account: a}), SyncNativeParam{Account: a}},
+		{"InitializeAccount3", InitializeAccount3(InitializeAccount3Param{Account: a, Mint: b, Owner: c}), InitializeAccount3Param{Account: a, Mint: b, Owner: c}},
+		{"InitializeMultisig2", InitializeMultisig2(InitializeMultisig2Param{Account: a, Signers: []common.PublicKey{d, e}, MinRequired: 2}), InitializeMultisig2Param{Account: a, Signers: []common.PublicKey{d, e}, MinRequired: 2}},
+		{"InitializeMint2", InitializeMint2(InitializeMint2Param{Decimals: 6, Mint: a, MintAuth: b, FreezeAuth: &c}), InitializeMint2Param{Decimals: 6, Mint: a, MintAuth: b, FreezeAuth: &c}},
+		{"GetAccountDataSize", GetAccountDataSize(GetAccountDataSizeParam{Mint: a, ExtensionTypes: []ExtensionType{ExtensionTypeImmutableOwner, ExtensionTypeMemoTransfer}, ProgramID: t22}), GetAccountDataSizeParam{Mint: a, ExtensionTypes: []ExtensionType{ExtensionTypeImmutableOwner, ExtensionTypeMemoTransfer}, ProgramID: t22}},
+		{"InitializeImmutableOwner", InitializeImmutableOwner(InitializeImmutableOwnerParam{Account: a, ProgramID: t22}), InitializeImmutableOwnerParam{Account: a, ProgramID: t22}},
+		{"AmountToUiAmount", AmountToUiAmount(AmountToUiAmountParam{Mint: a, Amount: 1, ProgramID: t22}), AmountToUiAmountParam{Mint: a, Amount: 1, ProgramID: t22}},
+		{"UiAmountToAmount", UiAmountToAmount(UiAmountToAmountParam{Mint: a, UiAmount: "0.1", ProgramID: t22}), UiAmountToAmountParam{Mint: a, UiAmount: "0.1", ProgramID: t22}},
+		{"InitializeMintCloseAuthority", InitializeMintCloseAuthority(InitializeMintCloseAuthorityParam{Mint: a, CloseAuthority: &b, ProgramID: t22}), InitializeMintCloseAuthorityParam{Mint: a, CloseAuthority: &b, ProgramID: t22}},
+		{"InitializeTransferFeeConfig", InitializeTransferFeeConfig(InitializeTransferFeeConfigParam{Mint: a, TransferFeeConfigAuthority: &b, TransferFeeBasisPoints: 50, MaximumFee: 5000, ProgramID: t22}), InitializeTransferFeeConfigParam{Mint: a, TransferFeeConfigAuthority: &b, TransferFeeBasisPoints: 50, MaximumFee: 5000, ProgramID: t22}},
+		{"TransferCheckedWithFee", TransferCheckedWithFee(TransferCheckedWithFeeParam{From: a, To: b, Mint: c, Auth: d, Amount: 100, Decimals: 2, Fee: 1, ProgramID: t22}), TransferCheckedWithFeeParam{From: a, To: b, Mint: c, Auth: d, Amount: 100, Decimals: 2, Fee: 1, ProgramID: t22}},
+		{"WithdrawWithheldTokensFromMint", WithdrawWithheldTokensFromMint(WithdrawWithheldTokensFromMintParam{Mint: a, Destination: b, Auth: c, ProgramID: t22}), WithdrawWithheldTokensFromMintParam{Mint: a, Destination: b, Auth: c, ProgramID: t22}},
+		{"WithdrawWithheldTokensFromAccounts", WithdrawWithheldTokensFromAccounts(WithdrawWithheldTokensFromAccountsParam{Mint: a, Destination: b, Auth: c, Signers: []common.PublicKey{d}, Sources: []common.PublicKey{e, a}, ProgramID: t22}), WithdrawWithheldTokensFromAccountsParam{Mint: a, Destination: b, Auth: c, Signers: []common.PublicKey{d}, Sources: []common.PublicKey{e, a}, ProgramID: t22}},
+		{"HarvestWithheldTokensToMint", HarvestWithheldTokensToMint(HarvestWithheldTokensToMintParam{Mint: a, Sources: []common.PublicKey{b, c}, ProgramID: t22}), HarvestWithheldTokensToMintParam{Mint: a, Sources: []common.PublicKey{b, c}, ProgramID: t22}},
+		{"SetTransferFee", SetTransferFee(SetTransferFeeParam{Mint: a, Auth: b, TransferFeeBasisPoints: 10, MaximumFee: 1, ProgramID: t22}), SetTransferFeeParam{Mint: a, Auth: b, TransferFeeBasisPoints: 10, MaximumFee: 1, ProgramID: t22}},
+		{"InitializeDefaultAccountState", InitializeDefaultAccountState(InitializeDefaultAccountStateParam{Mint: a, State: TokenAccountFrozen, ProgramID: t22}), InitializeDefaultAccountStateParam{Mint: a, State: TokenAccountFrozen, ProgramID: t22}},
+		{"UpdateDefaultAccountState", UpdateDefaultAccountState(UpdateDefaultAccountStateParam{Mint: a, FreezeAuth: b, State: TokenAccountStateInitialized, ProgramID: t22}), UpdateDefaultAccountStateParam{Mint: a, FreezeAuth: b, State: TokenAccountStateInitialized, ProgramID: t22}},
+		{"Reallocate", Reallocate(ReallocateParam{Account: a, Payer: b, Owner: c, ExtensionTypes: []ExtensionType{ExtensionTypeMemoTransfer}, ProgramID: t22}), ReallocateParam{Account: a, Payer: b, Owner: c, ExtensionTypes: []ExtensionType{ExtensionTypeMemoTransfer}, ProgramID: t22}},
+		{"EnableRequiredMemoTransfers", EnableRequiredMemoTransfers(EnableRequiredMemoTransfersParam{Account: a, Owner: b, ProgramID: t22}), EnableRequiredMemoTransfersParam{Account: a, Owner: b, ProgramID: t22}},
+		{"DisableRequiredMemoTransfers", DisableRequiredMemoTransfers(DisableRequiredMemoTransfersParam{Account: a, Owner: b, Signers: []common.PublicKey{d}, ProgramID: t22}), DisableRequiredMemoTransfersParam{Account: a, Owner: b, Signers: []common.PublicKey{d}, ProgramID: t22}},
+		{"CreateNativeMint", CreateNativeMint(CreateNativeMintParam{Payer: a, ProgramID: t22}), CreateNativeMintParam{Payer: a, ProgramID: t22}},
+		{"InitializeNonTransferableMint", InitializeNonTransferableMint(InitializeNonTransferableMintParam{Mint: a, ProgramID: t22}), InitializeNonTransferableMintParam{Mint: a, ProgramID: t22}},
+		{"InitializeInterestBearingMint", InitializeInterestBearingMint(InitializeInterestBearingMintParam{Mint: a, RateAuthority: &b, Rate: -5, ProgramID: t22}), InitializeInterestBearingMintParam{Mint: a, RateAuthority: &b, Rate: -5, ProgramID: t22}},
+		{"UpdateInterestBearingMintRate", UpdateInterestBearingMintRate(UpdateInterestBearingMintRateParam{Mint: a, RateAuthority: b, Rate: 10, ProgramID: t22}), UpdateInterestBearingMintRateParam{Mint: a, RateAuthority: b, Rate: 10, ProgramID: t22}},
+		{"EnableCpiGuard", EnableCpiGuard(EnableCpiGuardParam{Account: a, Owner: b, ProgramID: t22}), EnableCpiGuardParam{Account: a, Owner: b, ProgramID: t22}},
+		{"DisableCpiGuard", DisableCpiGuard(DisableCpiGuardParam{Account: a, Owner: b, ProgramID: t22}), DisableCpiGuardParam{Account: a, Owner: b, ProgramID: t22}},
+		{"InitializePermanentDelegate", InitializePermanentDelegate(InitializePermanentDelegateParam{Mint: a, Delegate: b, ProgramID: t22}), InitializePermanentDelegateParam{Mint: a, Delegate: b, ProgramID: t22}},
+		{"InitializeTransferHook", InitializeTransferHook(InitializeTransferHookParam{Mint: a, TransferHookProgramID: &b, ProgramID: t22}), InitializeTransferHookParam{Mint: a, TransferHookProgramID: &b, ProgramID: t22}},
+		{"UpdateTransferHook", UpdateTransferHook(UpdateTransferHookParam{Mint: a, Authority: b, ProgramID: t22}), UpdateTransferHookParam{Mint: a, Authority: b, ProgramID: t22}},
+		{"InitializeMetadataPointer", InitializeMetadataPointer(InitializeMetadataPointerParam{Mint: a, Authority: &b, MetadataAddress: &a, ProgramID: t22}), InitializeMetadataPointerParam{Mint: a, Authority: &b, MetadataAddress: &a, ProgramID: t22}},
+		{"UpdateMetadataPointer", UpdateMetadataPointer(UpdateMetadataPointerParam{Mint: a, Authority: b, MetadataAddress: &c, ProgramID: t22}), UpdateMetadataPointerParam{Mint: a, Authority: b, MetadataAddress: &c, ProgramID: t22}},
+		{"InitializeGroupPointer", InitializeGroupPointer(InitializeGroupPointerParam{Mint: a, Authority: &b, GroupAddress: &c, ProgramID: t22}), InitializeGroupPointerParam{Mint: a, Authority: &b, GroupAddress: &c, ProgramID: t22}},
+		{"UpdateGroupPointer", UpdateGroupPointer(UpdateGroupPointerParam{Mint: a, Authority: b, Signers: []common.PublicKey{d}, ProgramID: t22}), UpdateGroupPointerParam{Mint: a, Authority: b, Signers: []common.PublicKey{d}, ProgramID: t22}},
+		{"InitializeGroupMemberPointer", InitializeGroupMemberPointer(InitializeGroupMemberPointerParam{Mint: a, MemberAddress: &c, ProgramID: t22}), InitializeGroupMemberPointerParam{Mint: a, MemberAddress: &c, ProgramID: t22}},
+		{"UpdateGroupMemberPointer", UpdateGroupMemberPointer(UpdateGroupMemberPointerParam{Mint: a, Authority: b, MemberAddress: &c, ProgramID: t22}), UpdateGroupMemberPointerParam{Mint: a, Authority: b, MemberAddress: &c, ProgramID: t22}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := instructiondecoder.Decode(tt.instruction)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.instruction.ProgramID, got.ProgramID)
+			assert.Equal(t, tt.name, got.Name)
+			assert.Equal(t, tt.param, got.Param)
+			assert.Len(t, got.Accounts, len(tt.instruction.Accounts))
+			for i, account := range got.Accounts {
+				assert.NotEmpty(t, account.Name)
+				assert.Equal(t, tt.instruction.Accounts[i], account.AccountMeta)
+			}
+		})
+	}
+}
+
+func TestDecodeInstruction_Accounts(t *testing.T) {
+	a := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	b := common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")
+	c := common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")
+	d := common.PublicKeyFromString("S1gner1111111111111111111111111111111111111")
+	e := common.PublicKeyFromString("S1gner1111111111111111111111111111111111112")
+
+	got, err := instructiondecoder.Decode(WithdrawWithheldTokensFromAccounts(WithdrawWithheldTokensFromAccountsParam{
+		Mint:        a,
+		Destination: b,
+		Auth:        c,
+		Signers:     []common.PublicKey{d},
+		Sources:     []common.PublicKey{e},
+		ProgramID:   common.Token2022ProgramID,
+	}))
+	assert.Nil(t, err)
+	assert.Equal(t, "spl-token-2022", got.Program)
+	assert.Equal(t, []instructiondecoder.Account{
+		{Name: "mint", AccountMeta: types.AccountMeta{PubKey: a, IsSigner: false, IsWritable: false}},
+		{Name: "destination", AccountMeta: types.AccountMeta{PubKey: b, IsSigner: false, IsWritable: true}},
+		{Name: "withdrawWithheldAuthority", AccountMeta: types.AccountMeta{PubKey: c, IsSigner: false, IsWritable: false}},
+		{Name: "signer", AccountMeta: types.AccountMeta{PubKey: d, IsSigner: true, IsWritable: false}},
+		{Name: "source", AccountMeta: types.AccountMeta{PubKey: e, IsSigner: false, IsWritable: true}},
+	}, got.Accounts)
+}
+
+func TestDecodeInstruction_Failed(t *testing.T) {
+	transfer := Transfer(TransferParam{Amount: 1})
+	tests := []struct {
+		name        string
+		instruction types.Instruction
+		wantErr     error
+	}{
+		{
+			name:        "unknown instruction",
+			instruction: types.Instruction{ProgramID: common.TokenProgramID, Data: []byte{255}},
+			wantErr:     instructiondecoder.ErrUnknownInstruction,
+		},
+		{
+			name:        "unknown sub instruction",
+			instruction: types.Instruction{ProgramID: common.Token2022ProgramID, Data: []byte{byte(InstructionCpiGuardExtension), 9}},
+			wantErr:     instructiondecoder.ErrUnknownInstruction,
+		},
+		{
+			name:        "confidential transfer",
+			instruction: types.Instruction{ProgramID: common.Token2022ProgramID, Data: []byte{byte(InstructionConfidentialTransferExtension), 0}},
+			wantErr:     instructiondecoder.ErrUnknownInstruction,
+		},
+		{
+			name:        "short data",
+			instruction: types.Instruction{ProgramID: common.TokenProgramID, Accounts: transfer.Accounts, Data: transfer.Data[:5]},
+			wantErr:     instructiondecoder.ErrInvalidInstructionData,
+		},
+		{
+			name:        "not enough accounts",
+			instruction: types.Instruction{ProgramID: common.TokenProgramID, Accounts: transfer.Accounts[:2], Data: transfer.Data},
+			wantErr:     instructiondecoder.ErrNotEnoughAccounts,
+		},
+		{
+			name:        "more sources than accounts",
+			instruction: types.Instruction{ProgramID: common.Token2022ProgramID, Accounts: transfer.Accounts, Data: []byte{byte(InstructionTransferFeeExtension), byte(TransferFeeInstructionWithdrawWithheldTokensFromAccounts), 2}},
+			wantErr:     instructiondecoder.ErrNotEnoughAccounts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := instructiondecoder.Decode(tt.instruction)
+			assert.True(t, errors.Is(err, tt.wantErr), err)
+		})
+	}
+}
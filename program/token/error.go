@@ -1,8 +1,148 @@
 package token
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/programerror"
+)
 
 var (
 	ErrInvalidAccountOwner    = errors.New("invalid account owner")
 	ErrInvalidAccountDataSize = errors.New("invalid account data size")
+	ErrInvalidExtensionData   = errors.New("invalid extension data")
+)
+
+// custom errors of the token program
+var (
+	ErrNotRentExempt                  = errors.New("lamport balance below rent-exempt threshold")
+	ErrInsufficientFunds              = errors.New("insufficient funds")
+	ErrInvalidMint                    = errors.New("invalid mint")
+	ErrMintMismatch                   = errors.New("account not associated with this mint")
+	ErrOwnerMismatch                  = errors.New("owner does not match")
+	ErrFixedSupply                    = errors.New("fixed supply")
+	ErrAlreadyInUse                   = errors.New("already in use")
+	ErrInvalidNumberOfProvidedSigners = errors.New("invalid number of provided signers")
+	ErrInvalidNumberOfRequiredSigners = errors.New("invalid number of required signers")
+	ErrUninitializedState             = errors.New("state is uninitialized")
+	ErrNativeNotSupported             = errors.New("instruction does not support native tokens")
+	ErrNonNativeHasBalance            = errors.New("non-native account can only be closed if its balance is zero")
+	ErrInvalidInstruction             = errors.New("invalid instruction")
+	ErrInvalidState                   = errors.New("state is invalid for requested operation")
+	ErrOverflow                       = errors.New("operation overflowed")
+	ErrAuthorityTypeNotSupported      = errors.New("account does not support specified authority type")
+	ErrMintCannotFreeze               = errors.New("this token mint cannot freeze accounts")
+	ErrAccountFrozen                  = errors.New("account is frozen")
+	ErrMintDecimalsMismatch           = errors.New("the provided decimals value different from the mint decimals")
+	ErrNonNativeNotSupported          = errors.New("instruction does not support non-native tokens")
+)
+
+// custom errors only token-2022 returns
+var (
+	ErrExtensionTypeMismatch                            = errors.New("extension type does not match already existing extensions")
+	ErrExtensionBaseMismatch                            = errors.New("extension does not match the base type provided")
+	ErrExtensionAlreadyInitialized                      = errors.New("extension already initialized on this account")
+	ErrConfidentialTransferAccountHasBalance            = errors.New("an account can only be closed if its confidential balance is zero")
+	ErrConfidentialTransferAccountNotApproved           = errors.New("account not approved for confidential transfers")
+	ErrConfidentialTransferDepositsAndTransfersDisabled = errors.New("account not accepting deposits or transfers")
+	ErrConfidentialTransferElGamalPubkeyMismatch        = errors.New("elgamal public key mismatch")
+	ErrConfidentialTransferBalanceMismatch              = errors.New("balance mismatch")
+	ErrMintHasSupply                                    = errors.New("mint has non-zero supply")
+	ErrNoAuthorityExists                                = errors.New("no authority exists to perform the desired operation")
+	ErrTransferFeeExceedsMaximum                        = errors.New("transfer fee exceeds maximum of 10,000 basis points")
+	ErrMintRequiredForTransfer                          = errors.New("mint required for this account to transfer tokens, use transfer_checked or transfer_checked_with_fee")
+	ErrFeeMismatch                                      = errors.New("calculated fee does not match expected fee")
+	ErrFeeParametersMismatch                            = errors.New("fee parameters associated with zero-knowledge proofs do not match fee parameters in mint")
+	ErrImmutableOwner                                   = errors.New("the owner authority cannot be changed")
+	ErrAccountHasWithheldTransferFees                   = errors.New("an account can only be closed if its withheld fee balance is zero")
+	ErrNoMemo                                           = errors.New("no memo in previous instruction")
+	ErrNonTransferable                                  = errors.New("transfer is disabled for this mint")
+	ErrNonTransferableNeedsImmutableOwnership           = errors.New("non-transferable tokens can't be minted to an account without immutable ownership")
+	ErrMaximumPendingBalanceCreditCounterExceeded       = errors.New("the total number of deposit and transfer instructions has exceeded the maximum")
+	ErrMaximumDepositAmountExceeded                     = errors.New("deposit amount exceeds maximum limit")
+	ErrCpiGuardSettingsLocked                           = errors.New("cpi guard cannot be enabled or disabled in cpi")
+	ErrCpiGuardTransferBlocked                          = errors.New("cpi guard is enabled, and a program attempted to transfer user funds without using a delegate")
+	ErrCpiGuardBurnBlocked                              = errors.New("cpi guard is enabled, and a program attempted to burn user funds without using a delegate")
+	ErrCpiGuardCloseAccountBlocked                      = errors.New("cpi guard is enabled, and a program attempted to close an account without returning lamports to owner")
+	ErrCpiGuardApproveBlocked                           = errors.New("cpi guard is enabled, and a program attempted to approve a delegate")
+	ErrCpiGuardSetAuthorityBlocked                      = errors.New("cpi guard is enabled, and a program attempted to add or replace an authority")
+	ErrCpiGuardOwnerChangeBlocked                       = errors.New("account ownership cannot be changed while cpi guard is enabled")
+	ErrExtensionNotFound                                = errors.New("extension not found in account data")
+	ErrNonConfidentialTransfersDisabled                 = errors.New("non-confidential transfers disabled")
+	ErrConfidentialTransferFeeAccountHasWithheldFee     = errors.New("an account can only be closed if the confidential withheld fee is zero")
+	ErrInvalidExtensionCombination                      = errors.New("a mint or an account is initialized to an invalid combination of extensions")
+	ErrInvalidLengthForAlloc                            = errors.New("extension allocation with overwrite must use the same length")
 )
+
+var tokenErrors = map[uint32]error{
+	0:  ErrNotRentExempt,
+	1:  ErrInsufficientFunds,
+	2:  ErrInvalidMint,
+	3:  ErrMintMismatch,
+	4:  ErrOwnerMismatch,
+	5:  ErrFixedSupply,
+	6:  ErrAlreadyInUse,
+	7:  ErrInvalidNumberOfProvidedSigners,
+	8:  ErrInvalidNumberOfRequiredSigners,
+	9:  ErrUninitializedState,
+	10: ErrNativeNotSupported,
+	11: ErrNonNativeHasBalance,
+	12: ErrInvalidInstruction,
+	13: ErrInvalidState,
+	14: ErrOverflow,
+	15: ErrAuthorityTypeNotSupported,
+	16: ErrMintCannotFreeze,
+	17: ErrAccountFrozen,
+	18: ErrMintDecimalsMismatch,
+	19: ErrNonNativeNotSupported,
+}
+
+var token2022Errors = map[uint32]error{
+	20: ErrExtensionTypeMismatch,
+	21: ErrExtensionBaseMismatch,
+	22: ErrExtensionAlreadyInitialized,
+	23: ErrConfidentialTransferAccountHasBalance,
+	24: ErrConfidentialTransferAccountNotApproved,
+	25: ErrConfidentialTransferDepositsAndTransfersDisabled,
+	26: ErrConfidentialTransferElGamalPubkeyMismatch,
+	27: ErrConfidentialTransferBalanceMismatch,
+	28: ErrMintHasSupply,
+	29: ErrNoAuthorityExists,
+	30: ErrTransferFeeExceedsMaximum,
+	31: ErrMintRequiredForTransfer,
+	32: ErrFeeMismatch,
+	33: ErrFeeParametersMismatch,
+	34: ErrImmutableOwner,
+	35: ErrAccountHasWithheldTransferFees,
+	36: ErrNoMemo,
+	37: ErrNonTransferable,
+	38: ErrNonTransferableNeedsImmutableOwnership,
+	39: ErrMaximumPendingBalanceCreditCounterExceeded,
+	40: ErrMaximumDepositAmountExceeded,
+	41: ErrCpiGuardSettingsLocked,
+	42: ErrCpiGuardTransferBlocked,
+	43: ErrCpiGuardBurnBlocked,
+	44: ErrCpiGuardCloseAccountBlocked,
+	45: ErrCpiGuardApproveBlocked,
+	46: ErrCpiGuardSetAuthorityBlocked,
+	47: ErrCpiGuardOwnerChangeBlocked,
+	48: ErrExtensionNotFound,
+	49: ErrNonConfidentialTransfersDisabled,
+	50: ErrConfidentialTransferFeeAccountHasWithheldFee,
+	51: ErrInvalidExtensionCombination,
+	52: ErrInvalidLengthForAlloc,
+}
+
+func init() {
+	programerror.Register(common.TokenProgramID, tokenErrors)
+
+	// token-2022 keeps the codes of the token program and appends its own
+	errs := make(map[uint32]error, len(tokenErrors)+len(token2022Errors))
+	for code, err := range tokenErrors {
+		errs[code] = err
+	}
+	for code, err := range token2022Errors {
+		errs[code] = err
+	}
+	programerror.Register(common.Token2022ProgramID, errs)
+}
@@ -0,0 +1,16 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/programerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorRegistry(t *testing.T) {
+	assert.Equal(t, ErrInsufficientFunds, programerror.Lookup(common.TokenProgramID, 1))
+	assert.Equal(t, ErrInsufficientFunds, programerror.Lookup(common.Token2022ProgramID, 1))
+	assert.Equal(t, ErrNoMemo, programerror.Lookup(common.Token2022ProgramID, 36))
+	assert.Nil(t, programerror.Lookup(common.TokenProgramID, 36))
+}
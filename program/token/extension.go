@@ -0,0 +1,343 @@
+package token
+
+import (
+	"encoding/binary"
+
+	"github.com/blocto/solana-go-sdk/common"
+)
+
+// Token2022NativeMint is the native mint of token-2022, it is created by CreateNativeMint
+var Token2022NativeMint = common.PublicKeyFromString("9pan9bMn5HatX4EJdBwg9VgCa7Uz5HL8N1m5D3NdXejP")
+
+// ExtensionType is the type of a token-2022 extension
+type ExtensionType uint16
+
+const (
+	ExtensionTypeUninitialized ExtensionType = iota
+	ExtensionTypeTransferFeeConfig
+	ExtensionTypeTransferFeeAmount
+	ExtensionTypeMintCloseAuthority
+	ExtensionTypeConfidentialTransferMint
+	ExtensionTypeConfidentialTransferAccount
+	ExtensionTypeDefaultAccountState
+	ExtensionTypeImmutableOwner
+	ExtensionTypeMemoTransfer
+	ExtensionTypeNonTransferable
+	ExtensionTypeInterestBearingConfig
+	ExtensionTypeCpiGuard
+	ExtensionTypePermanentDelegate
+	ExtensionTypeNonTransferableAccount
+	ExtensionTypeTransferHook
+	ExtensionTypeTransferHookAccount
+	ExtensionTypeConfidentialTransferFeeConfig
+	ExtensionTypeConfidentialTransferFeeAmount
+	ExtensionTypeMetadataPointer
+	ExtensionTypeTokenMetadata
+	ExtensionTypeGroupPointer
+	ExtensionTypeTokenGroup
+	ExtensionTypeGroupMemberPointer
+	ExtensionTypeTokenGroupMember
+)
+
+// AccountType is the byte right after the base account which tells a mint and a token account apart
+// once extensions are appended
+type AccountType uint8
+
+const (
+	AccountTypeUninitialized AccountType = iota
+	AccountTypeMint
+	AccountTypeAccount
+)
+
+// Extension is a decoded token-2022 extension. the extensions the sdk doesn't know are returned as RawExtension.
+type Extension interface {
+	Type() ExtensionType
+}
+
+// FindExtension returns the first extension of type T
+func FindExtension[T Extension](extensions []Extension) (T, bool) {
+	for _, extension := range extensions {
+		if v, ok := extension.(T); ok {
+			return v, true
+		}
+	}
+	var v T
+	return v, false
+}
+
+type RawExtension struct {
+	ExtensionType ExtensionType
+	Data          []byte
+}
+
+func (e RawExtension) Type() ExtensionType { return e.ExtensionType }
+
+type TransferFee struct {
+	Epoch                  uint64
+	MaximumFee             uint64
+	TransferFeeBasisPoints uint16
+}
+
+type TransferFeeConfig struct {
+	TransferFeeConfigAuthority *common.PublicKey
+	WithdrawWithheldAuthority  *common.PublicKey
+	WithheldAmount             uint64
+	OlderTransferFee           TransferFee
+	NewerTransferFee           TransferFee
+}
+
+func (TransferFeeConfig) Type() ExtensionType { return ExtensionTypeTransferFeeConfig }
+
+type TransferFeeAmount struct {
+	WithheldAmount uint64
+}
+
+func (TransferFeeAmount) Type() ExtensionType { return ExtensionTypeTransferFeeAmount }
+
+type MintCloseAuthority struct {
+	CloseAuthority *common.PublicKey
+}
+
+func (MintCloseAuthority) Type() ExtensionType { return ExtensionTypeMintCloseAuthority }
+
+type DefaultAccountState struct {
+	State TokenAccountState
+}
+
+func (DefaultAccountState) Type() ExtensionType { return ExtensionTypeDefaultAccountState }
+
+type ImmutableOwner struct{}
+
+func (ImmutableOwner) Type() ExtensionType { return ExtensionTypeImmutableOwner }
+
+type MemoTransfer struct {
+	RequireIncomingTransferMemos bool
+}
+
+func (MemoTransfer) Type() ExtensionType { return ExtensionTypeMemoTransfer }
+
+// InterestBearingConfig keeps rates in basis points and timestamps in unix seconds
+type InterestBearingConfig struct {
+	RateAuthority           *common.PublicKey
+	InitializationTimestamp int64
+	PreUpdateAverageRate    int16
+	LastUpdateTimestamp     int64
+	CurrentRate             int16
+}
+
+func (InterestBearingConfig) Type() ExtensionType { return ExtensionTypeInterestBearingConfig }
+
+type PermanentDelegate struct {
+	Delegate *common.PublicKey
+}
+
+func (PermanentDelegate) Type() ExtensionType { return ExtensionTypePermanentDelegate }
+
+type TransferHook struct {
+	Authority *common.PublicKey
+	ProgramID *common.PublicKey
+}
+
+func (TransferHook) Type() ExtensionType { return ExtensionTypeTransferHook }
+
+type MetadataPointer struct {
+	Authority       *common.PublicKey
+	MetadataAddress *common.PublicKey
+}
+
+func (MetadataPointer) Type() ExtensionType { return ExtensionTypeMetadataPointer }
+
+// TokenMetadata is the metadata stored in the mint itself
+type TokenMetadata struct {
+	UpdateAuthority    *common.PublicKey
+	Mint               common.PublicKey
+	Name               string
+	Symbol             string
+	Uri                string
+	AdditionalMetadata [][2]string
+}
+
+func (TokenMetadata) Type() ExtensionType { return ExtensionTypeTokenMetadata }
+
+// extensionsFromData decodes the tlv area which follows the account type byte
+func extensionsFromData(data []byte) ([]Extension, error) {
+	var extensions []Extension
+	current := 0
+	for current+4 <= len(data) {
+		extensionType := ExtensionType(binary.LittleEndian.Uint16(data[current : current+2]))
+		// the rest is zero padding
+		if extensionType == ExtensionTypeUninitialized {
+			break
+		}
+		length := int(binary.LittleEndian.Uint16(data[current+2 : current+4]))
+		current += 4
+		if current+length > len(data) {
+			return nil, ErrInvalidExtensionData
+		}
+
+		extension, err := extensionFromData(extensionType, data[current:current+length])
+		if err != nil {
+			return nil, err
+		}
+		extensions = append(extensions, extension)
+		current += length
+	}
+	return extensions, nil
+}
+
+var extensionSizes = map[ExtensionType]int{
+	ExtensionTypeTransferFeeConfig:     108,
+	ExtensionTypeTransferFeeAmount:     8,
+	ExtensionTypeMintCloseAuthority:    32,
+	ExtensionTypeDefaultAccountState:   1,
+	ExtensionTypeImmutableOwner:        0,
+	ExtensionTypeMemoTransfer:          1,
+	ExtensionTypeInterestBearingConfig: 52,
+	ExtensionTypePermanentDelegate:     32,
+	ExtensionTypeTransferHook:          64,
+	ExtensionTypeMetadataPointer:       64,
+}
+
+func extensionFromData(extensionType ExtensionType, data []byte) (Extension, error) {
+	if size, ok := extensionSizes[extensionType]; ok && len(data) != size {
+		return nil, ErrInvalidExtensionData
+	}
+
+	switch extensionType {
+	case ExtensionTypeTransferFeeConfig:
+		return TransferFeeConfig{
+			TransferFeeConfigAuthority: optionalNonZeroPublicKeyFromBytes(data[0:32]),
+			WithdrawWithheldAuthority:  optionalNonZeroPublicKeyFromBytes(data[32:64]),
+			WithheldAmount:             binary.LittleEndian.Uint64(data[64:72]),
+			OlderTransferFee:           transferFeeFromData(data[72:90]),
+			NewerTransferFee:           transferFeeFromData(data[90:108]),
+		}, nil
+	case ExtensionTypeTransferFeeAmount:
+		return TransferFeeAmount{
+			WithheldAmount: binary.LittleEndian.Uint64(data),
+		}, nil
+	case ExtensionTypeMintCloseAuthority:
+		return MintCloseAuthority{
+			CloseAuthority: optionalNonZeroPublicKeyFromBytes(data),
+		}, nil
+	case ExtensionTypeDefaultAccountState:
+		return DefaultAccountState{
+			State: TokenAccountState(data[0]),
+		}, nil
+	case ExtensionTypeImmutableOwner:
+		return ImmutableOwner{}, nil
+	case ExtensionTypeMemoTransfer:
+		return MemoTransfer{
+			RequireIncomingTransferMemos: data[0] == 1,
+		}, nil
+	case ExtensionTypeInterestBearingConfig:
+		return InterestBearingConfig{
+			RateAuthority:           optionalNonZeroPublicKeyFromBytes(data[0:32]),
+			InitializationTimestamp: int64(binary.LittleEndian.Uint64(data[32:40])),
+			PreUpdateAverageRate:    int16(binary.LittleEndian.Uint16(data[40:42])),
+			LastUpdateTimestamp:     int64(binary.LittleEndian.Uint64(data[42:50])),
+			CurrentRate:             int16(binary.LittleEndian.Uint16(data[50:52])),
+		}, nil
+	case ExtensionTypePermanentDelegate:
+		return PermanentDelegate{
+			Delegate: optionalNonZeroPublicKeyFromBytes(data),
+		}, nil
+	case ExtensionTypeTransferHook:
+		return TransferHook{
+			Authority: optionalNonZeroPublicKeyFromBytes(data[0:32]),
+			ProgramID: optionalNonZeroPublicKeyFromBytes(data[32:64]),
+		}, nil
+	case ExtensionTypeMetadataPointer:
+		return MetadataPointer{
+			Authority:       optionalNonZeroPublicKeyFromBytes(data[0:32]),
+			MetadataAddress: optionalNonZeroPublicKeyFromBytes(data[32:64]),
+		}, nil
+	case ExtensionTypeTokenMetadata:
+		return tokenMetadataFromData(data)
+	}
+
+	raw := make([]byte, len(data))
+	copy(raw, data)
+	return RawExtension{
+		ExtensionType: extensionType,
+		Data:          raw,
+	}, nil
+}
+
+func transferFeeFromData(data []byte) TransferFee {
+	return TransferFee{
+		Epoch:                  binary.LittleEndian.Uint64(data[0:8]),
+		MaximumFee:             binary.LittleEndian.Uint64(data[8:16]),
+		TransferFeeBasisPoints: binary.LittleEndian.Uint16(data[16:18]),
+	}
+}
+
+func tokenMetadataFromData(data []byte) (TokenMetadata, error) {
+	if len(data) < 64 {
+		return TokenMetadata{}, ErrInvalidExtensionData
+	}
+	current := 64
+
+	readString := func() (string, error) {
+		if current+4 > len(data) {
+			return "", ErrInvalidExtensionData
+		}
+		length := int(binary.LittleEndian.Uint32(data[current : current+4]))
+		current += 4
+		if length > len(data)-current {
+			return "", ErrInvalidExtensionData
+		}
+		s := string(data[current : current+length])
+		current += length
+		return s, nil
+	}
+
+	var fields [3]string
+	for i := range fields {
+		s, err := readString()
+		if err != nil {
+			return TokenMetadata{}, err
+		}
+		fields[i] = s
+	}
+
+	if current+4 > len(data) {
+		return TokenMetadata{}, ErrInvalidExtensionData
+	}
+	count := int(binary.LittleEndian.Uint32(data[current : current+4]))
+	current += 4
+	// each entry takes at least two length prefixes
+	if count > (len(data)-current)/8 {
+		return TokenMetadata{}, ErrInvalidExtensionData
+	}
+	additionalMetadata := make([][2]string, 0, count)
+	for i := 0; i < count; i++ {
+		key, err := readString()
+		if err != nil {
+			return TokenMetadata{}, err
+		}
+		value, err := readString()
+		if err != nil {
+			return TokenMetadata{}, err
+		}
+		additionalMetadata = append(additionalMetadata, [2]string{key, value})
+	}
+
+	return TokenMetadata{
+		UpdateAuthority:    optionalNonZeroPublicKeyFromBytes(data[0:32]),
+		Mint:               common.PublicKeyFromBytes(data[32:64]),
+		Name:               fields[0],
+		Symbol:             fields[1],
+		Uri:                fields[2],
+		AdditionalMetadata: additionalMetadata,
+	}, nil
+}
+
+// optionalNonZeroPublicKeyFromBytes decodes the zero public key as an absent one
+func optionalNonZeroPublicKeyFromBytes(b []byte) *common.PublicKey {
+	pubkey := common.PublicKeyFromBytes(b)
+	if pubkey == (common.PublicKey{}) {
+		return nil
+	}
+	return &pubkey
+}
@@ -2,6 +2,8 @@ package token
 
 import (
 	"bytes"
+	"encoding/binary"
+
 	"github.com/blocto/solana-go-sdk/common"
 	"github.com/blocto/solana-go-sdk/pkg/bincode"
 	"github.com/blocto/solana-go-sdk/types"
@@ -42,6 +44,16 @@ const (
 	InstructionReallocate
 	InstructionMemoTransferExtension
 	InstructionCreateNativeMint
+	InstructionInitializeNonTransferableMint
+	InstructionInterestBearingMintExtension
+	InstructionCpiGuardExtension
+	InstructionInitializePermanentDelegate
+	InstructionTransferHookExtension
+	InstructionConfidentialTransferFeeExtension
+	InstructionWithdrawExcessLamports
+	InstructionMetadataPointerExtension
+	InstructionGroupPointerExtension
+	InstructionGroupMemberPointerExtension
 )
 
 type InitializeMintParam struct {
@@ -495,10 +507,7 @@ type TransferCheckedParam struct {
 }
 
 func TransferChecked(param TransferCheckedParam) types.Instruction {
-	if !bytes.Equal(param.ProgramID.Bytes(), common.TokenProgramID.Bytes()) &&
-		!bytes.Equal(param.ProgramID.Bytes(), common.Token2022ProgramID.Bytes()) {
-		panic("TransferChecked:TokenProgramID should only TokenProgramID or Token2022ProgramID")
-	}
+	checkProgramID("TransferChecked", param.ProgramID)
 
 	data, err := bincode.SerializeData(struct {
 		Instruction Instruction
@@ -810,3 +819,219 @@ func InitializeMint2(param InitializeMint2Param) types.Instruction {
 		Data: data,
 	}
 }
+
+type GetAccountDataSizeParam struct {
+	Mint           common.PublicKey
+	ExtensionTypes []ExtensionType
+	ProgramID      common.PublicKey
+}
+
+// GetAccountDataSize returns the size of a token account with the given extensions through return data
+func GetAccountDataSize(param GetAccountDataSizeParam) types.Instruction {
+	checkProgramID("GetAccountDataSize", param.ProgramID)
+
+	data := make([]byte, 0, 1+2*len(param.ExtensionTypes))
+	data = append(data, byte(InstructionGetAccountDataSize))
+	for _, extensionType := range param.ExtensionTypes {
+		data = binary.LittleEndian.AppendUint16(data, uint16(extensionType))
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type InitializeImmutableOwnerParam struct {
+	Account   common.PublicKey
+	ProgramID common.PublicKey
+}
+
+// InitializeImmutableOwner makes the owner of a token account immutable, it should be called before InitializeAccount
+func InitializeImmutableOwner(param InitializeImmutableOwnerParam) types.Instruction {
+	checkProgramID("InitializeImmutableOwner", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionInitializeImmutableOwner,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Account, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+type AmountToUiAmountParam struct {
+	Mint      common.PublicKey
+	Amount    uint64
+	ProgramID common.PublicKey
+}
+
+// AmountToUiAmount converts an amount to its ui string through return data
+func AmountToUiAmount(param AmountToUiAmountParam) types.Instruction {
+	checkProgramID("AmountToUiAmount", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+		Amount      uint64
+	}{
+		Instruction: InstructionAmountToUiAmount,
+		Amount:      param.Amount,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type UiAmountToAmountParam struct {
+	Mint      common.PublicKey
+	UiAmount  string
+	ProgramID common.PublicKey
+}
+
+// UiAmountToAmount converts an ui string to its amount through return data
+func UiAmountToAmount(param UiAmountToAmountParam) types.Instruction {
+	checkProgramID("UiAmountToAmount", param.ProgramID)
+
+	// the string is not length prefixed
+	data := make([]byte, 0, 1+len(param.UiAmount))
+	data = append(data, byte(InstructionUiAmountToAmount))
+	data = append(data, []byte(param.UiAmount)...)
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+type InitializeMintCloseAuthorityParam struct {
+	Mint           common.PublicKey
+	CloseAuthority *common.PublicKey
+	ProgramID      common.PublicKey
+}
+
+// InitializeMintCloseAuthority sets the authority which can close the mint, it should be called before InitializeMint
+func InitializeMintCloseAuthority(param InitializeMintCloseAuthorityParam) types.Instruction {
+	checkToken2022ProgramID("InitializeMintCloseAuthority", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction    Instruction
+		CloseAuthority *common.PublicKey
+	}{
+		Instruction:    InstructionInitializeMintCloseAuthority,
+		CloseAuthority: param.CloseAuthority,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+type ReallocateParam struct {
+	Account        common.PublicKey
+	Payer          common.PublicKey
+	Owner          common.PublicKey
+	Signers        []common.PublicKey
+	ExtensionTypes []ExtensionType
+	ProgramID      common.PublicKey
+}
+
+// Reallocate grows a token account so it can hold the given extensions
+func Reallocate(param ReallocateParam) types.Instruction {
+	checkToken2022ProgramID("Reallocate", param.ProgramID)
+
+	data := make([]byte, 0, 1+2*len(param.ExtensionTypes))
+	data = append(data, byte(InstructionReallocate))
+	for _, extensionType := range param.ExtensionTypes {
+		data = binary.LittleEndian.AppendUint16(data, uint16(extensionType))
+	}
+
+	accounts := make([]types.AccountMeta, 0, 4+len(param.Signers))
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.Account, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+		types.AccountMeta{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		types.AccountMeta{PubKey: param.Owner, IsSigner: len(param.Signers) == 0, IsWritable: false},
+	)
+	for _, signerPubkey := range param.Signers {
+		accounts = append(accounts, types.AccountMeta{PubKey: signerPubkey, IsSigner: true, IsWritable: false})
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type CreateNativeMintParam struct {
+	Payer     common.PublicKey
+	ProgramID common.PublicKey
+}
+
+// CreateNativeMint creates the native mint of token-2022
+func CreateNativeMint(param CreateNativeMintParam) types.Instruction {
+	checkToken2022ProgramID("CreateNativeMint", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionCreateNativeMint,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Payer, IsSigner: true, IsWritable: true},
+			{PubKey: Token2022NativeMint, IsSigner: false, IsWritable: true},
+			{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+		},
+		Data: data,
+	}
+}
+
+func checkProgramID(name string, programID common.PublicKey) {
+	if !bytes.Equal(programID.Bytes(), common.TokenProgramID.Bytes()) &&
+		!bytes.Equal(programID.Bytes(), common.Token2022ProgramID.Bytes()) {
+		panic(name + ":TokenProgramID should only TokenProgramID or Token2022ProgramID")
+	}
+}
+
+// checkToken2022ProgramID is for the instructions which only token-2022 has
+func checkToken2022ProgramID(name string, programID common.PublicKey) {
+	if !bytes.Equal(programID.Bytes(), common.Token2022ProgramID.Bytes()) {
+		panic(name + ":TokenProgramID should only Token2022ProgramID")
+	}
+}
@@ -0,0 +1,757 @@
+package token
+
+import (
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/bincode"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+type TransferFeeInstruction uint8
+
+const (
+	TransferFeeInstructionInitializeTransferFeeConfig TransferFeeInstruction = iota
+	TransferFeeInstructionTransferCheckedWithFee
+	TransferFeeInstructionWithdrawWithheldTokensFromMint
+	TransferFeeInstructionWithdrawWithheldTokensFromAccounts
+	TransferFeeInstructionHarvestWithheldTokensToMint
+	TransferFeeInstructionSetTransferFee
+)
+
+type DefaultAccountStateInstruction uint8
+
+const (
+	DefaultAccountStateInstructionInitialize DefaultAccountStateInstruction = iota
+	DefaultAccountStateInstructionUpdate
+)
+
+type MemoTransferInstruction uint8
+
+const (
+	MemoTransferInstructionEnable MemoTransferInstruction = iota
+	MemoTransferInstructionDisable
+)
+
+type InterestBearingMintInstruction uint8
+
+const (
+	InterestBearingMintInstructionInitialize InterestBearingMintInstruction = iota
+	InterestBearingMintInstructionUpdateRate
+)
+
+type CpiGuardInstruction uint8
+
+const (
+	CpiGuardInstructionEnable CpiGuardInstruction = iota
+	CpiGuardInstructionDisable
+)
+
+type TransferHookInstruction uint8
+
+const (
+	TransferHookInstructionInitialize TransferHookInstruction = iota
+	TransferHookInstructionUpdate
+)
+
+type MetadataPointerInstruction uint8
+
+const (
+	MetadataPointerInstructionInitialize MetadataPointerInstruction = iota
+	MetadataPointerInstructionUpdate
+)
+
+type GroupPointerInstruction uint8
+
+const (
+	GroupPointerInstructionInitialize GroupPointerInstruction = iota
+	GroupPointerInstructionUpdate
+)
+
+type GroupMemberPointerInstruction uint8
+
+const (
+	GroupMemberPointerInstructionInitialize GroupMemberPointerInstruction = iota
+	GroupMemberPointerInstructionUpdate
+)
+
+type InitializeTransferFeeConfigParam struct {
+	Mint                       common.PublicKey
+	TransferFeeConfigAuthority *common.PublicKey
+	WithdrawWithheldAuthority  *common.PublicKey
+	TransferFeeBasisPoints     uint16
+	MaximumFee                 uint64
+	ProgramID                  common.PublicKey
+}
+
+// InitializeTransferFeeConfig initializes the transfer fee of a mint, it should be called before InitializeMint
+func InitializeTransferFeeConfig(param InitializeTransferFeeConfigParam) types.Instruction {
+	checkToken2022ProgramID("InitializeTransferFeeConfig", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction                Instruction
+		TransferFeeInstruction     TransferFeeInstruction
+		TransferFeeConfigAuthority *common.PublicKey
+		WithdrawWithheldAuthority  *common.PublicKey
+		TransferFeeBasisPoints     uint16
+		MaximumFee                 uint64
+	}{
+		Instruction:                InstructionTransferFeeExtension,
+		TransferFeeInstruction:     TransferFeeInstructionInitializeTransferFeeConfig,
+		TransferFeeConfigAuthority: param.TransferFeeConfigAuthority,
+		WithdrawWithheldAuthority:  param.WithdrawWithheldAuthority,
+		TransferFeeBasisPoints:     param.TransferFeeBasisPoints,
+		MaximumFee:                 param.MaximumFee,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+type TransferCheckedWithFeeParam struct {
+	From      common.PublicKey
+	To        common.PublicKey
+	Mint      common.PublicKey
+	Auth      common.PublicKey
+	Signers   []common.PublicKey
+	Amount    uint64
+	Decimals  uint8
+	Fee       uint64
+	ProgramID common.PublicKey
+}
+
+// TransferCheckedWithFee transfers tokens and asserts the fee matches the one the mint calculates
+func TransferCheckedWithFee(param TransferCheckedWithFeeParam) types.Instruction {
+	checkToken2022ProgramID("TransferCheckedWithFee", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction            Instruction
+		TransferFeeInstruction TransferFeeInstruction
+		Amount                 uint64
+		Decimals               uint8
+		Fee                    uint64
+	}{
+		Instruction:            InstructionTransferFeeExtension,
+		TransferFeeInstruction: TransferFeeInstructionTransferCheckedWithFee,
+		Amount:                 param.Amount,
+		Decimals:               param.Decimals,
+		Fee:                    param.Fee,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 4+len(param.Signers))
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.From, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+		types.AccountMeta{PubKey: param.To, IsSigner: false, IsWritable: true},
+	)
+	accounts = appendAuthority(accounts, param.Auth, param.Signers)
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type WithdrawWithheldTokensFromMintParam struct {
+	Mint        common.PublicKey
+	Destination common.PublicKey
+	Auth        common.PublicKey
+	Signers     []common.PublicKey
+	ProgramID   common.PublicKey
+}
+
+// WithdrawWithheldTokensFromMint withdraws the fee withheld in the mint
+func WithdrawWithheldTokensFromMint(param WithdrawWithheldTokensFromMintParam) types.Instruction {
+	checkToken2022ProgramID("WithdrawWithheldTokensFromMint", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction            Instruction
+		TransferFeeInstruction TransferFeeInstruction
+	}{
+		Instruction:            InstructionTransferFeeExtension,
+		TransferFeeInstruction: TransferFeeInstructionWithdrawWithheldTokensFromMint,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 3+len(param.Signers))
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+		types.AccountMeta{PubKey: param.Destination, IsSigner: false, IsWritable: true},
+	)
+	accounts = appendAuthority(accounts, param.Auth, param.Signers)
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type WithdrawWithheldTokensFromAccountsParam struct {
+	Mint        common.PublicKey
+	Destination common.PublicKey
+	Auth        common.PublicKey
+	Signers     []common.PublicKey
+	Sources     []common.PublicKey
+	ProgramID   common.PublicKey
+}
+
+// WithdrawWithheldTokensFromAccounts withdraws the fee withheld in the source token accounts
+func WithdrawWithheldTokensFromAccounts(param WithdrawWithheldTokensFromAccountsParam) types.Instruction {
+	checkToken2022ProgramID("WithdrawWithheldTokensFromAccounts", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction            Instruction
+		TransferFeeInstruction TransferFeeInstruction
+		NumTokenAccounts       uint8
+	}{
+		Instruction:            InstructionTransferFeeExtension,
+		TransferFeeInstruction: TransferFeeInstructionWithdrawWithheldTokensFromAccounts,
+		NumTokenAccounts:       uint8(len(param.Sources)),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 3+len(param.Signers)+len(param.Sources))
+	accounts = append(accounts,
+		types.AccountMeta{PubKey: param.Mint, IsSigner: false, IsWritable: false},
+		types.AccountMeta{PubKey: param.Destination, IsSigner: false, IsWritable: true},
+	)
+	accounts = appendAuthority(accounts, param.Auth, param.Signers)
+	for _, source := range param.Sources {
+		accounts = append(accounts, types.AccountMeta{PubKey: source, IsSigner: false, IsWritable: true})
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type HarvestWithheldTokensToMintParam struct {
+	Mint      common.PublicKey
+	Sources   []common.PublicKey
+	ProgramID common.PublicKey
+}
+
+// HarvestWithheldTokensToMint moves the fee withheld in the source token accounts to the mint, it is permissionless
+func HarvestWithheldTokensToMint(param HarvestWithheldTokensToMintParam) types.Instruction {
+	checkToken2022ProgramID("HarvestWithheldTokensToMint", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction            Instruction
+		TransferFeeInstruction TransferFeeInstruction
+	}{
+		Instruction:            InstructionTransferFeeExtension,
+		TransferFeeInstruction: TransferFeeInstructionHarvestWithheldTokensToMint,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 1+len(param.Sources))
+	accounts = append(accounts, types.AccountMeta{PubKey: param.Mint, IsSigner: false, IsWritable: true})
+	for _, source := range param.Sources {
+		accounts = append(accounts, types.AccountMeta{PubKey: source, IsSigner: false, IsWritable: true})
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type SetTransferFeeParam struct {
+	Mint                   common.PublicKey
+	Auth                   common.PublicKey
+	Signers                []common.PublicKey
+	TransferFeeBasisPoints uint16
+	MaximumFee             uint64
+	ProgramID              common.PublicKey
+}
+
+// SetTransferFee sets the transfer fee, it takes effect after two epochs
+func SetTransferFee(param SetTransferFeeParam) types.Instruction {
+	checkToken2022ProgramID("SetTransferFee", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction            Instruction
+		TransferFeeInstruction TransferFeeInstruction
+		TransferFeeBasisPoints uint16
+		MaximumFee             uint64
+	}{
+		Instruction:            InstructionTransferFeeExtension,
+		TransferFeeInstruction: TransferFeeInstructionSetTransferFee,
+		TransferFeeBasisPoints: param.TransferFeeBasisPoints,
+		MaximumFee:             param.MaximumFee,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 2+len(param.Signers))
+	accounts = append(accounts, types.AccountMeta{PubKey: param.Mint, IsSigner: false, IsWritable: true})
+	accounts = appendAuthority(accounts, param.Auth, param.Signers)
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type InitializeDefaultAccountStateParam struct {
+	Mint      common.PublicKey
+	State     TokenAccountState
+	ProgramID common.PublicKey
+}
+
+// InitializeDefaultAccountState sets the state new token accounts start with, it should be called before InitializeMint
+func InitializeDefaultAccountState(param InitializeDefaultAccountStateParam) types.Instruction {
+	checkToken2022ProgramID("InitializeDefaultAccountState", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction                    Instruction
+		DefaultAccountStateInstruction DefaultAccountStateInstruction
+		State                          TokenAccountState
+	}{
+		Instruction:                    InstructionDefaultAccountStateExtension,
+		DefaultAccountStateInstruction: DefaultAccountStateInstructionInitialize,
+		State:                          param.State,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+type UpdateDefaultAccountStateParam struct {
+	Mint       common.PublicKey
+	FreezeAuth common.PublicKey
+	Signers    []common.PublicKey
+	State      TokenAccountState
+	ProgramID  common.PublicKey
+}
+
+// UpdateDefaultAccountState updates the state new token accounts start with, it is signed by the freeze authority
+func UpdateDefaultAccountState(param UpdateDefaultAccountStateParam) types.Instruction {
+	checkToken2022ProgramID("UpdateDefaultAccountState", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction                    Instruction
+		DefaultAccountStateInstruction DefaultAccountStateInstruction
+		State                          TokenAccountState
+	}{
+		Instruction:                    InstructionDefaultAccountStateExtension,
+		DefaultAccountStateInstruction: DefaultAccountStateInstructionUpdate,
+		State:                          param.State,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 2+len(param.Signers))
+	accounts = append(accounts, types.AccountMeta{PubKey: param.Mint, IsSigner: false, IsWritable: true})
+	accounts = appendAuthority(accounts, param.FreezeAuth, param.Signers)
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type EnableRequiredMemoTransfersParam struct {
+	Account   common.PublicKey
+	Owner     common.PublicKey
+	Signers   []common.PublicKey
+	ProgramID common.PublicKey
+}
+
+// EnableRequiredMemoTransfers requires incoming transfers of the token account to have a memo
+func EnableRequiredMemoTransfers(param EnableRequiredMemoTransfersParam) types.Instruction {
+	checkToken2022ProgramID("EnableRequiredMemoTransfers", param.ProgramID)
+	return accountToggleInstruction(param.ProgramID, InstructionMemoTransferExtension, uint8(MemoTransferInstructionEnable), param.Account, param.Owner, param.Signers)
+}
+
+type DisableRequiredMemoTransfersParam struct {
+	Account   common.PublicKey
+	Owner     common.PublicKey
+	Signers   []common.PublicKey
+	ProgramID common.PublicKey
+}
+
+// DisableRequiredMemoTransfers stops requiring incoming transfers of the token account to have a memo
+func DisableRequiredMemoTransfers(param DisableRequiredMemoTransfersParam) types.Instruction {
+	checkToken2022ProgramID("DisableRequiredMemoTransfers", param.ProgramID)
+	return accountToggleInstruction(param.ProgramID, InstructionMemoTransferExtension, uint8(MemoTransferInstructionDisable), param.Account, param.Owner, param.Signers)
+}
+
+type InitializeInterestBearingMintParam struct {
+	Mint          common.PublicKey
+	RateAuthority *common.PublicKey
+	Rate          int16
+	ProgramID     common.PublicKey
+}
+
+// InitializeInterestBearingMint initializes the interest rate (in basis points) of a mint, it should be called before InitializeMint
+func InitializeInterestBearingMint(param InitializeInterestBearingMintParam) types.Instruction {
+	checkToken2022ProgramID("InitializeInterestBearingMint", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction                    Instruction
+		InterestBearingMintInstruction InterestBearingMintInstruction
+		RateAuthority                  common.PublicKey
+		Rate                           int16
+	}{
+		Instruction:                    InstructionInterestBearingMintExtension,
+		InterestBearingMintInstruction: InterestBearingMintInstructionInitialize,
+		RateAuthority:                  optionalNonZeroPublicKey(param.RateAuthority),
+		Rate:                           param.Rate,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+type UpdateInterestBearingMintRateParam struct {
+	Mint          common.PublicKey
+	RateAuthority common.PublicKey
+	Signers       []common.PublicKey
+	Rate          int16
+	ProgramID     common.PublicKey
+}
+
+// UpdateInterestBearingMintRate updates the interest rate (in basis points) of a mint
+func UpdateInterestBearingMintRate(param UpdateInterestBearingMintRateParam) types.Instruction {
+	checkToken2022ProgramID("UpdateInterestBearingMintRate", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction                    Instruction
+		InterestBearingMintInstruction InterestBearingMintInstruction
+		Rate                           int16
+	}{
+		Instruction:                    InstructionInterestBearingMintExtension,
+		InterestBearingMintInstruction: InterestBearingMintInstructionUpdateRate,
+		Rate:                           param.Rate,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 2+len(param.Signers))
+	accounts = append(accounts, types.AccountMeta{PubKey: param.Mint, IsSigner: false, IsWritable: true})
+	accounts = appendAuthority(accounts, param.RateAuthority, param.Signers)
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+type EnableCpiGuardParam struct {
+	Account   common.PublicKey
+	Owner     common.PublicKey
+	Signers   []common.PublicKey
+	ProgramID common.PublicKey
+}
+
+// EnableCpiGuard restricts the privileged actions a program can do to the token account through cpi
+func EnableCpiGuard(param EnableCpiGuardParam) types.Instruction {
+	checkToken2022ProgramID("EnableCpiGuard", param.ProgramID)
+	return accountToggleInstruction(param.ProgramID, InstructionCpiGuardExtension, uint8(CpiGuardInstructionEnable), param.Account, param.Owner, param.Signers)
+}
+
+type DisableCpiGuardParam struct {
+	Account   common.PublicKey
+	Owner     common.PublicKey
+	Signers   []common.PublicKey
+	ProgramID common.PublicKey
+}
+
+// DisableCpiGuard lifts the cpi restrictions of the token account
+func DisableCpiGuard(param DisableCpiGuardParam) types.Instruction {
+	checkToken2022ProgramID("DisableCpiGuard", param.ProgramID)
+	return accountToggleInstruction(param.ProgramID, InstructionCpiGuardExtension, uint8(CpiGuardInstructionDisable), param.Account, param.Owner, param.Signers)
+}
+
+type InitializePermanentDelegateParam struct {
+	Mint      common.PublicKey
+	Delegate  common.PublicKey
+	ProgramID common.PublicKey
+}
+
+// InitializePermanentDelegate sets a delegate which can transfer or burn tokens from any account of the mint,
+// it should be called before InitializeMint
+func InitializePermanentDelegate(param InitializePermanentDelegateParam) types.Instruction {
+	checkToken2022ProgramID("InitializePermanentDelegate", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+		Delegate    common.PublicKey
+	}{
+		Instruction: InstructionInitializePermanentDelegate,
+		Delegate:    param.Delegate,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+type InitializeTransferHookParam struct {
+	Mint                  common.PublicKey
+	Authority             *common.PublicKey
+	TransferHookProgramID *common.PublicKey
+	ProgramID             common.PublicKey
+}
+
+// InitializeTransferHook sets the program invoked on every transfer, it should be called before InitializeMint
+func InitializeTransferHook(param InitializeTransferHookParam) types.Instruction {
+	checkToken2022ProgramID("InitializeTransferHook", param.ProgramID)
+	return initializePointerInstruction(param.ProgramID, InstructionTransferHookExtension, uint8(TransferHookInstructionInitialize), param.Mint, param.Authority, param.TransferHookProgramID)
+}
+
+type UpdateTransferHookParam struct {
+	Mint                  common.PublicKey
+	Authority             common.PublicKey
+	Signers               []common.PublicKey
+	TransferHookProgramID *common.PublicKey
+	ProgramID             common.PublicKey
+}
+
+// UpdateTransferHook updates the program invoked on every transfer
+func UpdateTransferHook(param UpdateTransferHookParam) types.Instruction {
+	checkToken2022ProgramID("UpdateTransferHook", param.ProgramID)
+	return updatePointerInstruction(param.ProgramID, InstructionTransferHookExtension, uint8(TransferHookInstructionUpdate), param.Mint, param.Authority, param.Signers, param.TransferHookProgramID)
+}
+
+type InitializeMetadataPointerParam struct {
+	Mint            common.PublicKey
+	Authority       *common.PublicKey
+	MetadataAddress *common.PublicKey
+	ProgramID       common.PublicKey
+}
+
+// InitializeMetadataPointer sets the account which holds the metadata of a mint, it should be called before InitializeMint
+func InitializeMetadataPointer(param InitializeMetadataPointerParam) types.Instruction {
+	checkToken2022ProgramID("InitializeMetadataPointer", param.ProgramID)
+	return initializePointerInstruction(param.ProgramID, InstructionMetadataPointerExtension, uint8(MetadataPointerInstructionInitialize), param.Mint, param.Authority, param.MetadataAddress)
+}
+
+type UpdateMetadataPointerParam struct {
+	Mint            common.PublicKey
+	Authority       common.PublicKey
+	Signers         []common.PublicKey
+	MetadataAddress *common.PublicKey
+	ProgramID       common.PublicKey
+}
+
+// UpdateMetadataPointer updates the account which holds the metadata of a mint
+func UpdateMetadataPointer(param UpdateMetadataPointerParam) types.Instruction {
+	checkToken2022ProgramID("UpdateMetadataPointer", param.ProgramID)
+	return updatePointerInstruction(param.ProgramID, InstructionMetadataPointerExtension, uint8(MetadataPointerInstructionUpdate), param.Mint, param.Authority, param.Signers, param.MetadataAddress)
+}
+
+type InitializeGroupPointerParam struct {
+	Mint         common.PublicKey
+	Authority    *common.PublicKey
+	GroupAddress *common.PublicKey
+	ProgramID    common.PublicKey
+}
+
+// InitializeGroupPointer sets the account which holds the group configuration of a mint, it should be called before InitializeMint
+func InitializeGroupPointer(param InitializeGroupPointerParam) types.Instruction {
+	checkToken2022ProgramID("InitializeGroupPointer", param.ProgramID)
+	return initializePointerInstruction(param.ProgramID, InstructionGroupPointerExtension, uint8(GroupPointerInstructionInitialize), param.Mint, param.Authority, param.GroupAddress)
+}
+
+type UpdateGroupPointerParam struct {
+	Mint         common.PublicKey
+	Authority    common.PublicKey
+	Signers      []common.PublicKey
+	GroupAddress *common.PublicKey
+	ProgramID    common.PublicKey
+}
+
+// UpdateGroupPointer updates the account which holds the group configuration of a mint
+func UpdateGroupPointer(param UpdateGroupPointerParam) types.Instruction {
+	checkToken2022ProgramID("UpdateGroupPointer", param.ProgramID)
+	return updatePointerInstruction(param.ProgramID, InstructionGroupPointerExtension, uint8(GroupPointerInstructionUpdate), param.Mint, param.Authority, param.Signers, param.GroupAddress)
+}
+
+type InitializeGroupMemberPointerParam struct {
+	Mint          common.PublicKey
+	Authority     *common.PublicKey
+	MemberAddress *common.PublicKey
+	ProgramID     common.PublicKey
+}
+
+// InitializeGroupMemberPointer sets the account which holds the group member configuration of a mint,
+// it should be called before InitializeMint
+func InitializeGroupMemberPointer(param InitializeGroupMemberPointerParam) types.Instruction {
+	checkToken2022ProgramID("InitializeGroupMemberPointer", param.ProgramID)
+	return initializePointerInstruction(param.ProgramID, InstructionGroupMemberPointerExtension, uint8(GroupMemberPointerInstructionInitialize), param.Mint, param.Authority, param.MemberAddress)
+}
+
+type UpdateGroupMemberPointerParam struct {
+	Mint          common.PublicKey
+	Authority     common.PublicKey
+	Signers       []common.PublicKey
+	MemberAddress *common.PublicKey
+	ProgramID     common.PublicKey
+}
+
+// UpdateGroupMemberPointer updates the account which holds the group member configuration of a mint
+func UpdateGroupMemberPointer(param UpdateGroupMemberPointerParam) types.Instruction {
+	checkToken2022ProgramID("UpdateGroupMemberPointer", param.ProgramID)
+	return updatePointerInstruction(param.ProgramID, InstructionGroupMemberPointerExtension, uint8(GroupMemberPointerInstructionUpdate), param.Mint, param.Authority, param.Signers, param.MemberAddress)
+}
+
+type InitializeNonTransferableMintParam struct {
+	Mint      common.PublicKey
+	ProgramID common.PublicKey
+}
+
+// InitializeNonTransferableMint makes the tokens of a mint non-transferable, it should be called before InitializeMint
+func InitializeNonTransferableMint(param InitializeNonTransferableMintParam) types.Instruction {
+	checkToken2022ProgramID("InitializeNonTransferableMint", param.ProgramID)
+
+	data, err := bincode.SerializeData(struct {
+		Instruction Instruction
+	}{
+		Instruction: InstructionInitializeNonTransferableMint,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: param.ProgramID,
+		Accounts: []types.AccountMeta{
+			{PubKey: param.Mint, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+// accountToggleInstruction builds the enable/disable instructions of the account extensions (memo transfer, cpi guard)
+func accountToggleInstruction(programID common.PublicKey, instruction Instruction, subInstruction uint8, account, owner common.PublicKey, signers []common.PublicKey) types.Instruction {
+	accounts := make([]types.AccountMeta, 0, 2+len(signers))
+	accounts = append(accounts, types.AccountMeta{PubKey: account, IsSigner: false, IsWritable: true})
+	accounts = appendAuthority(accounts, owner, signers)
+
+	return types.Instruction{
+		ProgramID: programID,
+		Accounts:  accounts,
+		Data:      []byte{byte(instruction), subInstruction},
+	}
+}
+
+// initializePointerInstruction builds the initialize instructions of the extensions which store an authority
+// and an address (transfer hook, metadata pointer, group pointer, group member pointer)
+func initializePointerInstruction(programID common.PublicKey, instruction Instruction, subInstruction uint8, mint common.PublicKey, authority, address *common.PublicKey) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction    Instruction
+		SubInstruction uint8
+		Authority      common.PublicKey
+		Address        common.PublicKey
+	}{
+		Instruction:    instruction,
+		SubInstruction: subInstruction,
+		Authority:      optionalNonZeroPublicKey(authority),
+		Address:        optionalNonZeroPublicKey(address),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.Instruction{
+		ProgramID: programID,
+		Accounts: []types.AccountMeta{
+			{PubKey: mint, IsSigner: false, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+// updatePointerInstruction builds the update instructions of the extensions which store an authority and an address
+func updatePointerInstruction(programID common.PublicKey, instruction Instruction, subInstruction uint8, mint, authority common.PublicKey, signers []common.PublicKey, address *common.PublicKey) types.Instruction {
+	data, err := bincode.SerializeData(struct {
+		Instruction    Instruction
+		SubInstruction uint8
+		Address        common.PublicKey
+	}{
+		Instruction:    instruction,
+		SubInstruction: subInstruction,
+		Address:        optionalNonZeroPublicKey(address),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	accounts := make([]types.AccountMeta, 0, 2+len(signers))
+	accounts = append(accounts, types.AccountMeta{PubKey: mint, IsSigner: false, IsWritable: true})
+	accounts = appendAuthority(accounts, authority, signers)
+
+	return types.Instruction{
+		ProgramID: programID,
+		Accounts:  accounts,
+		Data:      data,
+	}
+}
+
+// appendAuthority appends the authority and its multisig signers. the authority only signs when it is not a multisig.
+func appendAuthority(accounts []types.AccountMeta, auth common.PublicKey, signers []common.PublicKey) []types.AccountMeta {
+	accounts = append(accounts, types.AccountMeta{PubKey: auth, IsSigner: len(signers) == 0, IsWritable: false})
+	for _, signerPubkey := range signers {
+		accounts = append(accounts, types.AccountMeta{PubKey: signerPubkey, IsSigner: true, IsWritable: false})
+	}
+	return accounts
+}
+
+// optionalNonZeroPublicKey encodes an absent public key as the zero public key
+func optionalNonZeroPublicKey(pubkey *common.PublicKey) common.PublicKey {
+	if pubkey == nil {
+		return common.PublicKey{}
+	}
+	return *pubkey
+}
@@ -0,0 +1,1022 @@
+package token
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/pointer"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitializeTransferFeeConfig(t *testing.T) {
+	type args struct {
+		param InitializeTransferFeeConfigParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeTransferFeeConfigParam{
+					Mint:                       common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					TransferFeeConfigAuthority: pointer.Get[common.PublicKey](common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")),
+					WithdrawWithheldAuthority:  pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					TransferFeeBasisPoints:     100,
+					MaximumFee:                 5000,
+					ProgramID:                  common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{26, 0, 1, 159, 186, 247, 199, 172, 215, 195, 31, 127, 42, 207, 18, 192, 64, 156, 59, 98, 1, 180, 8, 69, 70, 199, 127, 220, 159, 6, 40, 64, 117, 246, 19, 1, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240, 100, 0, 136, 19, 0, 0, 0, 0, 0, 0},
+			},
+		},
+		{
+			args: args{
+				param: InitializeTransferFeeConfigParam{
+					Mint:                   common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					TransferFeeBasisPoints: 100,
+					MaximumFee:             5000,
+					ProgramID:              common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{26, 0, 0, 0, 100, 0, 136, 19, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeTransferFeeConfig(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeTransferFeeConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransferCheckedWithFee(t *testing.T) {
+	type args struct {
+		param TransferCheckedWithFeeParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: TransferCheckedWithFeeParam{
+					From:      common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					To:        common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Auth:      common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Amount:    99999,
+					Decimals:  4,
+					Fee:       10,
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{26, 1, 159, 134, 1, 0, 0, 0, 0, 0, 4, 10, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+		{
+			args: args{
+				param: TransferCheckedWithFeeParam{
+					From: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					To:   common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Mint: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Auth: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Signers: []common.PublicKey{
+						common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"),
+						common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"),
+					},
+					Amount:    99999,
+					Decimals:  4,
+					Fee:       10,
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{26, 1, 159, 134, 1, 0, 0, 0, 0, 0, 4, 10, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TransferCheckedWithFee(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TransferCheckedWithFee() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithdrawWithheldTokensFromMint(t *testing.T) {
+	type args struct {
+		param WithdrawWithheldTokensFromMintParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: WithdrawWithheldTokensFromMintParam{
+					Mint:        common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Destination: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Auth:        common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					ProgramID:   common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{26, 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithdrawWithheldTokensFromMint(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WithdrawWithheldTokensFromMint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithdrawWithheldTokensFromAccounts(t *testing.T) {
+	type args struct {
+		param WithdrawWithheldTokensFromAccountsParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: WithdrawWithheldTokensFromAccountsParam{
+					Mint:        common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Destination: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Auth:        common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Signers: []common.PublicKey{
+						common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"),
+						common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"),
+					},
+					Sources:   []common.PublicKey{common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")},
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{26, 3, 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithdrawWithheldTokensFromAccounts(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WithdrawWithheldTokensFromAccounts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHarvestWithheldTokensToMint(t *testing.T) {
+	type args struct {
+		param HarvestWithheldTokensToMintParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: HarvestWithheldTokensToMintParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Sources:   []common.PublicKey{common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")},
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{26, 4},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HarvestWithheldTokensToMint(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HarvestWithheldTokensToMint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTransferFee(t *testing.T) {
+	type args struct {
+		param SetTransferFeeParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: SetTransferFeeParam{
+					Mint:                   common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Auth:                   common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					TransferFeeBasisPoints: 100,
+					MaximumFee:             5000,
+					ProgramID:              common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{26, 5, 100, 0, 136, 19, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetTransferFee(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SetTransferFee() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeDefaultAccountState(t *testing.T) {
+	type args struct {
+		param InitializeDefaultAccountStateParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeDefaultAccountStateParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					State:     TokenAccountFrozen,
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{28, 0, 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeDefaultAccountState(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeDefaultAccountState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateDefaultAccountState(t *testing.T) {
+	type args struct {
+		param UpdateDefaultAccountStateParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateDefaultAccountStateParam{
+					Mint:       common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					FreezeAuth: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					State:      TokenAccountStateInitialized,
+					ProgramID:  common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{28, 1, 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateDefaultAccountState(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateDefaultAccountState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnableRequiredMemoTransfers(t *testing.T) {
+	type args struct {
+		param EnableRequiredMemoTransfersParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: EnableRequiredMemoTransfersParam{
+					Account:   common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Owner:     common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{30, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EnableRequiredMemoTransfers(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnableRequiredMemoTransfers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisableRequiredMemoTransfers(t *testing.T) {
+	type args struct {
+		param DisableRequiredMemoTransfersParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: DisableRequiredMemoTransfersParam{
+					Account: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Owner:   common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Signers: []common.PublicKey{
+						common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"),
+						common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"),
+					},
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{30, 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DisableRequiredMemoTransfers(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DisableRequiredMemoTransfers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeInterestBearingMint(t *testing.T) {
+	type args struct {
+		param InitializeInterestBearingMintParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeInterestBearingMintParam{
+					Mint:          common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					RateAuthority: pointer.Get[common.PublicKey](common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")),
+					Rate:          -5,
+					ProgramID:     common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{33, 0, 159, 186, 247, 199, 172, 215, 195, 31, 127, 42, 207, 18, 192, 64, 156, 59, 98, 1, 180, 8, 69, 70, 199, 127, 220, 159, 6, 40, 64, 117, 246, 19, 251, 255},
+			},
+		},
+		{
+			args: args{
+				param: InitializeInterestBearingMintParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Rate:      5,
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{33, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeInterestBearingMint(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeInterestBearingMint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateInterestBearingMintRate(t *testing.T) {
+	type args struct {
+		param UpdateInterestBearingMintRateParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateInterestBearingMintRateParam{
+					Mint:          common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					RateAuthority: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Rate:          500,
+					ProgramID:     common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{33, 1, 244, 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateInterestBearingMintRate(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateInterestBearingMintRate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnableCpiGuard(t *testing.T) {
+	type args struct {
+		param EnableCpiGuardParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: EnableCpiGuardParam{
+					Account:   common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Owner:     common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{34, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EnableCpiGuard(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnableCpiGuard() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisableCpiGuard(t *testing.T) {
+	type args struct {
+		param DisableCpiGuardParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: DisableCpiGuardParam{
+					Account:   common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Owner:     common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{34, 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DisableCpiGuard(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DisableCpiGuard() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializePermanentDelegate(t *testing.T) {
+	type args struct {
+		param InitializePermanentDelegateParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializePermanentDelegateParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Delegate:  common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{35, 159, 186, 247, 199, 172, 215, 195, 31, 127, 42, 207, 18, 192, 64, 156, 59, 98, 1, 180, 8, 69, 70, 199, 127, 220, 159, 6, 40, 64, 117, 246, 19},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializePermanentDelegate(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializePermanentDelegate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeTransferHook(t *testing.T) {
+	type args struct {
+		param InitializeTransferHookParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeTransferHookParam{
+					Mint:                  common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Authority:             pointer.Get[common.PublicKey](common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")),
+					TransferHookProgramID: pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					ProgramID:             common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{36, 0, 159, 186, 247, 199, 172, 215, 195, 31, 127, 42, 207, 18, 192, 64, 156, 59, 98, 1, 180, 8, 69, 70, 199, 127, 220, 159, 6, 40, 64, 117, 246, 19, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeTransferHook(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeTransferHook() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateTransferHook(t *testing.T) {
+	type args struct {
+		param UpdateTransferHookParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateTransferHookParam{
+					Mint:                  common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Authority:             common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					TransferHookProgramID: pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					ProgramID:             common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{36, 1, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240},
+			},
+		},
+		{
+			args: args{
+				param: UpdateTransferHookParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Authority: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{36, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateTransferHook(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateTransferHook() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeMetadataPointer(t *testing.T) {
+	type args struct {
+		param InitializeMetadataPointerParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeMetadataPointerParam{
+					Mint:            common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Authority:       pointer.Get[common.PublicKey](common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")),
+					MetadataAddress: pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					ProgramID:       common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{39, 0, 159, 186, 247, 199, 172, 215, 195, 31, 127, 42, 207, 18, 192, 64, 156, 59, 98, 1, 180, 8, 69, 70, 199, 127, 220, 159, 6, 40, 64, 117, 246, 19, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeMetadataPointer(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeMetadataPointer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateMetadataPointer(t *testing.T) {
+	type args struct {
+		param UpdateMetadataPointerParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateMetadataPointerParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Authority: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Signers: []common.PublicKey{
+						common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"),
+						common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"),
+					},
+					MetadataAddress: pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					ProgramID:       common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{39, 1, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateMetadataPointer(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateMetadataPointer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeGroupPointer(t *testing.T) {
+	type args struct {
+		param InitializeGroupPointerParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeGroupPointerParam{
+					Mint:         common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					GroupAddress: pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					ProgramID:    common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{40, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeGroupPointer(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeGroupPointer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateGroupPointer(t *testing.T) {
+	type args struct {
+		param UpdateGroupPointerParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateGroupPointerParam{
+					Mint:         common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Authority:    common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					GroupAddress: pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					ProgramID:    common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{40, 1, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateGroupPointer(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateGroupPointer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeGroupMemberPointer(t *testing.T) {
+	type args struct {
+		param InitializeGroupMemberPointerParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeGroupMemberPointerParam{
+					Mint:          common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Authority:     pointer.Get[common.PublicKey](common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")),
+					MemberAddress: pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					ProgramID:     common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{41, 0, 159, 186, 247, 199, 172, 215, 195, 31, 127, 42, 207, 18, 192, 64, 156, 59, 98, 1, 180, 8, 69, 70, 199, 127, 220, 159, 6, 40, 64, 117, 246, 19, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeGroupMemberPointer(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeGroupMemberPointer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateGroupMemberPointer(t *testing.T) {
+	type args struct {
+		param UpdateGroupMemberPointerParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UpdateGroupMemberPointerParam{
+					Mint:          common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Authority:     common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					MemberAddress: pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					ProgramID:     common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{41, 1, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateGroupMemberPointer(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateGroupMemberPointer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeNonTransferableMint(t *testing.T) {
+	type args struct {
+		param InitializeNonTransferableMintParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeNonTransferableMintParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{32},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeNonTransferableMint(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeNonTransferableMint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToken2022OnlyInstructions(t *testing.T) {
+	mint := common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv")
+	tests := map[string]func(programID common.PublicKey) types.Instruction{
+		"InitializeMintCloseAuthority": func(programID common.PublicKey) types.Instruction {
+			return InitializeMintCloseAuthority(InitializeMintCloseAuthorityParam{Mint: mint, ProgramID: programID})
+		},
+		"Reallocate": func(programID common.PublicKey) types.Instruction {
+			return Reallocate(ReallocateParam{Account: mint, ProgramID: programID})
+		},
+		"CreateNativeMint": func(programID common.PublicKey) types.Instruction {
+			return CreateNativeMint(CreateNativeMintParam{Payer: mint, ProgramID: programID})
+		},
+		"InitializeTransferFeeConfig": func(programID common.PublicKey) types.Instruction {
+			return InitializeTransferFeeConfig(InitializeTransferFeeConfigParam{Mint: mint, ProgramID: programID})
+		},
+		"EnableCpiGuard": func(programID common.PublicKey) types.Instruction {
+			return EnableCpiGuard(EnableCpiGuardParam{Account: mint, Owner: mint, ProgramID: programID})
+		},
+		"InitializeNonTransferableMint": func(programID common.PublicKey) types.Instruction {
+			return InitializeNonTransferableMint(InitializeNonTransferableMintParam{Mint: mint, ProgramID: programID})
+		},
+	}
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.NotPanics(t, func() { f(common.Token2022ProgramID) })
+			assert.Panics(t, func() { f(common.TokenProgramID) })
+		})
+	}
+}
@@ -1182,3 +1182,310 @@ func TestInitializeMint2(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAccountDataSize(t *testing.T) {
+	type args struct {
+		param GetAccountDataSizeParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: GetAccountDataSizeParam{
+					Mint:           common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					ExtensionTypes: []ExtensionType{ExtensionTypeImmutableOwner, ExtensionTypeMemoTransfer},
+					ProgramID:      common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{21, 7, 0, 8, 0},
+			},
+		},
+		{
+			args: args{
+				param: GetAccountDataSizeParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					ProgramID: common.TokenProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.TokenProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{21},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetAccountDataSize(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetAccountDataSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeImmutableOwner(t *testing.T) {
+	type args struct {
+		param InitializeImmutableOwnerParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeImmutableOwnerParam{
+					Account:   common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{22},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeImmutableOwner(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeImmutableOwner() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmountToUiAmount(t *testing.T) {
+	type args struct {
+		param AmountToUiAmountParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: AmountToUiAmountParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					Amount:    99999,
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{23, 159, 134, 1, 0, 0, 0, 0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AmountToUiAmount(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AmountToUiAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUiAmountToAmount(t *testing.T) {
+	type args struct {
+		param UiAmountToAmountParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: UiAmountToAmountParam{
+					Mint:      common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"),
+					UiAmount:  "9.9999",
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("HFCNHUwPxRqqW6gaLd3uUjJcEUfjnRptJzh4xvnNmavv"), IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{24, 57, 46, 57, 57, 57, 57},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UiAmountToAmount(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UiAmountToAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitializeMintCloseAuthority(t *testing.T) {
+	type args struct {
+		param InitializeMintCloseAuthorityParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: InitializeMintCloseAuthorityParam{
+					Mint:           common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					CloseAuthority: pointer.Get[common.PublicKey](common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ")),
+					ProgramID:      common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{25, 1, 159, 186, 247, 199, 172, 215, 195, 31, 127, 42, 207, 18, 192, 64, 156, 59, 98, 1, 180, 8, 69, 70, 199, 127, 220, 159, 6, 40, 64, 117, 246, 19},
+			},
+		},
+		{
+			args: args{
+				param: InitializeMintCloseAuthorityParam{
+					Mint:      common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+				},
+				Data: []byte{25, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitializeMintCloseAuthority(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InitializeMintCloseAuthority() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReallocate(t *testing.T) {
+	type args struct {
+		param ReallocateParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: ReallocateParam{
+					Account:        common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Payer:          common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Owner:          common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					ExtensionTypes: []ExtensionType{ExtensionTypeMemoTransfer},
+					ProgramID:      common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: true},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{29, 8, 0},
+			},
+		},
+		{
+			args: args{
+				param: ReallocateParam{
+					Account: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"),
+					Payer:   common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					Owner:   common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+					Signers: []common.PublicKey{
+						common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"),
+						common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"),
+					},
+					ExtensionTypes: []ExtensionType{ExtensionTypeMemoTransfer, ExtensionTypeCpiGuard},
+					ProgramID:      common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("FtvD2ymcAFh59DGGmJkANyJzEpLDR1GLgqDrUxfe2dPm"), IsSigner: false, IsWritable: true},
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: true},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"), IsSigner: false, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner1111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+					{PubKey: common.PublicKeyFromString("S1gner2111111111111111111111111111111111111"), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{29, 8, 0, 11, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reallocate(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reallocate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateNativeMint(t *testing.T) {
+	type args struct {
+		param CreateNativeMintParam
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Instruction
+	}{
+		{
+			args: args{
+				param: CreateNativeMintParam{
+					Payer:     common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"),
+					ProgramID: common.Token2022ProgramID,
+				},
+			},
+			want: types.Instruction{
+				ProgramID: common.Token2022ProgramID,
+				Accounts: []types.AccountMeta{
+					{PubKey: common.PublicKeyFromString("BkXBQ9ThbQffhmG39c2TbXW94pEmVGJAvxWk6hfxRvUJ"), IsSigner: true, IsWritable: true},
+					{PubKey: Token2022NativeMint, IsSigner: false, IsWritable: true},
+					{PubKey: common.SystemProgramID, IsSigner: false, IsWritable: false},
+				},
+				Data: []byte{31},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CreateNativeMint(tt.args.param); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateNativeMint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
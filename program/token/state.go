@@ -60,11 +60,16 @@ type MintAccount struct {
 	Decimals        uint8
 	IsInitialized   bool
 	FreezeAuthority *common.PublicKey
+	// Extensions are the token-2022 extensions, it is nil if the mint has none
+	Extensions []Extension
 }
 
+// MintAccountFromData parses a mint. a token-2022 mint with extensions is padded to TokenAccountSize
+// and followed by the account type and the extensions.
 func MintAccountFromData(data []byte) (MintAccount, error) {
-	if len(data) != MintAccountSize {
-		return MintAccount{}, ErrInvalidAccountDataSize
+	extensions, err := baseAccountExtensions(data, MintAccountSize, AccountTypeMint)
+	if err != nil {
+		return MintAccount{}, err
 	}
 
 	var mint *common.PublicKey
@@ -91,6 +96,7 @@ func MintAccountFromData(data []byte) (MintAccount, error) {
 		Decimals:        decimals,
 		IsInitialized:   isInitialized,
 		FreezeAuthority: freezeAuthority,
+		Extensions:      extensions,
 	}, nil
 }
 
@@ -117,12 +123,16 @@ type TokenAccount struct {
 	IsNative        *uint64
 	DelegatedAmount uint64
 	CloseAuthority  *common.PublicKey
+	// Extensions are the token-2022 extensions, it is nil if the account has none
+	Extensions []Extension
 }
 
-// TODO 这里针对 Token 2022 Program 的token待完善，这里只是初步解析
+// TokenAccountFromData parses a token account. a token-2022 account with extensions is followed by
+// the account type and the extensions.
 func TokenAccountFromData(data []byte) (TokenAccount, error) {
-	if len(data) != TokenAccountSize && len(data) != Token2022AccountSize && len(data) != Token2022AccountSizeA {
-		return TokenAccount{}, ErrInvalidAccountDataSize
+	extensions, err := baseAccountExtensions(data, TokenAccountSize, AccountTypeAccount)
+	if err != nil {
+		return TokenAccount{}, err
 	}
 
 	mint := common.PublicKeyFromBytes(data[:32])
@@ -162,9 +172,21 @@ func TokenAccountFromData(data []byte) (TokenAccount, error) {
 		IsNative:        isNative,
 		DelegatedAmount: delegatedAmount,
 		CloseAuthority:  closeAuthority,
+		Extensions:      extensions,
 	}, nil
 }
 
+// baseAccountExtensions checks the size of the data and decodes the extensions behind the base account
+func baseAccountExtensions(data []byte, baseSize int, accountType AccountType) ([]Extension, error) {
+	if len(data) == baseSize {
+		return nil, nil
+	}
+	if len(data) <= TokenAccountSize || AccountType(data[TokenAccountSize]) != accountType {
+		return nil, ErrInvalidAccountDataSize
+	}
+	return extensionsFromData(data[TokenAccountSize+1:])
+}
+
 func DeserializeTokenAccount(data []byte, accountOwner common.PublicKey) (TokenAccount, error) {
 	if accountOwner != common.TokenProgramID && accountOwner != common.Token2022ProgramID {
 		return TokenAccount{}, ErrInvalidAccountOwner
@@ -50,6 +50,35 @@ func TestAccountFromData(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "token-2022 account with extensions",
+			args: args{
+				data: []byte{0xce, 0xd3, 0x87, 0xe6, 0xc3, 0x6f, 0x57, 0xfe, 0x93, 0xef, 0x8f, 0x51, 0x6e, 0x9f, 0x31, 0x8c, 0x6d, 0x89, 0xe0, 0xc5, 0x18, 0x31, 0xdf, 0x3d, 0x7b, 0x8, 0x4e, 0x6d, 0x6e, 0x88, 0xe4, 0xf0, 0x10, 0x96, 0x59, 0x17, 0x5e, 0x7c, 0x64, 0x33, 0x21, 0xa5, 0xed, 0x46, 0x42, 0xa0, 0x27, 0xb0, 0xab, 0xd9, 0x7b, 0x8d, 0xd9, 0x7a, 0xd1, 0xbc, 0xc6, 0xdc, 0x64, 0x71, 0x38, 0x6c, 0xcd, 0xdc, 0x64, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2, 0x7, 0x0, 0x0, 0x0, 0x2, 0x0, 0x8, 0x0, 0x3, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+			},
+			want: TokenAccount{
+				Mint:            common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+				Owner:           common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ"),
+				Amount:          100,
+				Delegate:        nil,
+				State:           TokenAccountStateInitialized,
+				IsNative:        nil,
+				DelegatedAmount: 0,
+				CloseAuthority:  nil,
+				Extensions: []Extension{
+					ImmutableOwner{},
+					TransferFeeAmount{WithheldAmount: 3},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "token-2022 account with only the account type",
+			args: args{
+				data: append(make([]byte, TokenAccountSize), byte(AccountTypeAccount)),
+			},
+			want:    TokenAccount{},
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -96,6 +125,60 @@ func TestMintAccountFromData(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "token-2022 mint with extensions",
+			args: args{
+				data: []byte{0x1, 0x0, 0x0, 0x0, 0x10, 0x96, 0x59, 0x17, 0x5e, 0x7c, 0x64, 0x33, 0x21, 0xa5, 0xed, 0x46, 0x42, 0xa0, 0x27, 0xb0, 0xab, 0xd9, 0x7b, 0x8d, 0xd9, 0x7a, 0xd1, 0xbc, 0xc6, 0xdc, 0x64, 0x71, 0x38, 0x6c, 0xcd, 0xdc, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x3, 0x0, 0x20, 0x0, 0xce, 0xd3, 0x87, 0xe6, 0xc3, 0x6f, 0x57, 0xfe, 0x93, 0xef, 0x8f, 0x51, 0x6e, 0x9f, 0x31, 0x8c, 0x6d, 0x89, 0xe0, 0xc5, 0x18, 0x31, 0xdf, 0x3d, 0x7b, 0x8, 0x4e, 0x6d, 0x6e, 0x88, 0xe4, 0xf0, 0x1, 0x0, 0x6c, 0x0, 0x10, 0x96, 0x59, 0x17, 0x5e, 0x7c, 0x64, 0x33, 0x21, 0xa5, 0xed, 0x46, 0x42, 0xa0, 0x27, 0xb0, 0xab, 0xd9, 0x7b, 0x8d, 0xd9, 0x7a, 0xd1, 0xbc, 0xc6, 0xdc, 0x64, 0x71, 0x38, 0x6c, 0xcd, 0xdc, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x7, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x88, 0x13, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x32, 0x0, 0x2, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x70, 0x17, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x64, 0x0, 0x13, 0x0, 0x6b, 0x0, 0x10, 0x96, 0x59, 0x17, 0x5e, 0x7c, 0x64, 0x33, 0x21, 0xa5, 0xed, 0x46, 0x42, 0xa0, 0x27, 0xb0, 0xab, 0xd9, 0x7b, 0x8d, 0xd9, 0x7a, 0xd1, 0xbc, 0xc6, 0xdc, 0x64, 0x71, 0x38, 0x6c, 0xcd, 0xdc, 0xce, 0xd3, 0x87, 0xe6, 0xc3, 0x6f, 0x57, 0xfe, 0x93, 0xef, 0x8f, 0x51, 0x6e, 0x9f, 0x31, 0x8c, 0x6d, 0x89, 0xe0, 0xc5, 0x18, 0x31, 0xdf, 0x3d, 0x7b, 0x8, 0x4e, 0x6d, 0x6e, 0x88, 0xe4, 0xf0, 0x5, 0x0, 0x0, 0x0, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3, 0x0, 0x0, 0x0, 0x54, 0x4b, 0x4e, 0x9, 0x0, 0x0, 0x0, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x78, 0x1, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x6b, 0x1, 0x0, 0x0, 0x0, 0x76, 0x14, 0x0, 0x2, 0x0, 0x9, 0x9},
+			},
+			want: MintAccount{
+				MintAuthority:   pointer.Get[common.PublicKey](common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")),
+				Supply:          0,
+				Decimals:        6,
+				IsInitialized:   true,
+				FreezeAuthority: nil,
+				Extensions: []Extension{
+					MintCloseAuthority{
+						CloseAuthority: pointer.Get[common.PublicKey](common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")),
+					},
+					TransferFeeConfig{
+						TransferFeeConfigAuthority: pointer.Get[common.PublicKey](common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")),
+						WithdrawWithheldAuthority:  nil,
+						WithheldAmount:             7,
+						OlderTransferFee:           TransferFee{Epoch: 1, MaximumFee: 5000, TransferFeeBasisPoints: 50},
+						NewerTransferFee:           TransferFee{Epoch: 2, MaximumFee: 6000, TransferFeeBasisPoints: 100},
+					},
+					TokenMetadata{
+						UpdateAuthority:    pointer.Get[common.PublicKey](common.PublicKeyFromString("27kVX7JpPZ1bsrSckbR76mV6GeRqtrjoddubfg2zBpHZ")),
+						Mint:               common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+						Name:               "Token",
+						Symbol:             "TKN",
+						Uri:                "https://x",
+						AdditionalMetadata: [][2]string{{"k", "v"}},
+					},
+					RawExtension{
+						ExtensionType: ExtensionTypeGroupPointer,
+						Data:          []byte{9, 9},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "token-2022 mint with truncated extension",
+			args: args{
+				data: []byte{0x1, 0x0, 0x0, 0x0, 0x10, 0x96, 0x59, 0x17, 0x5e, 0x7c, 0x64, 0x33, 0x21, 0xa5, 0xed, 0x46, 0x42, 0xa0, 0x27, 0xb0, 0xab, 0xd9, 0x7b, 0x8d, 0xd9, 0x7a, 0xd1, 0xbc, 0xc6, 0xdc, 0x64, 0x71, 0x38, 0x6c, 0xcd, 0xdc, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x6, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x3, 0x0, 0x20, 0x0},
+			},
+			want:    MintAccount{},
+			wantErr: ErrInvalidExtensionData,
+		},
+		{
+			name: "token-2022 mint with only the account type",
+			args: args{
+				data: append(make([]byte, TokenAccountSize), byte(AccountTypeMint)),
+			},
+			want:    MintAccount{},
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -265,3 +348,26 @@ func TestDeserializeTokenAccount(t *testing.T) {
 		})
 	}
 }
+
+func FuzzTokenAccountFromData(f *testing.F) {
+	f.Add(make([]byte, TokenAccountSize))
+	f.Add(append(make([]byte, TokenAccountSize), byte(AccountTypeAccount), 7, 0, 0, 0))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = TokenAccountFromData(data)
+	})
+}
+
+func FuzzMintAccountFromData(f *testing.F) {
+	f.Add(make([]byte, MintAccountSize))
+	f.Add(append(make([]byte, TokenAccountSize), byte(AccountTypeMint), 3, 0, 32, 0))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = MintAccountFromData(data)
+	})
+}
+
+func FuzzMultisigAccountFromData(f *testing.F) {
+	f.Add(make([]byte, MultisigAccountSize))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = MultisigAccountFromData(data)
+	})
+}
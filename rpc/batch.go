@@ -0,0 +1,214 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrBatchNotSent         = errors.New("batch has not been sent")
+	ErrBatchResponseMissing = errors.New("batch response missing")
+)
+
+// Batch queues calls and sends them in one json-rpc batch request. every call gets an unique incrementing id
+// and its own response and error, a failed call doesn't fail the others. a Batch should only be sent once.
+type Batch struct {
+	c        *RpcClient
+	requests []JsonRpcRequest
+	calls    []func(raw json.RawMessage, err error)
+}
+
+// BatchCall is a call in a batch. Response and Err are filled by Batch.Send.
+type BatchCall[T any] struct {
+	Response T
+	Err      error
+}
+
+// Result returns the response and the error of the call
+func (b *BatchCall[T]) Result() (T, error) {
+	return b.Response, b.Err
+}
+
+// NewBatch returns an empty batch which is sent by the client
+func (c *RpcClient) NewBatch() *Batch {
+	return &Batch{c: c}
+}
+
+// Len returns the number of the queued calls
+func (b *Batch) Len() int {
+	return len(b.requests)
+}
+
+// BatchAdd queues a call of the method. T is the type of the whole json-rpc response, e.g. JsonRpcResponse[uint64].
+// it can be used for the methods Batch doesn't wrap.
+func BatchAdd[T any](b *Batch, method string, params ...any) *BatchCall[T] {
+	call := &BatchCall[T]{Err: ErrBatchNotSent}
+	b.requests = append(b.requests, JsonRpcRequest{
+		JsonRpc: "2.0",
+		Id:      uint64(len(b.requests) + 1),
+		Method:  method,
+		Params:  params,
+	})
+	b.calls = append(b.calls, func(raw json.RawMessage, err error) {
+		if err != nil {
+			call.Err = err
+			return
+		}
+		if err := json.Unmarshal(raw, &call.Response); err != nil {
+			call.Err = fmt.Errorf("failed to json decode body, err: %v", err)
+			return
+		}
+		call.Err = nil
+		if r, ok := any(call.Response).(interface{ GetError() error }); ok {
+			call.Err = r.GetError()
+		}
+	})
+	return call
+}
+
+// Send sends the queued calls. it only returns an error when the whole batch fails, in which case
+// every call carries the error as well. the errors of the calls are in their Err.
+func (b *Batch) Send(ctx context.Context) error {
+	if len(b.requests) == 0 {
+		return nil
+	}
+
+	j, err := json.Marshal(b.requests)
+	if err != nil {
+		return b.fail(fmt.Errorf("failed to prepare payload, err: %v", err))
+	}
+
+	body, err := b.c.post(ctx, j)
+	if err != nil {
+		return b.fail(err)
+	}
+
+	var entries []json.RawMessage
+	if err := json.Unmarshal(body, &entries); err != nil {
+		// the node replies with a single response when it rejects the whole batch
+		var res JsonRpcResponse[any]
+		if json.Unmarshal(body, &res) == nil && res.Error != nil {
+			return b.fail(res.Error)
+		}
+		return b.fail(fmt.Errorf("failed to json decode body, err: %v", err))
+	}
+
+	answered := make([]bool, len(b.calls))
+	for _, entry := range entries {
+		var header struct {
+			Id uint64 `json:"id"`
+		}
+		if err := json.Unmarshal(entry, &header); err != nil || header.Id == 0 || header.Id > uint64(len(b.calls)) {
+			continue
+		}
+		idx := header.Id - 1
+		answered[idx] = true
+		b.calls[idx](entry, nil)
+	}
+	for i, ok := range answered {
+		if !ok {
+			b.calls[i](nil, fmt.Errorf("%w, id: %v", ErrBatchResponseMissing, i+1))
+		}
+	}
+
+	return nil
+}
+
+func (b *Batch) fail(err error) error {
+	for _, call := range b.calls {
+		call(nil, err)
+	}
+	return err
+}
+
+// GetAccountInfo queues a `getAccountInfo`
+func (b *Batch) GetAccountInfo(base58Addr string) *BatchCall[JsonRpcResponse[ValueWithContext[AccountInfo]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[AccountInfo]]](b, "getAccountInfo", base58Addr)
+}
+
+// GetAccountInfoWithConfig queues a `getAccountInfo`
+func (b *Batch) GetAccountInfoWithConfig(base58Addr string, cfg GetAccountInfoConfig) *BatchCall[JsonRpcResponse[ValueWithContext[AccountInfo]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[AccountInfo]]](b, "getAccountInfo", base58Addr, cfg)
+}
+
+// GetMultipleAccounts queues a `getMultipleAccounts`
+func (b *Batch) GetMultipleAccounts(base58Addrs []string) *BatchCall[JsonRpcResponse[ValueWithContext[[]AccountInfo]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[[]AccountInfo]]](b, "getMultipleAccounts", base58Addrs)
+}
+
+// GetMultipleAccountsWithConfig queues a `getMultipleAccounts`
+func (b *Batch) GetMultipleAccountsWithConfig(base58Addrs []string, cfg GetMultipleAccountsConfig) *BatchCall[JsonRpcResponse[ValueWithContext[[]AccountInfo]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[[]AccountInfo]]](b, "getMultipleAccounts", base58Addrs, cfg)
+}
+
+// GetBalance queues a `getBalance`
+func (b *Batch) GetBalance(base58Addr string) *BatchCall[JsonRpcResponse[ValueWithContext[uint64]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[uint64]]](b, "getBalance", base58Addr)
+}
+
+// GetBalanceWithConfig queues a `getBalance`
+func (b *Batch) GetBalanceWithConfig(base58Addr string, cfg GetBalanceConfig) *BatchCall[JsonRpcResponse[ValueWithContext[uint64]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[uint64]]](b, "getBalance", base58Addr, cfg)
+}
+
+// GetTokenAccountBalance queues a `getTokenAccountBalance`
+func (b *Batch) GetTokenAccountBalance(base58Addr string) *BatchCall[JsonRpcResponse[ValueWithContext[TokenAccountBalance]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[TokenAccountBalance]]](b, "getTokenAccountBalance", base58Addr)
+}
+
+// GetTokenAccountBalanceWithConfig queues a `getTokenAccountBalance`
+func (b *Batch) GetTokenAccountBalanceWithConfig(base58Addr string, cfg GetTokenAccountBalanceConfig) *BatchCall[JsonRpcResponse[ValueWithContext[TokenAccountBalance]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[TokenAccountBalance]]](b, "getTokenAccountBalance", base58Addr, cfg)
+}
+
+// GetTransaction queues a `getTransaction`
+func (b *Batch) GetTransaction(txhash string) *BatchCall[JsonRpcResponse[*GetTransaction]] {
+	return BatchAdd[JsonRpcResponse[*GetTransaction]](b, "getTransaction", txhash)
+}
+
+// GetTransactionWithConfig queues a `getTransaction`
+func (b *Batch) GetTransactionWithConfig(txhash string, cfg GetTransactionConfig) *BatchCall[JsonRpcResponse[*GetTransaction]] {
+	return BatchAdd[JsonRpcResponse[*GetTransaction]](b, "getTransaction", txhash, cfg)
+}
+
+// GetSignatureStatuses queues a `getSignatureStatuses`
+func (b *Batch) GetSignatureStatuses(signatures []string) *BatchCall[JsonRpcResponse[ValueWithContext[SignatureStatuses]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[SignatureStatuses]]](b, "getSignatureStatuses", signatures)
+}
+
+// GetSignatureStatusesWithConfig queues a `getSignatureStatuses`
+func (b *Batch) GetSignatureStatusesWithConfig(signatures []string, cfg GetSignatureStatusesConfig) *BatchCall[JsonRpcResponse[ValueWithContext[SignatureStatuses]]] {
+	return BatchAdd[JsonRpcResponse[ValueWithContext[SignatureStatuses]]](b, "getSignatureStatuses", signatures, cfg)
+}
+
+// GetSignaturesForAddress queues a `getSignaturesForAddress`
+func (b *Batch) GetSignaturesForAddress(base58Addr string) *BatchCall[JsonRpcResponse[GetSignaturesForAddress]] {
+	return BatchAdd[JsonRpcResponse[GetSignaturesForAddress]](b, "getSignaturesForAddress", base58Addr)
+}
+
+// GetSignaturesForAddressWithConfig queues a `getSignaturesForAddress`
+func (b *Batch) GetSignaturesForAddressWithConfig(base58Addr string, cfg GetSignaturesForAddressConfig) *BatchCall[JsonRpcResponse[GetSignaturesForAddress]] {
+	return BatchAdd[JsonRpcResponse[GetSignaturesForAddress]](b, "getSignaturesForAddress", base58Addr, cfg)
+}
+
+// GetSlot queues a `getSlot`
+func (b *Batch) GetSlot() *BatchCall[JsonRpcResponse[uint64]] {
+	return BatchAdd[JsonRpcResponse[uint64]](b, "getSlot")
+}
+
+// GetSlotWithConfig queues a `getSlot`
+func (b *Batch) GetSlotWithConfig(cfg GetSlotConfig) *BatchCall[JsonRpcResponse[uint64]] {
+	return BatchAdd[JsonRpcResponse[uint64]](b, "getSlot", cfg)
+}
+
+// GetBlockHeight queues a `getBlockHeight`
+func (b *Batch) GetBlockHeight() *BatchCall[JsonRpcResponse[uint64]] {
+	return BatchAdd[JsonRpcResponse[uint64]](b, "getBlockHeight")
+}
+
+// GetBlockHeightWithConfig queues a `getBlockHeight`
+func (b *Batch) GetBlockHeightWithConfig(cfg GetBlockHeightConfig) *BatchCall[JsonRpcResponse[uint64]] {
+	return BatchAdd[JsonRpcResponse[uint64]](b, "getBlockHeight", cfg)
+}
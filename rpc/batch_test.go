@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatch_Send(t *testing.T) {
+	var gotRequests []JsonRpcRequest
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		assert.Nil(t, json.Unmarshal(body, &gotRequests))
+		// out of order on purpose, id 4 is missing
+		_, _ = rw.Write([]byte(`[
+			{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid param: Invalid"},"id":2},
+			{"jsonrpc":"2.0","result":{"context":{"slot":77317717},"value":21474700000},"id":1},
+			{"jsonrpc":"2.0","result":86686567,"id":3}
+		]`))
+	}))
+	defer s.Close()
+
+	c := New(WithEndpoint(s.URL))
+	b := c.NewBatch()
+	balance := b.GetBalance("RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+	invalid := b.GetAccountInfo("Invalid")
+	slot := b.GetSlotWithConfig(GetSlotConfig{Commitment: CommitmentFinalized})
+	height := b.GetBlockHeight()
+	assert.Equal(t, 4, b.Len())
+
+	_, err := slot.Result()
+	assert.ErrorIs(t, err, ErrBatchNotSent)
+
+	assert.Nil(t, b.Send(context.Background()))
+
+	assert.Len(t, gotRequests, 4)
+	for i, req := range gotRequests {
+		assert.Equal(t, uint64(i+1), req.Id)
+	}
+	assert.Equal(t, "getBalance", gotRequests[0].Method)
+	assert.Equal(t, []any{"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7"}, gotRequests[0].Params)
+	assert.Equal(t, "getSlot", gotRequests[2].Method)
+	assert.Equal(t, []any{map[string]any{"commitment": "finalized"}}, gotRequests[2].Params)
+	assert.Nil(t, gotRequests[3].Params)
+
+	res, err := balance.Result()
+	assert.Nil(t, err)
+	assert.Equal(t, ValueWithContext[uint64]{Context: Context{Slot: 77317717}, Value: 21474700000}, res.Result)
+
+	_, err = invalid.Result()
+	var rpcErr *JsonRpcError
+	assert.True(t, errors.As(err, &rpcErr))
+	assert.Equal(t, -32602, rpcErr.Code)
+
+	slotRes, err := slot.Result()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(86686567), slotRes.Result)
+
+	_, err = height.Result()
+	assert.ErrorIs(t, err, ErrBatchResponseMissing)
+}
+
+func TestBatch_Send_Failed(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr func(*testing.T, error)
+	}{
+		{
+			name:   "http error",
+			status: http.StatusBadRequest,
+			body:   `{}`,
+			wantErr: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "get status code: 400")
+			},
+		},
+		{
+			name:   "batch rejected",
+			status: http.StatusOK,
+			body:   `{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid request"},"id":null}`,
+			wantErr: func(t *testing.T, err error) {
+				var rpcErr *JsonRpcError
+				assert.True(t, errors.As(err, &rpcErr))
+				assert.Equal(t, -32600, rpcErr.Code)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := tt.status, tt.body
+			s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				rw.WriteHeader(status)
+				_, _ = rw.Write([]byte(body))
+			}))
+			defer s.Close()
+
+			c := New(WithEndpoint(s.URL))
+			b := c.NewBatch()
+			first := b.GetSlot()
+			second := b.GetTransaction("txhash")
+
+			err := b.Send(context.Background())
+			tt.wantErr(t, err)
+			_, firstErr := first.Result()
+			_, secondErr := second.Result()
+			assert.Equal(t, err, firstErr)
+			assert.Equal(t, err, secondErr)
+		})
+	}
+}
+
+func TestBatch_Send_Empty(t *testing.T) {
+	c := New(WithEndpoint("http://127.0.0.1:0"))
+	assert.Nil(t, c.NewBatch().Send(context.Background()))
+}
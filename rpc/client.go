@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 const (
@@ -65,6 +66,11 @@ type ValueWithContext[T any] struct {
 type RpcClient struct {
 	endpoint   string
 	httpClient *http.Client
+
+	retry            *retryConfig
+	rateLimiter      *rateLimiter
+	endpoints        *endpointPool
+	endpointCooldown time.Duration
 }
 
 func NewRpcClient(endpoint string) RpcClient { return New(WithEndpoint(endpoint)) }
@@ -81,43 +87,122 @@ func New(opts ...Option) RpcClient {
 		opt(client)
 	}
 
+	if client.endpoints != nil && client.endpointCooldown > 0 {
+		client.endpoints.cooldown = client.endpointCooldown
+	}
+
 	return *client
 }
 
 // Call will return body of response. if http code beyond 200~300, the error also returns.
+// the request is retried and failed over according to WithRetry and WithEndpoints.
 func (c *RpcClient) Call(ctx context.Context, params ...any) ([]byte, error) {
 	// prepare payload
 	j, err := preparePayload(params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare payload, err: %v", err)
 	}
+	return c.post(ctx, j)
+}
+
+// post sends the payload with rate limit, retries and failover
+func (c *RpcClient) post(ctx context.Context, j []byte) ([]byte, error) {
+	maxAttempts := 1
+	if c.retry != nil {
+		maxAttempts += c.retry.maxRetries
+	}
+	if c.endpoints != nil && len(c.endpoints.endpoints) > maxAttempts {
+		maxAttempts = len(c.endpoints.endpoints)
+	}
+
+	tried := map[int]bool{}
+	for attempt := 0; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		endpoint, endpointIdx := c.endpoint, -1
+		if c.endpoints != nil {
+			endpointIdx = c.endpoints.pick(tried)
+			endpoint = c.endpoints.endpoints[endpointIdx]
+			tried[endpointIdx] = true
+		}
+
+		body, retryable, retryAfter, err := c.do(ctx, endpoint, j)
+		if err == nil {
+			if endpointIdx != -1 {
+				c.endpoints.markHealthy(endpointIdx)
+			}
+			return body, nil
+		}
+		if !retryable || ctx.Err() != nil || attempt+1 >= maxAttempts {
+			return body, err
+		}
+
+		// a bad Retry-After can't park the call longer than the max backoff
+		maxBackoff := defaultRetryMaxBackoff
+		if c.retry != nil {
+			maxBackoff = c.retry.maxBackoff
+		}
+		if retryAfter > maxBackoff {
+			retryAfter = maxBackoff
+		}
+
+		// the server knows best when it can take the request again
+		delay := retryAfter
+		if delay == 0 && c.retry != nil {
+			delay = c.retry.backoff(attempt)
+		}
+		if endpointIdx != -1 {
+			c.endpoints.markUnhealthy(endpointIdx, retryAfter)
+			// fail over right away if there is another healthy endpoint
+			if c.endpoints.hasHealthy(tried) {
+				delay = 0
+			}
+		}
+
+		if delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return body, err
+			case <-timer.C:
+			}
+		}
+	}
+}
 
+// do sends the payload once. it reports whether the failure is worth a retry and the Retry-After of the response.
+func (c *RpcClient) do(ctx context.Context, endpoint string, payload []byte) ([]byte, bool, time.Duration, error) {
 	// prepare request
-	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint, bytes.NewBuffer(j))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(payload))
 	if err != nil {
-		return nil, fmt.Errorf("failed to do http.NewRequestWithContext, err: %v", err)
+		return nil, false, 0, fmt.Errorf("failed to do http.NewRequestWithContext, err: %v", err)
 	}
 	req.Header.Add("Content-Type", "application/json")
 
 	// do request
 	res, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to do request, err: %v", err)
+		return nil, true, 0, fmt.Errorf("failed to do request, err: %v", err)
 	}
 	defer res.Body.Close()
 
 	// parse body
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read body, err: %v", err)
+		return nil, true, 0, fmt.Errorf("failed to read body, err: %v", err)
 	}
 
 	// check response code
 	if res.StatusCode < 200 || res.StatusCode > 300 {
-		return body, fmt.Errorf("get status code: %v", res.StatusCode)
+		return body, isRetryableStatus(res.StatusCode), parseRetryAfter(res.Header.Get("Retry-After"), time.Now()), fmt.Errorf("get status code: %v", res.StatusCode)
 	}
 
-	return body, nil
+	return body, false, 0, nil
 }
 
 func preparePayload(params []any) ([]byte, error) {
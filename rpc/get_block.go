@@ -1,17 +1,21 @@
 package rpc
 
-import "context"
+import (
+	"context"
+
+	"github.com/blocto/solana-go-sdk/common"
+)
 
 type GetBlockResponse JsonRpcResponse[GetBlock]
 
 type GetBlock struct {
-	Blockhash         string                `json:"blockhash"`
+	Blockhash         common.Hash           `json:"blockhash"`
 	BlockTime         *int64                `json:"blockTime"`
 	BlockHeight       *int64                `json:"blockHeight"`
-	PreviousBlockhash string                `json:"previousBlockhash"`
+	PreviousBlockhash common.Hash           `json:"previousBlockhash"`
 	ParentSlot        uint64                `json:"parentSlot"`
 	Transactions      []GetBlockTransaction `json:"transactions"`
-	Signatures        []string              `json:"signatures"`
+	Signatures        []common.Signature    `json:"signatures"`
 	Rewards           []Reward              `json:"rewards"`
 }
 
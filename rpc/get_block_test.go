@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/blocto/solana-go-sdk/common"
 	"github.com/blocto/solana-go-sdk/internal/client_test"
 	"github.com/blocto/solana-go-sdk/pkg/pointer"
 )
@@ -30,8 +31,8 @@ func TestGetBlock(t *testing.T) {
 						ParentSlot:        32,
 						BlockHeight:       pointer.Get[int64](33),
 						BlockTime:         pointer.Get[int64](1631803928),
-						PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
-						Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+						PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
+						Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 						Rewards: []Reward{
 							{
 								Pubkey:       "9HvwukipCq1TVcSWoNQW7ajTUDFyC16KrARqnXppBdwX",
@@ -125,8 +126,8 @@ func TestGetBlock(t *testing.T) {
 						ParentSlot:        32,
 						BlockHeight:       pointer.Get[int64](33),
 						BlockTime:         pointer.Get[int64](1631803928),
-						PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
-						Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+						PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
+						Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 						Transactions: []GetBlockTransaction{
 							{
 								Meta: &TransactionMeta{
@@ -186,8 +187,8 @@ func TestGetBlock(t *testing.T) {
 						ParentSlot:        32,
 						BlockHeight:       pointer.Get[int64](33),
 						BlockTime:         pointer.Get[int64](1631803928),
-						PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
-						Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+						PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
+						Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 						Rewards: []Reward{
 							{
 								Pubkey:       "9HvwukipCq1TVcSWoNQW7ajTUDFyC16KrARqnXppBdwX",
@@ -257,8 +258,8 @@ func TestGetBlock(t *testing.T) {
 						ParentSlot:        32,
 						BlockHeight:       pointer.Get[int64](33),
 						BlockTime:         pointer.Get[int64](1631803928),
-						PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
-						Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+						PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
+						Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 						Rewards: []Reward{
 							{
 								Pubkey:       "9HvwukipCq1TVcSWoNQW7ajTUDFyC16KrARqnXppBdwX",
@@ -268,8 +269,8 @@ func TestGetBlock(t *testing.T) {
 								Commission:   nil,
 							},
 						},
-						Signatures: []string{
-							"3Me2gWFGDFwWnhugNt5u1fFvU2CyVtY4WcRzBXRKUWtgnYSxnt72p5fWiNrAkEoNTLL6FdLmk34kC41Ph91LKr6A",
+						Signatures: []common.Signature{
+							common.MustParseSignature("3Me2gWFGDFwWnhugNt5u1fFvU2CyVtY4WcRzBXRKUWtgnYSxnt72p5fWiNrAkEoNTLL6FdLmk34kC41Ph91LKr6A"),
 						},
 					},
 				},
@@ -297,8 +298,8 @@ func TestGetBlock(t *testing.T) {
 						ParentSlot:        32,
 						BlockHeight:       pointer.Get[int64](33),
 						BlockTime:         pointer.Get[int64](1631803928),
-						PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
-						Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+						PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
+						Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 						Rewards: []Reward{
 							{
 								Pubkey:       "9HvwukipCq1TVcSWoNQW7ajTUDFyC16KrARqnXppBdwX",
@@ -335,8 +336,8 @@ func TestGetBlock(t *testing.T) {
 						ParentSlot:        32,
 						BlockHeight:       pointer.Get[int64](33),
 						BlockTime:         pointer.Get[int64](1631803928),
-						PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
-						Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+						PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
+						Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 						Rewards: []Reward{
 							{
 								Pubkey:       "9HvwukipCq1TVcSWoNQW7ajTUDFyC16KrARqnXppBdwX",
@@ -373,8 +374,8 @@ func TestGetBlock(t *testing.T) {
 						ParentSlot:        32,
 						BlockHeight:       pointer.Get[int64](33),
 						BlockTime:         pointer.Get[int64](1631803928),
-						PreviousBlockhash: "CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q",
-						Blockhash:         "HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT",
+						PreviousBlockhash: common.MustParseHash("CXjZvhmFVa4ATW8Qq7XSXJFmB25aEqfHiEbCieujPd9q"),
+						Blockhash:         common.MustParseHash("HUonDijNaSHAPobKtAkg1ewJjy2wECpynbCq5wQ5dkCT"),
 						Transactions: []GetBlockTransaction{
 							{
 								Meta: &TransactionMeta{
@@ -461,8 +462,8 @@ func TestGetBlock(t *testing.T) {
 						ParentSlot:        46985,
 						BlockHeight:       pointer.Get[int64](46984),
 						BlockTime:         pointer.Get[int64](1663315273),
-						PreviousBlockhash: "2ChS54SSzba9zFqsbQJviVkmXXN7zdpMRXPjvzY93cfX",
-						Blockhash:         "5GLYVkvcF7ygCQpFVbeZsaCcSBaw9qofJjdtzwVXYPu1",
+						PreviousBlockhash: common.MustParseHash("2ChS54SSzba9zFqsbQJviVkmXXN7zdpMRXPjvzY93cfX"),
+						Blockhash:         common.MustParseHash("5GLYVkvcF7ygCQpFVbeZsaCcSBaw9qofJjdtzwVXYPu1"),
 						Transactions: []GetBlockTransaction{
 							{
 								Meta: &TransactionMeta{
@@ -591,8 +592,8 @@ func TestGetBlock(t *testing.T) {
 						ParentSlot:        236,
 						BlockHeight:       pointer.Get[int64](237),
 						BlockTime:         pointer.Get[int64](1666899961),
-						PreviousBlockhash: "DhaQz2FuLkredqU2VGBkoUVZzk4QTCskDezYZS7ua9rE",
-						Blockhash:         "EdSZ7r12ZipGivMX4NDpFUT9pCmDkCPFHQyWwLSB2Wmv",
+						PreviousBlockhash: common.MustParseHash("DhaQz2FuLkredqU2VGBkoUVZzk4QTCskDezYZS7ua9rE"),
+						Blockhash:         common.MustParseHash("EdSZ7r12ZipGivMX4NDpFUT9pCmDkCPFHQyWwLSB2Wmv"),
 						Rewards: []Reward{
 							{
 								Pubkey:       "D7SRyWHVRfZngyzUciWJXNg6qPRV1Ga5ickasA3RKWbs",
@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"context"
+
+	"github.com/blocto/solana-go-sdk/common"
 )
 
 type GetLatestBlockhashResponse JsonRpcResponse[GetLatestBlockhash]
@@ -10,8 +12,8 @@ type GetLatestBlockhash ValueWithContext[GetLatestBlockhashValue]
 
 // GetLatestBlockhashResult is a part of raw rpc response of `getLatestBlockhash`
 type GetLatestBlockhashValue struct {
-	Blockhash              string `json:"blockhash"`
-	LatestValidBlockHeight uint64 `json:"lastValidBlockHeight"`
+	Blockhash              common.Hash `json:"blockhash"`
+	LatestValidBlockHeight uint64      `json:"lastValidBlockHeight"`
 }
 
 // GetLatestBlockhashConfig is a option config for `getLatestBlockhash`
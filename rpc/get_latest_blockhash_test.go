@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/blocto/solana-go-sdk/common"
 	"github.com/blocto/solana-go-sdk/internal/client_test"
 )
 
@@ -27,7 +28,7 @@ func TestGetLatestBlockhash(t *testing.T) {
 							Slot: 112872139,
 						},
 						Value: GetLatestBlockhashValue{
-							Blockhash:              "9K9GnvWXn9zYitQdHUSYzvjLjebnviwEFaWgWqHDU3ve",
+							Blockhash:              common.MustParseHash("9K9GnvWXn9zYitQdHUSYzvjLjebnviwEFaWgWqHDU3ve"),
 							LatestValidBlockHeight: 92248597,
 						},
 					},
@@ -50,7 +51,7 @@ func TestGetLatestBlockhash(t *testing.T) {
 							Slot: 112871314,
 						},
 						Value: GetLatestBlockhashValue{
-							Blockhash:              "3H2pwJD6pTrEveh5xcwHXToLn7txt5uTW6CPzCan4ZKL",
+							Blockhash:              common.MustParseHash("3H2pwJD6pTrEveh5xcwHXToLn7txt5uTW6CPzCan4ZKL"),
 							LatestValidBlockHeight: 92247902,
 						},
 					},
@@ -73,7 +74,7 @@ func TestGetLatestBlockhash(t *testing.T) {
 							Slot: 112871311,
 						},
 						Value: GetLatestBlockhashValue{
-							Blockhash:              "FXuaK93DmxWt98bv3wYMdE3TMnY2o8e3h85KrGWEUAzv",
+							Blockhash:              common.MustParseHash("FXuaK93DmxWt98bv3wYMdE3TMnY2o8e3h85KrGWEUAzv"),
 							LatestValidBlockHeight: 92247899,
 						},
 					},
@@ -96,7 +97,7 @@ func TestGetLatestBlockhash(t *testing.T) {
 							Slot: 112871221,
 						},
 						Value: GetLatestBlockhashValue{
-							Blockhash:              "21f41sJRvMV8Tc3R5bTTA3n3yBLuoocSkgb8zj1vmEJa",
+							Blockhash:              common.MustParseHash("21f41sJRvMV8Tc3R5bTTA3n3yBLuoocSkgb8zj1vmEJa"),
 							LatestValidBlockHeight: 92247838,
 						},
 					},
@@ -13,9 +13,10 @@ type GetMultipleAccountsConfigEncoding string
 
 // GetMultipleAccountsConfig is an option config for `getAccountInfo`
 type GetMultipleAccountsConfig struct {
-	Commitment Commitment      `json:"commitment,omitempty"`
-	Encoding   AccountEncoding `json:"encoding,omitempty"`
-	DataSlice  *DataSlice      `json:"dataSlice,omitempty"`
+	Commitment     Commitment      `json:"commitment,omitempty"`
+	Encoding       AccountEncoding `json:"encoding,omitempty"`
+	DataSlice      *DataSlice      `json:"dataSlice,omitempty"`
+	MinContextSlot *uint64         `json:"minContextSlot,omitempty"`
 }
 
 // GetMultipleAccounts returns all information associated with the account of provided Pubkey
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/blocto/solana-go-sdk/internal/client_test"
+	"github.com/blocto/solana-go-sdk/pkg/pointer"
 )
 
 func TestGetMultipleAccounts(t *testing.T) {
@@ -296,6 +297,33 @@ func TestGetMultipleAccounts(t *testing.T) {
 				},
 				ExpectedError: nil,
 			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0", "id":1, "method":"getMultipleAccounts", "params":[["F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb"], {"encoding": "base64", "minContextSlot": 77317718}]}`,
+				ResponseBody: `{"jsonrpc":"2.0","result":{"context":{"slot":77317718},"value":[null]},"id":1}`,
+				F: func(url string) (any, error) {
+					c := NewRpcClient(url)
+					return c.GetMultipleAccountsWithConfig(
+						context.Background(),
+						[]string{"F5RYi7FMPefkc7okJNh21Hcsch7RUaLVr8Rzc8SQqxUb"},
+						GetMultipleAccountsConfig{
+							Encoding:       AccountEncodingBase64,
+							MinContextSlot: pointer.Get[uint64](77317718),
+						},
+					)
+				},
+				ExpectedValue: JsonRpcResponse[ValueWithContext[[]AccountInfo]]{
+					JsonRpc: "2.0",
+					Id:      1,
+					Error:   nil,
+					Result: ValueWithContext[[]AccountInfo]{
+						Context: Context{
+							Slot: 77317718,
+						},
+						Value: []AccountInfo{{}},
+					},
+				},
+				ExpectedError: nil,
+			},
 		},
 	)
 }
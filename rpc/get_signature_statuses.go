@@ -9,10 +9,10 @@ type GetSignatureStatusesResponse JsonRpcResponse[GetSignatureStatuses]
 type GetSignatureStatuses ValueWithContext[SignatureStatuses]
 
 type SignatureStatus struct {
-	Slot               uint64      `json:"slot"`
-	Confirmations      *uint64     `json:"confirmations"`
-	ConfirmationStatus *Commitment `json:"confirmationStatus"`
-	Err                any         `json:"err"`
+	Slot               uint64            `json:"slot"`
+	Confirmations      *uint64           `json:"confirmations"`
+	ConfirmationStatus *Commitment       `json:"confirmationStatus"`
+	Err                *TransactionError `json:"err"`
 }
 
 type SignatureStatuses []*SignatureStatus
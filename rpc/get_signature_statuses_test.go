@@ -144,13 +144,10 @@ func TestGetSignatureStatuses(t *testing.T) {
 								Slot:               85500622,
 								Confirmations:      nil,
 								ConfirmationStatus: (*Commitment)(pointer.Get(string(CommitmentFinalized))),
-								Err: map[string]any{
-									"InstructionError": []any{
-										0.,
-										map[string]any{
-											"Custom": 1.,
-										},
-									},
+								Err: &TransactionError{
+									Kind:             TransactionErrorInstructionError,
+									InstructionIndex: 0,
+									InstructionError: &InstructionError{Kind: InstructionErrorCustom, Code: 1},
 								},
 							},
 						},
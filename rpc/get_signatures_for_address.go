@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"context"
+
+	"github.com/blocto/solana-go-sdk/common"
 )
 
 type GetSignaturesForAddressResponse JsonRpcResponse[GetSignaturesForAddress]
@@ -9,11 +11,11 @@ type GetSignaturesForAddressResponse JsonRpcResponse[GetSignaturesForAddress]
 type GetSignaturesForAddress []SignatureWithStatus
 
 type SignatureWithStatus struct {
-	Signature string  `json:"signature"`
-	Slot      uint64  `json:"slot"`
-	BlockTime *int64  `json:"blockTime"`
-	Err       any     `json:"err"`
-	Memo      *string `json:"memo"`
+	Signature common.Signature  `json:"signature"`
+	Slot      uint64            `json:"slot"`
+	BlockTime *int64            `json:"blockTime"`
+	Err       *TransactionError `json:"err"`
+	Memo      *string           `json:"memo"`
 }
 
 // GetSignaturesForAddressConfig is option config of `getSignaturesForAddress`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/blocto/solana-go-sdk/common"
 	"github.com/blocto/solana-go-sdk/internal/client_test"
 	"github.com/blocto/solana-go-sdk/pkg/pointer"
 )
@@ -28,27 +29,27 @@ func TestGetSignaturesForAddress(t *testing.T) {
 					Error:   nil,
 					Result: GetSignaturesForAddress{
 						{
-							Signature: "26UNKcerqcmHhRgFqtvtWUJZod91dGpkkAtRzKewvsZSoah33VZiFgKPmnHmMhBwsHT4bQjKdCgH88Faex5WkChh",
+							Signature: common.MustParseSignature("26UNKcerqcmHhRgFqtvtWUJZod91dGpkkAtRzKewvsZSoah33VZiFgKPmnHmMhBwsHT4bQjKdCgH88Faex5WkChh"),
 							Slot:      63372,
 							BlockTime: pointer.Get[int64](1633580920),
 						},
 						{
-							Signature: "vB73C8nWXv2ZwLjCRhTQXnkqqKDafE9uWyycQqajFzQcNFzjRUYq8ZqmtCm4qnwpGxXJWbkkRuyhiQ26zEpfk28",
+							Signature: common.MustParseSignature("vB73C8nWXv2ZwLjCRhTQXnkqqKDafE9uWyycQqajFzQcNFzjRUYq8ZqmtCm4qnwpGxXJWbkkRuyhiQ26zEpfk28"),
 							Slot:      63370,
 							BlockTime: pointer.Get[int64](1633580919),
 						},
 						{
-							Signature: "4ZAtdQ7wF8EPXVf43ZpyqMiKqpaiGppysSxQ54X31Q92ht1pKgQymiJwRnUc3h4cUjRJfFrVprNJhW6UfybJMAQP",
+							Signature: common.MustParseSignature("4ZAtdQ7wF8EPXVf43ZpyqMiKqpaiGppysSxQ54X31Q92ht1pKgQymiJwRnUc3h4cUjRJfFrVprNJhW6UfybJMAQP"),
 							Slot:      63369,
 							BlockTime: pointer.Get[int64](1633580918),
 						},
 						{
-							Signature: "63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y",
+							Signature: common.MustParseSignature("63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y"),
 							Slot:      63367,
 							BlockTime: pointer.Get[int64](1633580916),
 						},
 						{
-							Signature: "pxS5UZhzvk8p5qiKonkAeVBjP1ipujERPMMNZ2ZexK1PyU8RvZxQwYjiv9YJYP4CpRrHAxTET2rNZ6LNf4aYvDN",
+							Signature: common.MustParseSignature("pxS5UZhzvk8p5qiKonkAeVBjP1ipujERPMMNZ2ZexK1PyU8RvZxQwYjiv9YJYP4CpRrHAxTET2rNZ6LNf4aYvDN"),
 							Slot:      63365,
 							BlockTime: pointer.Get[int64](1633580915),
 						},
@@ -76,7 +77,7 @@ func TestGetSignaturesForAddress(t *testing.T) {
 					Error:   nil,
 					Result: GetSignaturesForAddress{
 						{
-							Signature: "66z7UgyzEozBQ1moxc2ThzGtzbwETZ9bR5ExSUubWhQzqGuX1hQgCGSV1n22o96yuDCFY2dHeMNNLDnf6zjewy7C",
+							Signature: common.MustParseSignature("66z7UgyzEozBQ1moxc2ThzGtzbwETZ9bR5ExSUubWhQzqGuX1hQgCGSV1n22o96yuDCFY2dHeMNNLDnf6zjewy7C"),
 							Slot:      64265,
 							BlockTime: pointer.Get[int64](1633581563),
 						},
@@ -103,12 +104,12 @@ func TestGetSignaturesForAddress(t *testing.T) {
 					Error:   nil,
 					Result: GetSignaturesForAddress{
 						{
-							Signature: "66z7UgyzEozBQ1moxc2ThzGtzbwETZ9bR5ExSUubWhQzqGuX1hQgCGSV1n22o96yuDCFY2dHeMNNLDnf6zjewy7C",
+							Signature: common.MustParseSignature("66z7UgyzEozBQ1moxc2ThzGtzbwETZ9bR5ExSUubWhQzqGuX1hQgCGSV1n22o96yuDCFY2dHeMNNLDnf6zjewy7C"),
 							Slot:      64265,
 							BlockTime: pointer.Get[int64](1633581563),
 						},
 						{
-							Signature: "26UNKcerqcmHhRgFqtvtWUJZod91dGpkkAtRzKewvsZSoah33VZiFgKPmnHmMhBwsHT4bQjKdCgH88Faex5WkChh",
+							Signature: common.MustParseSignature("26UNKcerqcmHhRgFqtvtWUJZod91dGpkkAtRzKewvsZSoah33VZiFgKPmnHmMhBwsHT4bQjKdCgH88Faex5WkChh"),
 							Slot:      63372,
 							BlockTime: pointer.Get[int64](1633580920),
 						},
@@ -135,12 +136,12 @@ func TestGetSignaturesForAddress(t *testing.T) {
 					Error:   nil,
 					Result: GetSignaturesForAddress{
 						{
-							Signature: "63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y",
+							Signature: common.MustParseSignature("63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y"),
 							Slot:      63367,
 							BlockTime: pointer.Get[int64](1633580916),
 						},
 						{
-							Signature: "pxS5UZhzvk8p5qiKonkAeVBjP1ipujERPMMNZ2ZexK1PyU8RvZxQwYjiv9YJYP4CpRrHAxTET2rNZ6LNf4aYvDN",
+							Signature: common.MustParseSignature("pxS5UZhzvk8p5qiKonkAeVBjP1ipujERPMMNZ2ZexK1PyU8RvZxQwYjiv9YJYP4CpRrHAxTET2rNZ6LNf4aYvDN"),
 							Slot:      63365,
 							BlockTime: pointer.Get[int64](1633580915),
 						},
@@ -168,7 +169,7 @@ func TestGetSignaturesForAddress(t *testing.T) {
 					Error:   nil,
 					Result: GetSignaturesForAddress{
 						{
-							Signature: "63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y",
+							Signature: common.MustParseSignature("63BWysCcY6CcVT9G4FrfD1XuZ2DUswSTuJM5jeULUWwgp3BJhMnAStH3gTkUPXeUEjYoQhVNfd61RxxxWwsezi8y"),
 							Slot:      63367,
 							BlockTime: pointer.Get[int64](1633580916),
 						},
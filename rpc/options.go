@@ -1,7 +1,9 @@
 package rpc
 
 import (
+	"math"
 	"net/http"
+	"time"
 )
 
 // Option is a configuration type for the Client
@@ -19,6 +21,7 @@ func WithHTTPClient(h *http.Client) Option {
 func WithEndpoint(endpoint string) Option {
 	return func(r *RpcClient) {
 		r.endpoint = endpoint
+		r.endpoints = nil
 	}
 }
 
@@ -26,3 +29,53 @@ func setDefaultOptions(r *RpcClient) {
 	r.httpClient = &http.Client{}
 	r.endpoint = MainnetRPCEndpoint
 }
+
+// WithRetry is an Option that retries a request with exponential backoff when it fails with 429, 5xx
+// or a network error. a Retry-After header, capped at maxBackoff, is used instead of the backoff when the server sends one.
+func WithRetry(maxRetries int, minBackoff, maxBackoff time.Duration) Option {
+	return func(r *RpcClient) {
+		if minBackoff <= 0 {
+			minBackoff = defaultRetryMinBackoff
+		}
+		if maxBackoff < minBackoff {
+			maxBackoff = minBackoff
+		}
+		r.retry = &retryConfig{
+			maxRetries: maxRetries,
+			minBackoff: minBackoff,
+			maxBackoff: maxBackoff,
+		}
+	}
+}
+
+// WithRateLimit is an Option that limits the requests the client sends by a token bucket.
+// a rate which isn't a positive finite number is ignored.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(r *RpcClient) {
+		if !(requestsPerSecond > 0) || math.IsInf(requestsPerSecond, 1) {
+			return
+		}
+		r.rateLimiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// WithEndpoints is an Option that configures several rpc endpoints. requests go to the first healthy
+// one and fail over to the next one when it fails with 429, 5xx or a network error. a failed endpoint
+// is skipped until its cooldown (default: 30s) passes.
+func WithEndpoints(endpoints ...string) Option {
+	return func(r *RpcClient) {
+		if len(endpoints) == 0 {
+			return
+		}
+		r.endpoint = endpoints[0]
+		r.endpoints = newEndpointPool(append([]string{}, endpoints...), defaultEndpointCooldown)
+	}
+}
+
+// WithEndpointCooldown is an Option that sets how long a failed endpoint is skipped. it takes effect
+// with WithEndpoints.
+func WithEndpointCooldown(d time.Duration) Option {
+	return func(r *RpcClient) {
+		r.endpointCooldown = d
+	}
+}
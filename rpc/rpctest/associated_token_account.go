@@ -0,0 +1,111 @@
+package rpctest
+
+import (
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/associated_token_account"
+	"github.com/blocto/solana-go-sdk/program/token"
+)
+
+// custom errors of the associated token account program
+const (
+	ataErrInvalidOwner uint32 = 0
+)
+
+func processAssociatedTokenAccount(in *invocation) any {
+	idempotent := false
+	if len(in.data) > 0 {
+		switch associated_token_account.Instruction(in.data[0]) {
+		case associated_token_account.InstructionCreate:
+		case associated_token_account.InstructionCreateIdempotent:
+			idempotent = true
+		default:
+			in.log("Program log: rpctest: the instruction is not supported")
+			return errInvalidInstructionData
+		}
+	}
+	if idempotent {
+		in.log("Program log: CreateIdempotent")
+	} else {
+		in.log("Program log: Create")
+	}
+
+	tokenProgramID, err := in.pubkey(5)
+	if err != nil {
+		return err
+	}
+	if !isTokenProgram(tokenProgramID) {
+		return errIncorrectProgramId
+	}
+	ataPubkey, ata, _, err := in.load(1)
+	if err != nil {
+		return err
+	}
+	wallet, err := in.pubkey(2)
+	if err != nil {
+		return err
+	}
+	mintPubkey, mint, _, err := in.load(3)
+	if err != nil {
+		return err
+	}
+	expected, _, err2 := common.FindAssociatedTokenAddress(wallet, mintPubkey, tokenProgramID)
+	if err2 != nil || expected != ataPubkey {
+		in.log("Program log: Error: Associated address does not match seed derivation")
+		return errInvalidSeeds
+	}
+
+	// an account the system program owns without data is only prefunded
+	if ata.Owner != common.SystemProgramID || len(ata.Data) > 0 {
+		if !idempotent {
+			in.log("Create Account: account %v already in use", ataPubkey)
+			return customError(systemErrAccountAlreadyInUse)
+		}
+		tokenAccount, ok := parseTokenAccount(ata)
+		if ata.Owner != tokenProgramID || !ok || tokenAccount.Owner != wallet || tokenAccount.Mint != mintPubkey {
+			return customError(ataErrInvalidOwner)
+		}
+		return nil
+	}
+	if mint.Owner != tokenProgramID {
+		return errIncorrectProgramId
+	}
+	if _, ok := parseMint(mint); !ok {
+		return errInvalidAccountData
+	}
+
+	size := uint64(token.TokenAccountSize)
+	if tokenProgramID == common.Token2022ProgramID {
+		// the account type and an empty ImmutableOwner entry
+		size = token.Token2022AccountSizeA
+	}
+	rent := MinimumBalanceForRentExemption(size)
+	in.log("Program log: Initialize the associated token account")
+
+	if ata.Lamports < rent {
+		if !in.isSigner(0) {
+			return errMissingRequiredSignature
+		}
+		in.log("Program %v invoke [2]", common.SystemProgramID)
+		if err := systemDebit(in, 0, rent-ata.Lamports); err != nil {
+			in.log("Program %v failed: %v", common.SystemProgramID, describeInstructionError(err))
+			return err
+		}
+		in.log("Program %v success", common.SystemProgramID)
+		ata.Lamports = rent
+	}
+
+	in.log("Program %v invoke [2]", tokenProgramID)
+	in.log("Program log: Instruction: InitializeAccount3")
+	ata.Owner = tokenProgramID
+	ata.Data = make([]byte, size)
+	initializeTokenAccountData(ata.Data, mintPubkey, wallet)
+	if tokenProgramID == common.Token2022ProgramID {
+		// tlv entry: ImmutableOwner with no value
+		ata.Data[token.TokenAccountSize+1] = byte(token.ExtensionTypeImmutableOwner)
+	}
+	if isNativeMint(tokenProgramID, mintPubkey) {
+		putNative(ata.Data, rent, ata.Lamports-rent)
+	}
+	in.log("Program %v success", tokenProgramID)
+	return in.store(1, ata)
+}
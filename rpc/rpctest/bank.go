@@ -0,0 +1,553 @@
+package rpctest
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/address_lookup_table"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+const (
+	// LamportsPerSignature is the base fee of every signature
+	LamportsPerSignature uint64 = 5000
+
+	faucetLamports          uint64 = 500_000_000 * 1_000_000_000
+	maxProcessingAge               = 150
+	slotsPerEpoch                  = 432_000
+	defaultComputeUnitLimit        = 200_000
+	maxComputeUnitLimit            = 1_400_000
+)
+
+// MinimumBalanceForRentExemption returns the lamports an account with dataLen bytes needs to be rent exempt
+func MinimumBalanceForRentExemption(dataLen uint64) uint64 {
+	// (account storage overhead + data len) * lamports per byte-year * exemption threshold in years
+	return (128 + dataLen) * 3480 * 2
+}
+
+type blockhash struct {
+	hash                 common.Hash
+	lastValidBlockHeight uint64
+}
+
+type transactionRecord struct {
+	signature        string
+	slot             uint64
+	blockTime        int64
+	tx               types.Transaction
+	accountKeys      []common.PublicKey
+	writable         []bool
+	computeUnitPrice uint64
+	meta             rpc.TransactionMeta
+}
+
+func (s *Server) produceBlock() {
+	s.slot++
+	s.blockHeight++
+
+	seed := make([]byte, 0, 40)
+	if len(s.blockhashes) > 0 {
+		latest := s.latestBlockhash().hash
+		seed = append(seed, latest[:]...)
+	}
+	seed = binary.LittleEndian.AppendUint64(seed, s.slot)
+	h := sha256.Sum256(seed)
+
+	s.blockhashes = append(s.blockhashes, blockhash{
+		hash:                 h,
+		lastValidBlockHeight: s.blockHeight + maxProcessingAge,
+	})
+	if len(s.blockhashes) > maxProcessingAge+1 {
+		s.blockhashes = s.blockhashes[1:]
+	}
+	for slot := range s.blocks {
+		if slot+maxProcessingAge < s.slot {
+			delete(s.blocks, slot)
+		}
+	}
+}
+
+func (s *Server) latestBlockhash() blockhash {
+	return s.blockhashes[len(s.blockhashes)-1]
+}
+
+func (s *Server) isBlockhashValid(hash common.Hash) bool {
+	for _, b := range s.blockhashes {
+		if b.hash == hash {
+			return b.lastValidBlockHeight >= s.blockHeight
+		}
+	}
+	return false
+}
+
+// transaction errors in the json form of the node
+const (
+	errAccountNotFound                = "AccountNotFound"
+	errProgramAccountNotFound         = "ProgramAccountNotFound"
+	errInsufficientFundsForFee        = "InsufficientFundsForFee"
+	errAlreadyProcessed               = "AlreadyProcessed"
+	errBlockhashNotFound              = "BlockhashNotFound"
+	errAddressLookupTableNotFound     = "AddressLookupTableNotFound"
+	errInvalidAddressLookupTableOwner = "InvalidAddressLookupTableOwner"
+	errInvalidAddressLookupTableData  = "InvalidAddressLookupTableData"
+	errInvalidAddressLookupTableIndex = "InvalidAddressLookupTableIndex"
+	errInvalidArgument                = "InvalidArgument"
+	errInvalidInstructionData         = "InvalidInstructionData"
+	errInvalidAccountData             = "InvalidAccountData"
+	errIncorrectProgramId             = "IncorrectProgramId"
+	errMissingRequiredSignature       = "MissingRequiredSignature"
+	errNotEnoughAccountKeys           = "NotEnoughAccountKeys"
+	errInvalidSeeds                   = "InvalidSeeds"
+	errReadonlyDataModified           = "ReadonlyDataModified"
+	errComputationalBudgetExceeded    = "ComputationalBudgetExceeded"
+)
+
+func customError(code uint32) any {
+	return map[string]any{"Custom": code}
+}
+
+func instructionError(index int, err any) any {
+	return map[string]any{"InstructionError": []any{index, err}}
+}
+
+// transactionError converts the json form of an error into the one of the rpc results
+func transactionError(err any) *rpc.TransactionError {
+	e, parseErr := rpc.ParseTransactionError(err)
+	if parseErr != nil {
+		panic(fmt.Sprintf("rpctest: invalid transaction error %v, err: %v", err, parseErr))
+	}
+	return e
+}
+
+func describeInstructionError(err any) string {
+	return transactionError(instructionError(0, err)).InstructionError.Error()
+}
+
+// sanitize checks the shape of the transaction, the node rejects a malformed one before anything else
+func sanitize(tx types.Transaction) error {
+	header := tx.Message.Header
+	if header.NumRequireSignatures == 0 || len(tx.Signatures) != int(header.NumRequireSignatures) {
+		return fmt.Errorf("transaction requires %v signatures, got %v", header.NumRequireSignatures, len(tx.Signatures))
+	}
+	if int(header.NumRequireSignatures) > len(tx.Message.Accounts) ||
+		header.NumReadonlySignedAccounts >= header.NumRequireSignatures ||
+		int(header.NumRequireSignatures)+int(header.NumReadonlyUnsignedAccounts) > len(tx.Message.Accounts) {
+		return fmt.Errorf("transaction failed to sanitize accounts offsets correctly")
+	}
+	for _, sig := range tx.Signatures {
+		if len(sig) != ed25519.SignatureSize {
+			return fmt.Errorf("invalid signature length")
+		}
+	}
+
+	total := len(tx.Message.Accounts)
+	for _, table := range tx.Message.AddressLookupTables {
+		total += len(table.WritableIndexes) + len(table.ReadonlyIndexes)
+	}
+	for _, ins := range tx.Message.Instructions {
+		if ins.ProgramIDIndex <= 0 || ins.ProgramIDIndex >= len(tx.Message.Accounts) {
+			return fmt.Errorf("transaction failed to sanitize accounts offsets correctly")
+		}
+		for _, idx := range ins.Accounts {
+			if idx < 0 || idx >= total {
+				return fmt.Errorf("transaction failed to sanitize accounts offsets correctly")
+			}
+		}
+	}
+	return nil
+}
+
+func verifySignatures(tx types.Transaction) bool {
+	message, err := tx.Message.Serialize()
+	if err != nil {
+		return false
+	}
+	for i, sig := range tx.Signatures {
+		if !ed25519.Verify(tx.Message.Accounts[i].Bytes(), message, sig[:]) {
+			return false
+		}
+	}
+	return true
+}
+
+// execution is a transaction run against the accounts of the server. the changes are kept in working
+// until commit.
+type execution struct {
+	server *Server
+
+	tx               types.Transaction
+	signature        string
+	accountKeys      []common.PublicKey
+	loadedAddresses  rpc.TransactionLoadedAddresses
+	signer           []bool
+	writable         []bool
+	fee              uint64
+	computeUnitLimit uint64
+	computeUnitPrice uint64
+	unitsConsumed    uint64
+
+	// landed reports whether the transaction gets into a block, a landed transaction pays the fee
+	// even if it fails
+	landed  bool
+	err     any
+	logs    []string
+	working map[common.PublicKey]Account
+}
+
+func (s *Server) execute(tx types.Transaction) *execution {
+	e := &execution{
+		server:    s,
+		tx:        tx,
+		signature: tx.Signatures[0].String(),
+		logs:      []string{},
+		working:   map[common.PublicKey]Account{},
+	}
+	if err := e.loadAccountKeys(); err != nil {
+		e.err = err
+		return e
+	}
+	e.loadComputeBudget()
+
+	if !s.isBlockhashValid(tx.Message.RecentBlockHash) {
+		e.err = errBlockhashNotFound
+		return e
+	}
+	if _, ok := s.transactions[e.signature]; ok {
+		e.err = errAlreadyProcessed
+		return e
+	}
+	payer, ok := s.accounts[e.accountKeys[0]]
+	if !ok || payer.Lamports == 0 {
+		e.err = errAccountNotFound
+		return e
+	}
+	if payer.Lamports < e.fee {
+		e.err = errInsufficientFundsForFee
+		return e
+	}
+
+	// the fee is paid before the instructions run and stays paid if they fail
+	e.landed = true
+	payer = cloneAccount(payer)
+	payer.Lamports -= e.fee
+	e.working[e.accountKeys[0]] = payer
+	reset := func() {
+		e.working = map[common.PublicKey]Account{e.accountKeys[0]: payer}
+	}
+
+	for _, ins := range tx.Message.Instructions {
+		if _, ok := processors[e.accountKeys[ins.ProgramIDIndex]]; !ok {
+			e.err = errProgramAccountNotFound
+			reset()
+			return e
+		}
+	}
+	for i, ins := range tx.Message.Instructions {
+		if err := e.invoke(ins); err != nil {
+			e.err = instructionError(i, err)
+			reset()
+			return e
+		}
+	}
+	return e
+}
+
+func (e *execution) loadAccountKeys() any {
+	m := e.tx.Message
+	e.accountKeys = append(e.accountKeys, m.Accounts...)
+	e.loadedAddresses = rpc.TransactionLoadedAddresses{Writable: []string{}, Readonly: []string{}}
+
+	var writable, readonly []common.PublicKey
+	for _, lookup := range m.AddressLookupTables {
+		account, ok := e.server.accounts[lookup.AccountKey]
+		if !ok {
+			return errAddressLookupTableNotFound
+		}
+		if account.Owner != common.AddressLookupTableProgramID {
+			return errInvalidAddressLookupTableOwner
+		}
+		table, err := address_lookup_table.DeserializeLookupTable(account.Data, account.Owner)
+		if err != nil {
+			return errInvalidAddressLookupTableData
+		}
+		for _, idx := range lookup.WritableIndexes {
+			if int(idx) >= len(table.Addresses) {
+				return errInvalidAddressLookupTableIndex
+			}
+			writable = append(writable, table.Addresses[idx])
+		}
+		for _, idx := range lookup.ReadonlyIndexes {
+			if int(idx) >= len(table.Addresses) {
+				return errInvalidAddressLookupTableIndex
+			}
+			readonly = append(readonly, table.Addresses[idx])
+		}
+	}
+	e.accountKeys = append(e.accountKeys, writable...)
+	e.accountKeys = append(e.accountKeys, readonly...)
+	for _, pubkey := range writable {
+		e.loadedAddresses.Writable = append(e.loadedAddresses.Writable, pubkey.ToBase58())
+	}
+	for _, pubkey := range readonly {
+		e.loadedAddresses.Readonly = append(e.loadedAddresses.Readonly, pubkey.ToBase58())
+	}
+
+	numSigners := int(m.Header.NumRequireSignatures)
+	numStatic := len(m.Accounts)
+	e.signer = make([]bool, len(e.accountKeys))
+	e.writable = make([]bool, len(e.accountKeys))
+	for i := range e.accountKeys {
+		switch {
+		case i < numSigners:
+			e.signer[i] = true
+			e.writable[i] = i < numSigners-int(m.Header.NumReadonlySignedAccounts)
+		case i < numStatic:
+			e.writable[i] = i < numStatic-int(m.Header.NumReadonlyUnsignedAccounts)
+		default:
+			e.writable[i] = i < numStatic+len(writable)
+		}
+	}
+	return nil
+}
+
+func (e *execution) loadComputeBudget() {
+	var limit *uint64
+	count := uint64(0)
+	for _, ins := range e.tx.Message.Instructions {
+		if e.accountKeys[ins.ProgramIDIndex] != common.ComputeBudgetProgramID {
+			count++
+			continue
+		}
+		switch {
+		case len(ins.Data) == 5 && ins.Data[0] == 2:
+			v := uint64(binary.LittleEndian.Uint32(ins.Data[1:]))
+			limit = &v
+		case len(ins.Data) == 9 && ins.Data[0] == 3:
+			e.computeUnitPrice = binary.LittleEndian.Uint64(ins.Data[1:])
+		}
+	}
+
+	e.computeUnitLimit = count * defaultComputeUnitLimit
+	if limit != nil {
+		e.computeUnitLimit = *limit
+	}
+	if e.computeUnitLimit > maxComputeUnitLimit {
+		e.computeUnitLimit = maxComputeUnitLimit
+	}
+
+	// the priority fee is the price in micro-lamports times the limit, rounded up
+	priorityFee := (e.computeUnitPrice*e.computeUnitLimit + 999_999) / 1_000_000
+	e.fee = LamportsPerSignature*uint64(e.tx.Message.Header.NumRequireSignatures) + priorityFee
+}
+
+// account returns a copy of the account as seen by the execution so far
+func (e *execution) account(pubkey common.PublicKey) (Account, bool) {
+	if account, ok := e.working[pubkey]; ok {
+		return cloneAccount(account), account.Lamports > 0 || len(account.Data) > 0
+	}
+	if account, ok := e.server.accounts[pubkey]; ok {
+		return cloneAccount(account), true
+	}
+	return Account{Owner: common.SystemProgramID}, false
+}
+
+func (e *execution) invoke(ins types.CompiledInstruction) any {
+	programID := e.accountKeys[ins.ProgramIDIndex]
+	in := &invocation{
+		exec:      e,
+		programID: programID,
+		accounts:  ins.Accounts,
+		data:      ins.Data,
+	}
+
+	cost, builtin := programCosts[programID], programID == common.SystemProgramID || programID == common.ComputeBudgetProgramID
+	remaining := e.computeUnitLimit - e.unitsConsumed
+	in.log("Program %v invoke [1]", programID)
+	if cost > remaining {
+		e.unitsConsumed = e.computeUnitLimit
+		if !builtin {
+			in.log("Program %v consumed %v of %v compute units", programID, remaining, remaining)
+		}
+		in.log("Program %v failed: exceeded CUs meter at BPF instruction", programID)
+		return errComputationalBudgetExceeded
+	}
+
+	err := processors[programID](in)
+	e.unitsConsumed += cost
+	if !builtin {
+		in.log("Program %v consumed %v of %v compute units", programID, cost, remaining)
+	}
+	if err != nil {
+		in.log("Program %v failed: %v", programID, describeInstructionError(err))
+		return err
+	}
+	in.log("Program %v success", programID)
+	return nil
+}
+
+func (s *Server) commit(e *execution) {
+	if !e.landed {
+		return
+	}
+
+	preBalances, preTokenBalances := s.balances(e.accountKeys)
+	for pubkey, account := range e.working {
+		if account.Lamports == 0 {
+			delete(s.accounts, pubkey)
+			continue
+		}
+		s.accounts[pubkey] = account
+	}
+	postBalances, postTokenBalances := s.balances(e.accountKeys)
+
+	unitsConsumed := e.unitsConsumed
+	record := &transactionRecord{
+		signature:        e.signature,
+		slot:             s.slot,
+		blockTime:        time.Now().Unix(),
+		tx:               e.tx,
+		accountKeys:      e.accountKeys,
+		writable:         e.writable,
+		computeUnitPrice: e.computeUnitPrice,
+		meta: rpc.TransactionMeta{
+			Err:                  transactionError(e.err),
+			Fee:                  e.fee,
+			PreBalances:          preBalances,
+			PostBalances:         postBalances,
+			PreTokenBalances:     preTokenBalances,
+			PostTokenBalances:    postTokenBalances,
+			Rewards:              []rpc.Reward{},
+			LogMessages:          e.logs,
+			InnerInstructions:    []rpc.TransactionMetaInnerInstruction{},
+			LoadedAddresses:      e.loadedAddresses,
+			ComputeUnitsConsumed: &unitsConsumed,
+		},
+	}
+	s.transactions[e.signature] = record
+	s.signatures = append(s.signatures, e.signature)
+	s.blocks[s.slot] = record
+	s.produceBlock()
+}
+
+// balances returns the lamports and the token balances of the account keys
+func (s *Server) balances(accountKeys []common.PublicKey) ([]int64, []rpc.TransactionMetaTokenBalance) {
+	lamports := make([]int64, 0, len(accountKeys))
+	tokenBalances := []rpc.TransactionMetaTokenBalance{}
+	for i, pubkey := range accountKeys {
+		account, ok := s.accounts[pubkey]
+		lamports = append(lamports, int64(account.Lamports))
+		if !ok {
+			continue
+		}
+		tokenAccount, ok := parseTokenAccount(account)
+		if !ok {
+			continue
+		}
+		mint := s.accounts[tokenAccount.Mint]
+		decimals := uint8(0)
+		if m, ok := parseMint(mint); ok {
+			decimals = m.Decimals
+		}
+		tokenBalances = append(tokenBalances, rpc.TransactionMetaTokenBalance{
+			AccountIndex:  uint64(i),
+			Mint:          tokenAccount.Mint.ToBase58(),
+			Owner:         tokenAccount.Owner.ToBase58(),
+			ProgramId:     account.Owner.ToBase58(),
+			UITokenAmount: tokenAmount(tokenAccount.Amount, decimals),
+		})
+	}
+	return lamports, tokenBalances
+}
+
+// sortedAccounts returns the pubkeys of the accounts in a stable order
+func (s *Server) sortedAccounts() []common.PublicKey {
+	pubkeys := make([]common.PublicKey, 0, len(s.accounts))
+	for pubkey := range s.accounts {
+		pubkeys = append(pubkeys, pubkey)
+	}
+	sort.Slice(pubkeys, func(i, j int) bool {
+		return pubkeys[i].ToBase58() < pubkeys[j].ToBase58()
+	})
+	return pubkeys
+}
+
+// invocation is an instruction being processed
+type invocation struct {
+	exec      *execution
+	programID common.PublicKey
+	accounts  []int
+	data      []byte
+}
+
+func (in *invocation) pubkey(i int) (common.PublicKey, any) {
+	if i >= len(in.accounts) {
+		return common.PublicKey{}, errNotEnoughAccountKeys
+	}
+	return in.exec.accountKeys[in.accounts[i]], nil
+}
+
+// load returns the i-th account of the instruction and whether it exists
+func (in *invocation) load(i int) (common.PublicKey, Account, bool, any) {
+	pubkey, err := in.pubkey(i)
+	if err != nil {
+		return common.PublicKey{}, Account{}, false, err
+	}
+	account, ok := in.exec.account(pubkey)
+	return pubkey, account, ok, nil
+}
+
+func (in *invocation) store(i int, account Account) any {
+	pubkey, err := in.pubkey(i)
+	if err != nil {
+		return err
+	}
+	if !in.exec.writable[in.accounts[i]] {
+		return errReadonlyDataModified
+	}
+	in.exec.working[pubkey] = account
+	return nil
+}
+
+func (in *invocation) isSigner(i int) bool {
+	return i < len(in.accounts) && in.exec.signer[in.accounts[i]]
+}
+
+func (in *invocation) log(format string, args ...any) {
+	in.exec.logs = append(in.exec.logs, fmt.Sprintf(format, args...))
+}
+
+type processor func(in *invocation) any
+
+var processors = map[common.PublicKey]processor{
+	common.SystemProgramID:                    processSystem,
+	common.ComputeBudgetProgramID:             func(in *invocation) any { return nil },
+	common.MemoProgramID:                      processMemo,
+	common.TokenProgramID:                     processToken,
+	common.Token2022ProgramID:                 processToken,
+	common.SPLAssociatedTokenAccountProgramID: processAssociatedTokenAccount,
+}
+
+var programCosts = map[common.PublicKey]uint64{
+	common.SystemProgramID:                    150,
+	common.ComputeBudgetProgramID:             150,
+	common.MemoProgramID:                      1_000,
+	common.TokenProgramID:                     4_500,
+	common.Token2022ProgramID:                 6_000,
+	common.SPLAssociatedTokenAccountProgramID: 25_000,
+}
+
+func processMemo(in *invocation) any {
+	for i := range in.accounts {
+		if !in.isSigner(i) {
+			return errMissingRequiredSignature
+		}
+	}
+	in.log("Program log: Memo (len %v): %q", len(in.data), string(in.data))
+	return nil
+}
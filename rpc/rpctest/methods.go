@@ -0,0 +1,776 @@
+package rpctest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/mr-tron/base58"
+)
+
+type handler func(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError)
+
+var handlers = map[string]handler{
+	"getAccountInfo":                    getAccountInfo,
+	"getBalance":                        getBalance,
+	"getBlockHeight":                    getBlockHeight,
+	"getEpochInfo":                      getEpochInfo,
+	"getFeeForMessage":                  getFeeForMessage,
+	"getGenesisHash":                    getGenesisHash,
+	"getHealth":                         getHealth,
+	"getLatestBlockhash":                getLatestBlockhash,
+	"getMinimumBalanceForRentExemption": getMinimumBalanceForRentExemption,
+	"getMultipleAccounts":               getMultipleAccounts,
+	"getProgramAccounts":                getProgramAccounts,
+	"getRecentPrioritizationFees":       getRecentPrioritizationFees,
+	"getSignatureStatuses":              getSignatureStatuses,
+	"getSignaturesForAddress":           getSignaturesForAddress,
+	"getSlot":                           getSlot,
+	"getTokenAccountBalance":            getTokenAccountBalance,
+	"getTokenAccountsByOwner":           getTokenAccountsByOwner,
+	"getTokenSupply":                    getTokenSupply,
+	"getTransaction":                    getTransaction,
+	"getTransactionCount":               getTransactionCount,
+	"getVersion":                        getVersion,
+	"isBlockhashValid":                  isBlockhashValid,
+	"requestAirdrop":                    requestAirdrop,
+	"sendTransaction":                   sendTransaction,
+	"simulateTransaction":               simulateTransaction,
+}
+
+func (s *Server) context() rpc.Context {
+	return rpc.Context{Slot: s.slot}
+}
+
+func parsePublicKey(s string) (common.PublicKey, *rpc.JsonRpcError) {
+	b, err := base58.Decode(s)
+	if err != nil || len(b) != common.PublicKeyLength {
+		return common.PublicKey{}, invalidParams("Invalid public key: %v", s)
+	}
+	return common.PublicKeyFromBytes(b), nil
+}
+
+func encodeAccount(account Account, encoding rpc.AccountEncoding, dataSlice *rpc.DataSlice) (*rpc.AccountInfo, *rpc.JsonRpcError) {
+	data := account.Data
+	if dataSlice != nil {
+		start, end := dataSlice.Offset, dataSlice.Offset+dataSlice.Length
+		if start > uint64(len(data)) {
+			start = uint64(len(data))
+		}
+		if end > uint64(len(data)) {
+			end = uint64(len(data))
+		}
+		data = data[start:end]
+	}
+
+	info := &rpc.AccountInfo{
+		Lamports:   account.Lamports,
+		Owner:      account.Owner.ToBase58(),
+		RentEpoch:  account.RentEpoch,
+		Executable: account.Executable,
+	}
+	switch encoding {
+	case "", rpc.AccountEncodingBase58:
+		info.Data = []any{base58.Encode(data), string(rpc.AccountEncodingBase58)}
+	// the node falls back to base64 if an account can't be parsed
+	case rpc.AccountEncodingBase64, rpc.AccountEncodingJsonParsed:
+		info.Data = []any{base64.StdEncoding.EncodeToString(data), string(rpc.AccountEncodingBase64)}
+	default:
+		return nil, invalidParams("unsupported encoding: %v", encoding)
+	}
+	return info, nil
+}
+
+func getAccountInfo(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addr string
+	var cfg rpc.GetAccountInfoConfig
+	if err := param(params, 0, &addr, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &cfg, false); err != nil {
+		return nil, err
+	}
+	pubkey, err := parsePublicKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var value *rpc.AccountInfo
+	if account, ok := s.accounts[pubkey]; ok {
+		if value, err = encodeAccount(account, cfg.Encoding, cfg.DataSlice); err != nil {
+			return nil, err
+		}
+	}
+	return rpc.ValueWithContext[*rpc.AccountInfo]{Context: s.context(), Value: value}, nil
+}
+
+func getMultipleAccounts(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addrs []string
+	var cfg rpc.GetMultipleAccountsConfig
+	if err := param(params, 0, &addrs, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &cfg, false); err != nil {
+		return nil, err
+	}
+	if len(addrs) > MaxMultipleAccounts {
+		return nil, invalidParams("Too many inputs provided; max %v", MaxMultipleAccounts)
+	}
+	if cfg.MinContextSlot != nil && *cfg.MinContextSlot > s.slot {
+		return nil, newError(ErrCodeMinContextSlotNotReached, "Minimum context slot has not been reached")
+	}
+
+	values := make([]*rpc.AccountInfo, 0, len(addrs))
+	for _, addr := range addrs {
+		pubkey, err := parsePublicKey(addr)
+		if err != nil {
+			return nil, err
+		}
+		var value *rpc.AccountInfo
+		if account, ok := s.accounts[pubkey]; ok {
+			if value, err = encodeAccount(account, cfg.Encoding, cfg.DataSlice); err != nil {
+				return nil, err
+			}
+		}
+		values = append(values, value)
+	}
+	return rpc.ValueWithContext[[]*rpc.AccountInfo]{Context: s.context(), Value: values}, nil
+}
+
+func getBalance(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addr string
+	if err := param(params, 0, &addr, true); err != nil {
+		return nil, err
+	}
+	pubkey, err := parsePublicKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	return rpc.ValueWithContext[uint64]{Context: s.context(), Value: s.accounts[pubkey].Lamports}, nil
+}
+
+func getBlockHeight(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	return s.blockHeight, nil
+}
+
+func getSlot(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	return s.slot, nil
+}
+
+func getTransactionCount(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	return uint64(len(s.signatures)), nil
+}
+
+func getEpochInfo(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	transactionCount := uint64(len(s.signatures))
+	return rpc.GetEpochInfo{
+		AbsoluteSlot:     s.slot,
+		BlockHeight:      s.blockHeight,
+		Epoch:            s.slot / slotsPerEpoch,
+		SlotIndex:        s.slot % slotsPerEpoch,
+		SlotsInEpoch:     slotsPerEpoch,
+		TransactionCount: &transactionCount,
+	}, nil
+}
+
+func getGenesisHash(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	return s.genesisHash, nil
+}
+
+func getHealth(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	return "ok", nil
+}
+
+func getVersion(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	return rpc.GetVersion{SolanaCore: "1.18.26"}, nil
+}
+
+func getLatestBlockhash(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	latest := s.latestBlockhash()
+	return rpc.ValueWithContext[rpc.GetLatestBlockhashValue]{
+		Context: s.context(),
+		Value: rpc.GetLatestBlockhashValue{
+			Blockhash:              latest.hash,
+			LatestValidBlockHeight: latest.lastValidBlockHeight,
+		},
+	}, nil
+}
+
+func isBlockhashValid(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var hash common.Hash
+	if err := param(params, 0, &hash, true); err != nil {
+		return nil, err
+	}
+	return rpc.ValueWithContext[bool]{Context: s.context(), Value: s.isBlockhashValid(hash)}, nil
+}
+
+func getMinimumBalanceForRentExemption(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var dataLen uint64
+	if err := param(params, 0, &dataLen, true); err != nil {
+		return nil, err
+	}
+	return MinimumBalanceForRentExemption(dataLen), nil
+}
+
+func getFeeForMessage(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var raw string
+	if err := param(params, 0, &raw, true); err != nil {
+		return nil, err
+	}
+	b, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, invalidParams("invalid base64 encoding: %v", err)
+	}
+	message, err := types.MessageDeserialize(b)
+	if err != nil {
+		return nil, invalidParams("invalid message: %v", err)
+	}
+
+	value := rpc.ValueWithContext[*uint64]{Context: s.context()}
+	if !s.isBlockhashValid(message.RecentBlockHash) || len(message.Accounts) == 0 {
+		return value, nil
+	}
+	e := &execution{server: s, tx: types.Transaction{Message: message}}
+	if e.loadAccountKeys() != nil {
+		return value, nil
+	}
+	e.loadComputeBudget()
+	value.Value = &e.fee
+	return value, nil
+}
+
+func requestAirdrop(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addr string
+	var lamports uint64
+	if err := param(params, 0, &addr, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &lamports, true); err != nil {
+		return nil, err
+	}
+	pubkey, rpcErr := parsePublicKey(addr)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	tx, err := types.NewTransaction(types.NewTransactionParam{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer:        s.faucet.PublicKey,
+			RecentBlockhash: s.latestBlockhash().hash,
+			Instructions: []types.Instruction{
+				system.Transfer(system.TransferParam{
+					From:   s.faucet.PublicKey,
+					To:     pubkey,
+					Amount: lamports,
+				}),
+			},
+		}),
+		Signers: []types.Account{s.faucet},
+	})
+	if err != nil {
+		return nil, newError(ErrCodeInvalidRequest, fmt.Sprintf("airdrop request failed: %v", err))
+	}
+	e := s.execute(tx)
+	if e.err != nil {
+		return nil, newError(ErrCodeInvalidRequest, "airdrop request failed: "+transactionError(e.err).Error())
+	}
+	s.commit(e)
+	return e.signature, nil
+}
+
+func getTokenAccountBalance(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addr string
+	if err := param(params, 0, &addr, true); err != nil {
+		return nil, err
+	}
+	pubkey, err := parsePublicKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	tokenAccount, ok := parseTokenAccount(s.accounts[pubkey])
+	if !ok {
+		return nil, invalidParams("not a Token account")
+	}
+	mint, _ := parseMint(s.accounts[tokenAccount.Mint])
+	return rpc.ValueWithContext[rpc.TokenAccountBalance]{
+		Context: s.context(),
+		Value:   tokenAmount(tokenAccount.Amount, mint.Decimals),
+	}, nil
+}
+
+func getTokenSupply(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addr string
+	if err := param(params, 0, &addr, true); err != nil {
+		return nil, err
+	}
+	pubkey, err := parsePublicKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	mint, ok := parseMint(s.accounts[pubkey])
+	if !ok {
+		return nil, invalidParams("not a Token mint")
+	}
+	return rpc.ValueWithContext[rpc.TokenAccountBalance]{
+		Context: s.context(),
+		Value:   tokenAmount(mint.Supply, mint.Decimals),
+	}, nil
+}
+
+func getTokenAccountsByOwner(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addr string
+	var filter rpc.GetTokenAccountsByOwnerConfigFilter
+	var cfg rpc.GetTokenAccountsByOwnerConfig
+	if err := param(params, 0, &addr, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &filter, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 2, &cfg, false); err != nil {
+		return nil, err
+	}
+	owner, err := parsePublicKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var mint, programID *common.PublicKey
+	switch {
+	case filter.Mint != "" && filter.ProgramId == "":
+		pubkey, err := parsePublicKey(filter.Mint)
+		if err != nil {
+			return nil, err
+		}
+		mint = &pubkey
+	case filter.ProgramId != "" && filter.Mint == "":
+		pubkey, err := parsePublicKey(filter.ProgramId)
+		if err != nil {
+			return nil, err
+		}
+		if !isTokenProgram(pubkey) {
+			return nil, invalidParams("unrecognized Token program id")
+		}
+		programID = &pubkey
+	default:
+		return nil, invalidParams("expected either a mint or a programId filter")
+	}
+
+	accounts := rpc.GetProgramAccounts{}
+	for _, pubkey := range s.sortedAccounts() {
+		account := s.accounts[pubkey]
+		tokenAccount, ok := parseTokenAccount(account)
+		if !ok || tokenAccount.Owner != owner {
+			continue
+		}
+		if (mint != nil && tokenAccount.Mint != *mint) || (programID != nil && account.Owner != *programID) {
+			continue
+		}
+		info, err := encodeAccount(account, cfg.Encoding, cfg.DataSlice)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, rpc.GetProgramAccount{Pubkey: pubkey.ToBase58(), Account: *info})
+	}
+	return rpc.ValueWithContext[rpc.GetProgramAccounts]{Context: s.context(), Value: accounts}, nil
+}
+
+func getProgramAccounts(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addr string
+	var cfg struct {
+		rpc.GetProgramAccountsConfig
+		WithContext bool `json:"withContext"`
+	}
+	if err := param(params, 0, &addr, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &cfg, false); err != nil {
+		return nil, err
+	}
+	programID, err := parsePublicKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := rpc.GetProgramAccounts{}
+	for _, pubkey := range s.sortedAccounts() {
+		account := s.accounts[pubkey]
+		if account.Owner != programID {
+			continue
+		}
+		ok, err := matchFilters(account.Data, cfg.Filters)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		info, err := encodeAccount(account, cfg.Encoding, cfg.DataSlice)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, rpc.GetProgramAccount{Pubkey: pubkey.ToBase58(), Account: *info})
+	}
+	if cfg.WithContext {
+		return rpc.ValueWithContext[rpc.GetProgramAccounts]{Context: s.context(), Value: accounts}, nil
+	}
+	return accounts, nil
+}
+
+func matchFilters(data []byte, filters []rpc.GetProgramAccountsConfigFilter) (bool, *rpc.JsonRpcError) {
+	for _, filter := range filters {
+		if filter.DataSize != 0 && uint64(len(data)) != filter.DataSize {
+			return false, nil
+		}
+		if filter.MemCmp != nil {
+			b, err := base58.Decode(filter.MemCmp.Bytes)
+			if err != nil {
+				return false, invalidParams("invalid memcmp bytes: %v", err)
+			}
+			offset := filter.MemCmp.Offset
+			if offset+uint64(len(b)) > uint64(len(data)) || string(data[offset:offset+uint64(len(b))]) != string(b) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func getRecentPrioritizationFees(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addrs []string
+	if err := param(params, 0, &addrs, false); err != nil {
+		return nil, err
+	}
+	pubkeys := make([]common.PublicKey, 0, len(addrs))
+	for _, addr := range addrs {
+		pubkey, err := parsePublicKey(addr)
+		if err != nil {
+			return nil, err
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+
+	fees := rpc.PrioritizationFees{}
+	start := uint64(1)
+	if s.slot > maxProcessingAge {
+		start = s.slot - maxProcessingAge
+	}
+	for slot := start; slot < s.slot; slot++ {
+		fee := uint64(0)
+		if record, ok := s.blocks[slot]; ok && locksAny(record, pubkeys) {
+			fee = record.computeUnitPrice
+		}
+		fees = append(fees, rpc.PrioritizationFee{Slot: slot, PrioritizationFee: fee})
+	}
+	return fees, nil
+}
+
+// locksAny reports whether the transaction writes one of the accounts, no accounts means any
+func locksAny(record *transactionRecord, pubkeys []common.PublicKey) bool {
+	if len(pubkeys) == 0 {
+		return true
+	}
+	for i, key := range record.accountKeys {
+		for _, pubkey := range pubkeys {
+			if key == pubkey && record.writable[i] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getSignatureStatuses(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var signatures []string
+	if err := param(params, 0, &signatures, true); err != nil {
+		return nil, err
+	}
+
+	finalized := rpc.CommitmentFinalized
+	statuses := make(rpc.SignatureStatuses, 0, len(signatures))
+	for _, signature := range signatures {
+		record, ok := s.transactions[signature]
+		if !ok {
+			statuses = append(statuses, nil)
+			continue
+		}
+		statuses = append(statuses, &rpc.SignatureStatus{
+			Slot:               record.slot,
+			ConfirmationStatus: &finalized,
+			Err:                record.meta.Err,
+		})
+	}
+	return rpc.ValueWithContext[rpc.SignatureStatuses]{Context: s.context(), Value: statuses}, nil
+}
+
+func getSignaturesForAddress(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var addr string
+	var cfg rpc.GetSignaturesForAddressConfig
+	if err := param(params, 0, &addr, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &cfg, false); err != nil {
+		return nil, err
+	}
+	pubkey, err := parsePublicKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	limit := cfg.Limit
+	if limit <= 0 || limit > 1000 {
+		limit = 1000
+	}
+
+	result := rpc.GetSignaturesForAddress{}
+	started := cfg.Before == ""
+	for i := len(s.signatures) - 1; i >= 0 && len(result) < limit; i-- {
+		signature := s.signatures[i]
+		if !started {
+			started = signature == cfg.Before
+			continue
+		}
+		if signature == cfg.Until {
+			break
+		}
+		record := s.transactions[signature]
+		for _, key := range record.accountKeys {
+			if key == pubkey {
+				blockTime := record.blockTime
+				result = append(result, rpc.SignatureWithStatus{
+					Signature: record.tx.Signatures[0],
+					Slot:      record.slot,
+					BlockTime: &blockTime,
+					Err:       record.meta.Err,
+				})
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func getTransaction(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var signature string
+	var cfg rpc.GetTransactionConfig
+	if err := param(params, 0, &signature, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &cfg, false); err != nil {
+		return nil, err
+	}
+	record, ok := s.transactions[signature]
+	if !ok {
+		return nil, nil
+	}
+
+	var version any
+	if record.tx.Message.Version == types.MessageVersionV0 {
+		if cfg.MaxSupportedTransactionVersion == nil {
+			return nil, newError(
+				ErrCodeUnsupportedTransactionVersion,
+				`Transaction version (0) is not supported by the requesting client. Please try the request again with the following configuration parameter: "maxSupportedTransactionVersion": 0`,
+			)
+		}
+		version = 0
+	} else if cfg.MaxSupportedTransactionVersion != nil {
+		version = types.MessageVersionLegacy
+	}
+
+	var transaction any
+	switch cfg.Encoding {
+	case "", rpc.TransactionEncodingJson, rpc.TransactionEncodingJsonParsed:
+		transaction = jsonTransaction(record.tx)
+	case rpc.TransactionEncodingBase64, rpc.TransactionEncodingBase58, rpc.TransactionEncodingBinary:
+		raw, err := record.tx.Serialize()
+		if err != nil {
+			return nil, newError(ErrCodeInvalidRequest, err.Error())
+		}
+		switch cfg.Encoding {
+		case rpc.TransactionEncodingBase64:
+			transaction = []any{base64.StdEncoding.EncodeToString(raw), string(rpc.TransactionEncodingBase64)}
+		case rpc.TransactionEncodingBase58:
+			transaction = []any{base58.Encode(raw), string(rpc.TransactionEncodingBase58)}
+		default:
+			transaction = base58.Encode(raw)
+		}
+	default:
+		return nil, invalidParams("unsupported encoding: %v", cfg.Encoding)
+	}
+
+	meta := record.meta
+	blockTime := record.blockTime
+	return rpc.GetTransaction{
+		Slot:        record.slot,
+		Meta:        &meta,
+		Transaction: transaction,
+		BlockTime:   &blockTime,
+		Version:     version,
+	}, nil
+}
+
+func jsonTransaction(tx types.Transaction) map[string]any {
+	signatures := make([]string, 0, len(tx.Signatures))
+	for _, sig := range tx.Signatures {
+		signatures = append(signatures, sig.String())
+	}
+	accountKeys := make([]string, 0, len(tx.Message.Accounts))
+	for _, pubkey := range tx.Message.Accounts {
+		accountKeys = append(accountKeys, pubkey.ToBase58())
+	}
+	instructions := make([]rpc.Instruction, 0, len(tx.Message.Instructions))
+	for _, ins := range tx.Message.Instructions {
+		instructions = append(instructions, rpc.Instruction{
+			ProgramIDIndex: ins.ProgramIDIndex,
+			Accounts:       ins.Accounts,
+			Data:           base58.Encode(ins.Data),
+		})
+	}
+
+	message := map[string]any{
+		"accountKeys": accountKeys,
+		"header": map[string]any{
+			"numRequiredSignatures":       tx.Message.Header.NumRequireSignatures,
+			"numReadonlySignedAccounts":   tx.Message.Header.NumReadonlySignedAccounts,
+			"numReadonlyUnsignedAccounts": tx.Message.Header.NumReadonlyUnsignedAccounts,
+		},
+		"recentBlockhash": tx.Message.RecentBlockHash,
+		"instructions":    instructions,
+	}
+	if tx.Message.Version == types.MessageVersionV0 {
+		lookups := make([]map[string]any, 0, len(tx.Message.AddressLookupTables))
+		for _, lookup := range tx.Message.AddressLookupTables {
+			lookups = append(lookups, map[string]any{
+				"accountKey":      lookup.AccountKey.ToBase58(),
+				"writableIndexes": bytesToInts(lookup.WritableIndexes),
+				"readonlyIndexes": bytesToInts(lookup.ReadonlyIndexes),
+			})
+		}
+		message["addressTableLookups"] = lookups
+	}
+	return map[string]any{
+		"signatures": signatures,
+		"message":    message,
+	}
+}
+
+func bytesToInts(b []uint8) []int {
+	ints := make([]int, 0, len(b))
+	for _, v := range b {
+		ints = append(ints, int(v))
+	}
+	return ints
+}
+
+func sendTransaction(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var raw string
+	var cfg rpc.SendTransactionConfig
+	if err := param(params, 0, &raw, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &cfg, false); err != nil {
+		return nil, err
+	}
+	tx, rpcErr := decodeTransaction(raw, string(cfg.Encoding))
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if !verifySignatures(tx) {
+		return nil, newError(ErrCodeSignatureVerificationFailure, "Transaction signature verification failure")
+	}
+
+	e := s.execute(tx)
+	if e.err != nil && !cfg.SkipPreflight {
+		return nil, &rpc.JsonRpcError{
+			Code:    ErrCodeSendTransactionPreflight,
+			Message: "Transaction simulation failed: " + transactionError(e.err).Error(),
+			Data: map[string]any{
+				"accounts":      nil,
+				"err":           e.err,
+				"logs":          e.logs,
+				"returnData":    nil,
+				"unitsConsumed": e.unitsConsumed,
+			},
+		}
+	}
+	// a transaction which can't land is dropped, the same as the node does after skipping the preflight
+	s.commit(e)
+	return e.signature, nil
+}
+
+func simulateTransaction(s *Server, params []json.RawMessage) (any, *rpc.JsonRpcError) {
+	var raw string
+	var cfg rpc.SimulateTransactionConfig
+	if err := param(params, 0, &raw, true); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &cfg, false); err != nil {
+		return nil, err
+	}
+	if cfg.SigVerify && cfg.ReplaceRecentBlockhash {
+		return nil, invalidParams("sigVerify may not be used with replaceRecentBlockhash")
+	}
+	tx, rpcErr := decodeTransaction(raw, string(cfg.Encoding))
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if cfg.SigVerify && !verifySignatures(tx) {
+		return nil, newError(ErrCodeSignatureVerificationFailure, "Transaction signature verification failure")
+	}
+	if cfg.ReplaceRecentBlockhash {
+		tx.Message.RecentBlockHash = s.latestBlockhash().hash
+	}
+
+	e := s.execute(tx)
+	unitsConsumed := e.unitsConsumed
+	value := rpc.SimulateTransactionValue{
+		Err:          transactionError(e.err),
+		Logs:         e.logs,
+		UnitConsumed: &unitsConsumed,
+	}
+	if cfg.Accounts != nil {
+		value.Accounts = make([]*rpc.AccountInfo, 0, len(cfg.Accounts.Addresses))
+		for _, addr := range cfg.Accounts.Addresses {
+			pubkey, err := parsePublicKey(addr)
+			if err != nil {
+				return nil, err
+			}
+			var info *rpc.AccountInfo
+			if account, ok := e.account(pubkey); ok {
+				if info, err = encodeAccount(account, cfg.Accounts.Encoding, nil); err != nil {
+					return nil, err
+				}
+			}
+			value.Accounts = append(value.Accounts, info)
+		}
+	}
+	return rpc.ValueWithContext[rpc.SimulateTransactionValue]{Context: s.context(), Value: value}, nil
+}
+
+func decodeTransaction(raw string, encoding string) (types.Transaction, *rpc.JsonRpcError) {
+	var b []byte
+	var err error
+	switch encoding {
+	case "", string(rpc.SendTransactionConfigEncodingBase58):
+		b, err = base58.Decode(raw)
+	case string(rpc.SendTransactionConfigEncodingBase64):
+		b, err = base64.StdEncoding.DecodeString(raw)
+	default:
+		return types.Transaction{}, invalidParams("unsupported encoding: %v", encoding)
+	}
+	if err != nil {
+		return types.Transaction{}, invalidParams("invalid %v encoding: %v", encoding, err)
+	}
+
+	tx, err := types.TransactionDeserialize(b)
+	if err != nil {
+		return types.Transaction{}, invalidParams("failed to deserialize transaction: %v", err)
+	}
+	if err := sanitize(tx); err != nil {
+		return types.Transaction{}, invalidParams("invalid transaction: %v", err)
+	}
+	return tx, nil
+}
@@ -0,0 +1,246 @@
+// Package rpctest provides an in-memory solana cluster behind an httptest server.
+//
+// the server holds accounts, blockhashes and transactions and answers the json-rpc methods the rpc
+// package calls. transactions are deserialized, their signatures are verified and the instructions of
+// the system program, the token programs and the associated token account program are executed.
+// the other programs are not loaded, a transaction which invokes one fails with ProgramAccountNotFound.
+package rpctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+// json-rpc error codes the server replies with
+const (
+	ErrCodeInvalidRequest                = -32600
+	ErrCodeMethodNotFound                = -32601
+	ErrCodeInvalidParams                 = -32602
+	ErrCodeParse                         = -32700
+	ErrCodeSendTransactionPreflight      = -32002
+	ErrCodeSignatureVerificationFailure  = -32003
+	ErrCodeUnsupportedTransactionVersion = -32015
+	ErrCodeMinContextSlotNotReached      = -32016
+)
+
+// MaxMultipleAccounts is the max number of addresses getMultipleAccounts takes
+const MaxMultipleAccounts = 100
+
+// Account is an account held by the server
+type Account struct {
+	Lamports   uint64
+	Owner      common.PublicKey
+	Data       []byte
+	Executable bool
+	RentEpoch  uint64
+}
+
+// Server is a fake solana cluster. every landed transaction is put in a new block, so the slot and
+// the block height move forward one by one and a new blockhash is produced each time.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	accounts     map[common.PublicKey]Account
+	slot         uint64
+	blockHeight  uint64
+	genesisHash  string
+	blockhashes  []blockhash
+	blocks       map[uint64]*transactionRecord
+	transactions map[string]*transactionRecord
+	signatures   []string
+	faucet       types.Account
+}
+
+// NewServer starts a fake cluster. the caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		accounts:     map[common.PublicKey]Account{},
+		blocks:       map[uint64]*transactionRecord{},
+		transactions: map[string]*transactionRecord{},
+		faucet:       types.NewAccount(),
+	}
+	s.accounts[s.faucet.PublicKey] = Account{Lamports: faucetLamports, Owner: common.SystemProgramID}
+	s.produceBlock()
+	s.genesisHash = s.blockhashes[0].hash.String()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// SetAccount creates or replaces an account
+func (s *Server) SetAccount(pubkey common.PublicKey, account Account) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts[pubkey] = cloneAccount(account)
+}
+
+// GetAccount returns the account, it reports false if the account doesn't exist
+func (s *Server) GetAccount(pubkey common.PublicKey) (Account, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	account, ok := s.accounts[pubkey]
+	return cloneAccount(account), ok
+}
+
+// GetBalance returns the lamports of the account
+func (s *Server) GetBalance(pubkey common.PublicKey) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.accounts[pubkey].Lamports
+}
+
+// Airdrop credits lamports to the account without a transaction. use the `requestAirdrop` method
+// if a transaction is expected.
+func (s *Server) Airdrop(pubkey common.PublicKey, lamports uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	account, ok := s.accounts[pubkey]
+	if !ok {
+		account.Owner = common.SystemProgramID
+	}
+	account.Lamports += lamports
+	s.accounts[pubkey] = account
+}
+
+// LatestBlockhash returns the newest blockhash
+func (s *Server) LatestBlockhash() common.Hash {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.latestBlockhash().hash
+}
+
+// ProduceBlocks produces empty blocks, blockhashes expire after 150 blocks
+func (s *Server) ProduceBlocks(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := 0; i < n; i++ {
+		s.produceBlock()
+	}
+}
+
+// Slot returns the current slot
+func (s *Server) Slot() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.slot
+}
+
+type request struct {
+	JsonRpc string            `json:"jsonrpc"`
+	Id      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+type response struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id"`
+	Result  any             `json:"result"`
+}
+
+type errorResponse struct {
+	JsonRpc string            `json:"jsonrpc"`
+	Id      json.RawMessage   `json:"id"`
+	Error   *rpc.JsonRpcError `json:"error"`
+}
+
+func (s *Server) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var output any
+	if body = bytes.TrimSpace(body); len(body) > 0 && body[0] == '[' {
+		var reqs []request
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			output = newErrorResponse(nil, newError(ErrCodeParse, "Parse error"))
+		} else if len(reqs) == 0 {
+			output = newErrorResponse(nil, newError(ErrCodeInvalidRequest, "Invalid request"))
+		} else {
+			outputs := make([]any, 0, len(reqs))
+			for _, r := range reqs {
+				outputs = append(outputs, s.handle(r))
+			}
+			output = outputs
+		}
+	} else {
+		var r request
+		if err := json.Unmarshal(body, &r); err != nil {
+			output = newErrorResponse(nil, newError(ErrCodeParse, "Parse error"))
+		} else {
+			output = s.handle(r)
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(output)
+}
+
+func (s *Server) handle(r request) any {
+	if r.JsonRpc != "2.0" || r.Method == "" {
+		return newErrorResponse(r.Id, newError(ErrCodeInvalidRequest, "Invalid request"))
+	}
+	h, ok := handlers[r.Method]
+	if !ok {
+		return newErrorResponse(r.Id, newError(ErrCodeMethodNotFound, "Method not found"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result, rpcErr := h(s, r.Params)
+	if rpcErr != nil {
+		return newErrorResponse(r.Id, rpcErr)
+	}
+	return response{JsonRpc: "2.0", Id: r.Id, Result: result}
+}
+
+func newErrorResponse(id json.RawMessage, err *rpc.JsonRpcError) errorResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return errorResponse{JsonRpc: "2.0", Id: id, Error: err}
+}
+
+func newError(code int, message string) *rpc.JsonRpcError {
+	return &rpc.JsonRpcError{Code: code, Message: message}
+}
+
+func invalidParams(format string, args ...any) *rpc.JsonRpcError {
+	return newError(ErrCodeInvalidParams, "Invalid params: "+fmt.Sprintf(format, args...))
+}
+
+// param decodes the i-th param into v. a missing optional param leaves v untouched.
+func param(params []json.RawMessage, i int, v any, required bool) *rpc.JsonRpcError {
+	if i >= len(params) || string(params[i]) == "null" {
+		if required {
+			return invalidParams("missing param #%d", i+1)
+		}
+		return nil
+	}
+	if err := json.Unmarshal(params[i], v); err != nil {
+		return invalidParams("param #%d: %v", i+1, err)
+	}
+	return nil
+}
+
+func cloneAccount(account Account) Account {
+	if account.Data != nil {
+		account.Data = append([]byte{}, account.Data...)
+	}
+	return account
+}
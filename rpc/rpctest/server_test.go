@@ -0,0 +1,325 @@
+package rpctest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blocto/solana-go-sdk/client"
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/associated_token_account"
+	"github.com/blocto/solana-go-sdk/program/system"
+	"github.com/blocto/solana-go-sdk/program/token"
+	"github.com/blocto/solana-go-sdk/rpc"
+	"github.com/blocto/solana-go-sdk/rpc/rpctest"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTransaction(t *testing.T, blockhash common.Hash, feePayer types.Account, signers []types.Account, instructions ...types.Instruction) types.Transaction {
+	tx, err := types.NewTransaction(types.NewTransactionParam{
+		Message: types.NewMessage(types.NewMessageParam{
+			FeePayer:        feePayer.PublicKey,
+			RecentBlockhash: blockhash,
+			Instructions:    instructions,
+		}),
+		Signers: append([]types.Account{feePayer}, signers...),
+	})
+	assert.Nil(t, err)
+	return tx
+}
+
+func TestServer_SystemTransfer(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := client.NewClient(s.URL)
+	ctx := context.Background()
+
+	alice, bob := types.NewAccount(), types.NewAccount()
+	sig, err := c.RequestAirdrop(ctx, alice.PublicKey.ToBase58(), 1_000_000_000)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, sig)
+	assert.Equal(t, uint64(1_000_000_000), s.GetBalance(alice.PublicKey))
+
+	latest, err := c.GetLatestBlockhash(ctx)
+	assert.Nil(t, err)
+	tx := newTransaction(t, latest.Blockhash, alice, nil, system.Transfer(system.TransferParam{
+		From:   alice.PublicKey,
+		To:     bob.PublicKey,
+		Amount: 100_000_000,
+	}))
+	sig, err = c.SendAndConfirmTransaction(ctx, tx, client.SendAndConfirmTransactionConfig{
+		Confirm: client.ConfirmTransactionConfig{
+			LastValidBlockHeight: latest.LatestValidBlockHeight,
+			PollInterval:         time.Millisecond,
+		},
+	})
+	assert.Nil(t, err)
+
+	balance, err := c.GetBalance(ctx, bob.PublicKey.ToBase58())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100_000_000), balance)
+	assert.Equal(t, uint64(900_000_000)-rpctest.LamportsPerSignature, s.GetBalance(alice.PublicKey))
+
+	got, err := c.GetTransaction(ctx, sig)
+	assert.Nil(t, err)
+	assert.Equal(t, tx, got.Transaction)
+	assert.Nil(t, got.Meta.Err)
+	assert.Equal(t, rpctest.LamportsPerSignature, got.Meta.Fee)
+	assert.Equal(t, []int64{1_000_000_000, 0, 0}, got.Meta.PreBalances)
+	assert.Equal(t, []int64{900_000_000 - int64(rpctest.LamportsPerSignature), 100_000_000, 0}, got.Meta.PostBalances)
+	assert.Equal(t, []string{
+		"Program 11111111111111111111111111111111 invoke [1]",
+		"Program 11111111111111111111111111111111 success",
+	}, got.Meta.LogMessages)
+
+	// a processed transaction can't be sent again
+	_, err = c.SendTransaction(ctx, tx)
+	var rpcErr *rpc.JsonRpcError
+	assert.True(t, errors.As(err, &rpcErr))
+	assert.Equal(t, rpctest.ErrCodeSendTransactionPreflight, rpcErr.Code)
+}
+
+func TestServer_Token(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := client.NewClient(s.URL)
+	ctx := context.Background()
+
+	payer, mint, alice, bob := types.NewAccount(), types.NewAccount(), types.NewAccount(), types.NewAccount()
+	s.Airdrop(payer.PublicKey, 10_000_000_000)
+
+	rent, err := c.GetMinimumBalanceForRentExemption(ctx, token.MintAccountSize)
+	assert.Nil(t, err)
+	aliceAta, _, err := common.FindAssociatedTokenAddress(alice.PublicKey, mint.PublicKey, common.TokenProgramID)
+	assert.Nil(t, err)
+	bobAta, _, err := common.FindAssociatedTokenAddress(bob.PublicKey, mint.PublicKey, common.TokenProgramID)
+	assert.Nil(t, err)
+
+	tx := newTransaction(t, s.LatestBlockhash(), payer, []types.Account{mint, alice},
+		system.CreateAccount(system.CreateAccountParam{
+			From:     payer.PublicKey,
+			New:      mint.PublicKey,
+			Owner:    common.TokenProgramID,
+			Lamports: rent,
+			Space:    token.MintAccountSize,
+		}),
+		token.InitializeMint2(token.InitializeMint2Param{
+			Decimals: 6,
+			Mint:     mint.PublicKey,
+			MintAuth: alice.PublicKey,
+		}),
+		associated_token_account.Create(associated_token_account.CreateParam{
+			Funder:                 payer.PublicKey,
+			Owner:                  alice.PublicKey,
+			Mint:                   mint.PublicKey,
+			AssociatedTokenAccount: aliceAta,
+			ProgramID:              common.TokenProgramID,
+		}),
+		associated_token_account.CreateIdempotent(associated_token_account.CreateIdempotentParam{
+			Funder:                 payer.PublicKey,
+			Owner:                  bob.PublicKey,
+			Mint:                   mint.PublicKey,
+			AssociatedTokenAccount: bobAta,
+		}),
+		token.MintToChecked(token.MintToCheckedParam{
+			Mint:     mint.PublicKey,
+			Auth:     alice.PublicKey,
+			To:       aliceAta,
+			Amount:   5_000_000,
+			Decimals: 6,
+		}),
+		token.TransferChecked(token.TransferCheckedParam{
+			From:      aliceAta,
+			To:        bobAta,
+			Mint:      mint.PublicKey,
+			Auth:      alice.PublicKey,
+			Amount:    1_500_000,
+			Decimals:  6,
+			ProgramID: common.TokenProgramID,
+		}),
+	)
+	sig, err := c.SendTransaction(ctx, tx)
+	assert.Nil(t, err)
+
+	balance, err := c.GetTokenAccountBalance(ctx, bobAta.ToBase58())
+	assert.Nil(t, err)
+	assert.Equal(t, client.TokenAmount{Amount: 1_500_000, Decimals: 6, UIAmountString: "1.5"}, balance)
+	supply, err := c.GetTokenSupply(ctx, mint.PublicKey.ToBase58())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5_000_000), supply.Amount)
+
+	account, err := c.GetAccountInfo(ctx, aliceAta.ToBase58())
+	assert.Nil(t, err)
+	tokenAccount, err := token.DeserializeTokenAccount(account.Data, account.Owner)
+	assert.Nil(t, err)
+	assert.Equal(t, alice.PublicKey, tokenAccount.Owner)
+	assert.Equal(t, uint64(3_500_000), tokenAccount.Amount)
+
+	got, err := c.GetTransaction(ctx, sig)
+	assert.Nil(t, err)
+	assert.Len(t, got.Meta.PreTokenBalances, 0)
+	assert.Len(t, got.Meta.PostTokenBalances, 2)
+
+	// alice can't spend more than she has
+	tx = newTransaction(t, s.LatestBlockhash(), payer, []types.Account{alice},
+		token.TransferChecked(token.TransferCheckedParam{
+			From:      aliceAta,
+			To:        bobAta,
+			Mint:      mint.PublicKey,
+			Auth:      alice.PublicKey,
+			Amount:    10_000_000,
+			Decimals:  6,
+			ProgramID: common.TokenProgramID,
+		}),
+	)
+	_, err = c.SendTransaction(ctx, tx)
+	customErr, ok := client.ParseCustomError(err)
+	assert.True(t, ok)
+	assert.Equal(t, common.TokenProgramID, customErr.ProgramID)
+	assert.ErrorIs(t, customErr, token.ErrInsufficientFunds)
+}
+
+func TestServer_Token2022AssociatedTokenAccount(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := client.NewClient(s.URL)
+	ctx := context.Background()
+
+	payer, mint := types.NewAccount(), types.NewAccount()
+	s.Airdrop(payer.PublicKey, 10_000_000_000)
+	data := make([]byte, token.MintAccountSize)
+	data[44], data[45] = 9, 1
+	s.SetAccount(mint.PublicKey, rpctest.Account{
+		Lamports: rpctest.MinimumBalanceForRentExemption(token.MintAccountSize),
+		Owner:    common.Token2022ProgramID,
+		Data:     data,
+	})
+	ata, _, err := common.FindAssociatedTokenAddress(payer.PublicKey, mint.PublicKey, common.Token2022ProgramID)
+	assert.Nil(t, err)
+
+	_, err = c.SendTransaction(ctx, newTransaction(t, s.LatestBlockhash(), payer, nil,
+		associated_token_account.Create(associated_token_account.CreateParam{
+			Funder:                 payer.PublicKey,
+			Owner:                  payer.PublicKey,
+			Mint:                   mint.PublicKey,
+			AssociatedTokenAccount: ata,
+			ProgramID:              common.Token2022ProgramID,
+		}),
+	))
+	assert.Nil(t, err)
+
+	account, ok := s.GetAccount(ata)
+	assert.True(t, ok)
+	assert.Len(t, account.Data, token.Token2022AccountSizeA)
+	tokenAccount, err := token.DeserializeTokenAccount(account.Data, account.Owner)
+	assert.Nil(t, err)
+	assert.Equal(t, []token.Extension{token.ImmutableOwner{}}, tokenAccount.Extensions)
+}
+
+func TestServer_SendTransactionFailed(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := client.NewClient(s.URL)
+	ctx := context.Background()
+
+	alice, bob := types.NewAccount(), types.NewAccount()
+	s.Airdrop(alice.PublicKey, 1_000_000)
+	transfer := system.Transfer(system.TransferParam{
+		From:   alice.PublicKey,
+		To:     bob.PublicKey,
+		Amount: 2_000_000,
+	})
+
+	t.Run("preflight", func(t *testing.T) {
+		_, err := c.SendTransaction(ctx, newTransaction(t, s.LatestBlockhash(), alice, nil, transfer))
+		customErr, ok := client.ParseCustomError(err)
+		assert.True(t, ok)
+		assert.Equal(t, common.SystemProgramID, customErr.ProgramID)
+		assert.ErrorIs(t, customErr, system.ErrResultWithNegativeLamports)
+		assert.Equal(t, uint64(1_000_000), s.GetBalance(alice.PublicKey))
+	})
+
+	t.Run("skip preflight", func(t *testing.T) {
+		latest := s.LatestBlockhash()
+		sig, err := c.SendAndConfirmTransaction(ctx, newTransaction(t, latest, alice, nil, transfer), client.SendAndConfirmTransactionConfig{
+			Send:    client.SendTransactionConfig{SkipPreflight: true},
+			Confirm: client.ConfirmTransactionConfig{LastValidBlockHeight: 1000, PollInterval: time.Millisecond},
+		})
+		var failedErr *client.TransactionFailedError
+		assert.True(t, errors.As(err, &failedErr))
+		assert.Equal(t, sig, failedErr.Signature)
+		assert.ErrorIs(t, err, rpc.InstructionErrorCustom)
+		// the fee is paid even though the transfer failed
+		assert.Equal(t, 1_000_000-rpctest.LamportsPerSignature, s.GetBalance(alice.PublicKey))
+	})
+
+	t.Run("signature verification", func(t *testing.T) {
+		tx := newTransaction(t, s.LatestBlockhash(), alice, nil, transfer)
+		copy(tx.Signatures[0][:], types.NewAccount().Sign([]byte("message")))
+		_, err := c.SendTransaction(ctx, tx)
+		var rpcErr *rpc.JsonRpcError
+		assert.True(t, errors.As(err, &rpcErr))
+		assert.Equal(t, rpctest.ErrCodeSignatureVerificationFailure, rpcErr.Code)
+	})
+
+	t.Run("expired blockhash", func(t *testing.T) {
+		latest := s.LatestBlockhash()
+		s.ProduceBlocks(151)
+		_, err := c.SendTransaction(ctx, newTransaction(t, latest, alice, nil, system.Transfer(system.TransferParam{
+			From:   alice.PublicKey,
+			To:     bob.PublicKey,
+			Amount: 1,
+		})))
+		var rpcErr *rpc.JsonRpcError
+		assert.True(t, errors.As(err, &rpcErr))
+		assert.Equal(t, "Transaction simulation failed: Blockhash not found", rpcErr.Message)
+	})
+}
+
+func TestServer_SimulateTransaction(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := client.NewClient(s.URL)
+	ctx := context.Background()
+
+	alice, bob := types.NewAccount(), types.NewAccount()
+	s.Airdrop(alice.PublicKey, 1_000_000)
+	tx := newTransaction(t, s.LatestBlockhash(), alice, nil, system.Transfer(system.TransferParam{
+		From:   alice.PublicKey,
+		To:     bob.PublicKey,
+		Amount: 10_000,
+	}))
+
+	got, err := c.SimulateTransaction(ctx, tx)
+	assert.Nil(t, err)
+	assert.Nil(t, got.Err)
+	assert.Len(t, got.Logs, 2)
+	// a simulation changes nothing
+	assert.Equal(t, uint64(0), s.GetBalance(bob.PublicKey))
+}
+
+func TestServer_Batch(t *testing.T) {
+	s := rpctest.NewServer()
+	defer s.Close()
+	c := rpc.New(rpc.WithEndpoint(s.URL))
+
+	alice := types.NewAccount()
+	s.Airdrop(alice.PublicKey, 42)
+
+	b := c.NewBatch()
+	balance := b.GetBalance(alice.PublicKey.ToBase58())
+	invalid := b.GetBalance("invalid")
+	slot := b.GetSlot()
+	assert.Nil(t, b.Send(context.Background()))
+
+	res, err := balance.Result()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(42), res.Result.Value)
+	_, err = invalid.Result()
+	assert.NotNil(t, err)
+	slotRes, err := slot.Result()
+	assert.Nil(t, err)
+	assert.Equal(t, s.Slot(), slotRes.Result)
+}
@@ -0,0 +1,154 @@
+package rpctest
+
+import (
+	"encoding/binary"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/system"
+)
+
+// custom errors of the system program
+const (
+	systemErrAccountAlreadyInUse        uint32 = 0
+	systemErrResultWithNegativeLamports uint32 = 1
+	systemErrInvalidAccountDataLength   uint32 = 3
+	maxPermittedDataLength              uint64 = 10 * 1024 * 1024
+)
+
+func processSystem(in *invocation) any {
+	if len(in.data) < 4 {
+		return errInvalidInstructionData
+	}
+	data := in.data[4:]
+
+	switch system.Instruction(binary.LittleEndian.Uint32(in.data)) {
+	case system.InstructionCreateAccount:
+		if len(data) != 48 {
+			return errInvalidInstructionData
+		}
+		return systemCreateAccount(
+			in,
+			binary.LittleEndian.Uint64(data[0:8]),
+			binary.LittleEndian.Uint64(data[8:16]),
+			common.PublicKeyFromBytes(data[16:48]),
+		)
+	case system.InstructionAssign:
+		if len(data) != 32 {
+			return errInvalidInstructionData
+		}
+		return systemAssign(in, common.PublicKeyFromBytes(data))
+	case system.InstructionTransfer:
+		if len(data) != 8 {
+			return errInvalidInstructionData
+		}
+		return systemTransfer(in, binary.LittleEndian.Uint64(data))
+	case system.InstructionAllocate:
+		if len(data) != 8 {
+			return errInvalidInstructionData
+		}
+		return systemAllocate(in, binary.LittleEndian.Uint64(data))
+	}
+
+	in.log("Program log: rpctest: the instruction is not supported")
+	return errInvalidInstructionData
+}
+
+func systemCreateAccount(in *invocation, lamports, space uint64, owner common.PublicKey) any {
+	if !in.isSigner(0) || !in.isSigner(1) {
+		return errMissingRequiredSignature
+	}
+	_, to, _, err := in.load(1)
+	if err != nil {
+		return err
+	}
+	if to.Lamports > 0 || len(to.Data) > 0 || to.Owner != common.SystemProgramID {
+		in.log("Create Account: account %v already in use", mustPubkey(in, 1))
+		return customError(systemErrAccountAlreadyInUse)
+	}
+	if space > maxPermittedDataLength {
+		return customError(systemErrInvalidAccountDataLength)
+	}
+
+	if err := systemDebit(in, 0, lamports); err != nil {
+		return err
+	}
+	_, to, _, _ = in.load(1)
+	to.Lamports += lamports
+	to.Data = make([]byte, space)
+	to.Owner = owner
+	return in.store(1, to)
+}
+
+func systemAssign(in *invocation, owner common.PublicKey) any {
+	if !in.isSigner(0) {
+		return errMissingRequiredSignature
+	}
+	_, account, _, err := in.load(0)
+	if err != nil {
+		return err
+	}
+	if account.Owner == owner {
+		return nil
+	}
+	if account.Owner != common.SystemProgramID {
+		return errIncorrectProgramId
+	}
+	account.Owner = owner
+	return in.store(0, account)
+}
+
+func systemTransfer(in *invocation, lamports uint64) any {
+	if _, err := in.pubkey(1); err != nil {
+		return err
+	}
+	if !in.isSigner(0) {
+		return errMissingRequiredSignature
+	}
+	if err := systemDebit(in, 0, lamports); err != nil {
+		return err
+	}
+	_, to, _, _ := in.load(1)
+	to.Lamports += lamports
+	return in.store(1, to)
+}
+
+func systemAllocate(in *invocation, space uint64) any {
+	if !in.isSigner(0) {
+		return errMissingRequiredSignature
+	}
+	_, account, _, err := in.load(0)
+	if err != nil {
+		return err
+	}
+	if len(account.Data) > 0 || account.Owner != common.SystemProgramID {
+		return customError(systemErrAccountAlreadyInUse)
+	}
+	if space > maxPermittedDataLength {
+		return customError(systemErrInvalidAccountDataLength)
+	}
+	account.Data = make([]byte, space)
+	return in.store(0, account)
+}
+
+// systemDebit takes lamports from an account the system program owns
+func systemDebit(in *invocation, i int, lamports uint64) any {
+	_, from, _, err := in.load(i)
+	if err != nil {
+		return err
+	}
+	if len(from.Data) > 0 || from.Owner != common.SystemProgramID {
+		in.log("Transfer: `from` must not carry data")
+		return errInvalidArgument
+	}
+	if from.Lamports < lamports {
+		in.log("Transfer: insufficient lamports %v, need %v", from.Lamports, lamports)
+		return customError(systemErrResultWithNegativeLamports)
+	}
+	from.Lamports -= lamports
+	return in.store(i, from)
+}
+
+func mustPubkey(in *invocation, i int) common.PublicKey {
+	pubkey, _ := in.pubkey(i)
+	return pubkey
+}
@@ -0,0 +1,540 @@
+package rpctest
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/program/token"
+	"github.com/blocto/solana-go-sdk/rpc"
+)
+
+var nativeMint = common.PublicKeyFromString("So11111111111111111111111111111111111111112")
+
+// custom errors of the token program
+const (
+	tokenErrNotRentExempt         uint32 = 0
+	tokenErrInsufficientFunds     uint32 = 1
+	tokenErrInvalidMint           uint32 = 2
+	tokenErrMintMismatch          uint32 = 3
+	tokenErrOwnerMismatch         uint32 = 4
+	tokenErrFixedSupply           uint32 = 5
+	tokenErrAlreadyInUse          uint32 = 6
+	tokenErrUninitializedState    uint32 = 9
+	tokenErrNativeNotSupported    uint32 = 10
+	tokenErrNonNativeHasBalance   uint32 = 11
+	tokenErrOverflow              uint32 = 14
+	tokenErrAccountFrozen         uint32 = 17
+	tokenErrMintDecimalsMismatch  uint32 = 18
+	tokenErrNonNativeNotSupported uint32 = 19
+)
+
+var tokenInstructionNames = map[token.Instruction]string{
+	token.InstructionInitializeMint:     "InitializeMint",
+	token.InstructionInitializeAccount:  "InitializeAccount",
+	token.InstructionTransfer:           "Transfer",
+	token.InstructionMintTo:             "MintTo",
+	token.InstructionBurn:               "Burn",
+	token.InstructionCloseAccount:       "CloseAccount",
+	token.InstructionTransferChecked:    "TransferChecked",
+	token.InstructionMintToChecked:      "MintToChecked",
+	token.InstructionBurnChecked:        "BurnChecked",
+	token.InstructionInitializeAccount2: "InitializeAccount2",
+	token.InstructionSyncNative:         "SyncNative",
+	token.InstructionInitializeAccount3: "InitializeAccount3",
+	token.InstructionInitializeMint2:    "InitializeMint2",
+}
+
+// processToken runs the instructions of the token program and token-2022. extensions are kept as they are
+// and multisig authorities are not supported.
+func processToken(in *invocation) any {
+	if len(in.data) == 0 {
+		return errInvalidInstructionData
+	}
+	instruction := token.Instruction(in.data[0])
+	name, ok := tokenInstructionNames[instruction]
+	if !ok {
+		in.log("Program log: rpctest: the instruction is not supported")
+		return errInvalidInstructionData
+	}
+	in.log("Program log: Instruction: %v", name)
+	data := in.data[1:]
+
+	switch instruction {
+	case token.InstructionInitializeMint, token.InstructionInitializeMint2:
+		if len(data) < 34 {
+			return errInvalidInstructionData
+		}
+		var freezeAuthority *common.PublicKey
+		if data[33] == 1 {
+			if len(data) < 66 {
+				return errInvalidInstructionData
+			}
+			pubkey := common.PublicKeyFromBytes(data[34:66])
+			freezeAuthority = &pubkey
+		}
+		return tokenInitializeMint(in, data[0], common.PublicKeyFromBytes(data[1:33]), freezeAuthority)
+	case token.InstructionInitializeAccount:
+		owner, err := in.pubkey(2)
+		if err != nil {
+			return err
+		}
+		return tokenInitializeAccount(in, owner)
+	case token.InstructionInitializeAccount2, token.InstructionInitializeAccount3:
+		if len(data) != 32 {
+			return errInvalidInstructionData
+		}
+		return tokenInitializeAccount(in, common.PublicKeyFromBytes(data))
+	case token.InstructionTransfer:
+		if len(data) != 8 {
+			return errInvalidInstructionData
+		}
+		return tokenTransfer(in, binary.LittleEndian.Uint64(data), nil)
+	case token.InstructionTransferChecked:
+		if len(data) != 9 {
+			return errInvalidInstructionData
+		}
+		return tokenTransfer(in, binary.LittleEndian.Uint64(data), &data[8])
+	case token.InstructionMintTo:
+		if len(data) != 8 {
+			return errInvalidInstructionData
+		}
+		return tokenMintTo(in, binary.LittleEndian.Uint64(data), nil)
+	case token.InstructionMintToChecked:
+		if len(data) != 9 {
+			return errInvalidInstructionData
+		}
+		return tokenMintTo(in, binary.LittleEndian.Uint64(data), &data[8])
+	case token.InstructionBurn:
+		if len(data) != 8 {
+			return errInvalidInstructionData
+		}
+		return tokenBurn(in, binary.LittleEndian.Uint64(data), nil)
+	case token.InstructionBurnChecked:
+		if len(data) != 9 {
+			return errInvalidInstructionData
+		}
+		return tokenBurn(in, binary.LittleEndian.Uint64(data), &data[8])
+	case token.InstructionCloseAccount:
+		return tokenCloseAccount(in)
+	case token.InstructionSyncNative:
+		return tokenSyncNative(in)
+	}
+	return errInvalidInstructionData
+}
+
+func tokenInitializeMint(in *invocation, decimals uint8, mintAuthority common.PublicKey, freezeAuthority *common.PublicKey) any {
+	_, mint, _, err := in.load(0)
+	if err != nil {
+		return err
+	}
+	if mint.Owner != in.programID {
+		return errIncorrectProgramId
+	}
+	if len(mint.Data) < token.MintAccountSize {
+		return errInvalidAccountData
+	}
+	if mint.Data[45] == 1 {
+		return customError(tokenErrAlreadyInUse)
+	}
+	if mint.Lamports < MinimumBalanceForRentExemption(uint64(len(mint.Data))) {
+		return customError(tokenErrNotRentExempt)
+	}
+
+	putOptionalPublicKey(mint.Data[0:36], &mintAuthority)
+	binary.LittleEndian.PutUint64(mint.Data[36:44], 0)
+	mint.Data[44] = decimals
+	mint.Data[45] = 1
+	putOptionalPublicKey(mint.Data[46:82], freezeAuthority)
+	if len(mint.Data) > token.TokenAccountSize {
+		mint.Data[token.TokenAccountSize] = byte(token.AccountTypeMint)
+	}
+	return in.store(0, mint)
+}
+
+func tokenInitializeAccount(in *invocation, owner common.PublicKey) any {
+	_, account, _, err := in.load(0)
+	if err != nil {
+		return err
+	}
+	mintPubkey, mint, _, err := in.load(1)
+	if err != nil {
+		return err
+	}
+	if account.Owner != in.programID {
+		return errIncorrectProgramId
+	}
+	if len(account.Data) < token.TokenAccountSize {
+		return errInvalidAccountData
+	}
+	if account.Data[108] != byte(token.TokenAccountStateUninitialized) {
+		return customError(tokenErrAlreadyInUse)
+	}
+	if _, ok := parseMint(mint); !ok || mint.Owner != in.programID {
+		return customError(tokenErrInvalidMint)
+	}
+	rent := MinimumBalanceForRentExemption(uint64(len(account.Data)))
+	if account.Lamports < rent {
+		return customError(tokenErrNotRentExempt)
+	}
+
+	initializeTokenAccountData(account.Data, mintPubkey, owner)
+	if isNativeMint(in.programID, mintPubkey) {
+		putNative(account.Data, rent, account.Lamports-rent)
+	}
+	return in.store(0, account)
+}
+
+func tokenTransfer(in *invocation, amount uint64, decimals *uint8) any {
+	destinationIdx, authorityIdx := 1, 2
+	if decimals != nil {
+		destinationIdx, authorityIdx = 2, 3
+	}
+
+	sourcePubkey, source, err := loadTokenAccount(in, 0)
+	if err != nil {
+		return err
+	}
+	if source.Amount < amount {
+		return customError(tokenErrInsufficientFunds)
+	}
+	if decimals != nil {
+		if err := checkMint(in, 1, source.Mint, decimals); err != nil {
+			return err
+		}
+	}
+	destinationPubkey, destination, err := loadTokenAccount(in, destinationIdx)
+	if err != nil {
+		return err
+	}
+	if destination.Mint != source.Mint {
+		return customError(tokenErrMintMismatch)
+	}
+	if err := checkAuthority(in, authorityIdx, source, amount); err != nil {
+		return err
+	}
+	if sourcePubkey == destinationPubkey {
+		return nil
+	}
+
+	if err := updateTokenAccount(in, 0, func(account *Account, data token.TokenAccount) any {
+		debitDelegate(in, account.Data, data, authorityIdx, amount)
+		binary.LittleEndian.PutUint64(account.Data[64:72], data.Amount-amount)
+		if data.IsNative != nil {
+			account.Lamports -= amount
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return updateTokenAccount(in, destinationIdx, func(account *Account, data token.TokenAccount) any {
+		if data.Amount+amount < data.Amount {
+			return customError(tokenErrOverflow)
+		}
+		binary.LittleEndian.PutUint64(account.Data[64:72], data.Amount+amount)
+		if data.IsNative != nil {
+			account.Lamports += amount
+		}
+		return nil
+	})
+}
+
+func tokenMintTo(in *invocation, amount uint64, decimals *uint8) any {
+	mintPubkey, mintAccount, _, err := in.load(0)
+	if err != nil {
+		return err
+	}
+	mint, ok := parseMint(mintAccount)
+	if !ok || mintAccount.Owner != in.programID {
+		return customError(tokenErrInvalidMint)
+	}
+	if decimals != nil && *decimals != mint.Decimals {
+		return customError(tokenErrMintDecimalsMismatch)
+	}
+	if isNativeMint(in.programID, mintPubkey) {
+		return customError(tokenErrNativeNotSupported)
+	}
+	_, destination, err := loadTokenAccount(in, 1)
+	if err != nil {
+		return err
+	}
+	if destination.Mint != mintPubkey {
+		return customError(tokenErrMintMismatch)
+	}
+	authority, err := in.pubkey(2)
+	if err != nil {
+		return err
+	}
+	if mint.MintAuthority == nil {
+		return customError(tokenErrFixedSupply)
+	}
+	if *mint.MintAuthority != authority {
+		return customError(tokenErrOwnerMismatch)
+	}
+	if !in.isSigner(2) {
+		return errMissingRequiredSignature
+	}
+	if mint.Supply+amount < mint.Supply {
+		return customError(tokenErrOverflow)
+	}
+
+	binary.LittleEndian.PutUint64(mintAccount.Data[36:44], mint.Supply+amount)
+	if err := in.store(0, mintAccount); err != nil {
+		return err
+	}
+	return updateTokenAccount(in, 1, func(account *Account, data token.TokenAccount) any {
+		binary.LittleEndian.PutUint64(account.Data[64:72], data.Amount+amount)
+		return nil
+	})
+}
+
+func tokenBurn(in *invocation, amount uint64, decimals *uint8) any {
+	_, source, err := loadTokenAccount(in, 0)
+	if err != nil {
+		return err
+	}
+	if source.IsNative != nil {
+		return customError(tokenErrNativeNotSupported)
+	}
+	if source.Amount < amount {
+		return customError(tokenErrInsufficientFunds)
+	}
+	if err := checkMint(in, 1, source.Mint, decimals); err != nil {
+		return err
+	}
+	if err := checkAuthority(in, 2, source, amount); err != nil {
+		return err
+	}
+
+	if err := updateTokenAccount(in, 0, func(account *Account, data token.TokenAccount) any {
+		debitDelegate(in, account.Data, data, 2, amount)
+		binary.LittleEndian.PutUint64(account.Data[64:72], data.Amount-amount)
+		return nil
+	}); err != nil {
+		return err
+	}
+	_, mintAccount, _, _ := in.load(1)
+	mint, _ := parseMint(mintAccount)
+	binary.LittleEndian.PutUint64(mintAccount.Data[36:44], mint.Supply-amount)
+	return in.store(1, mintAccount)
+}
+
+func tokenCloseAccount(in *invocation) any {
+	sourcePubkey, source, err := loadTokenAccount(in, 0)
+	if err != nil {
+		return err
+	}
+	if source.IsNative == nil && source.Amount != 0 {
+		return customError(tokenErrNonNativeHasBalance)
+	}
+	destinationPubkey, err := in.pubkey(1)
+	if err != nil {
+		return err
+	}
+	if sourcePubkey == destinationPubkey {
+		return errInvalidAccountData
+	}
+	authority, err := in.pubkey(2)
+	if err != nil {
+		return err
+	}
+	closeAuthority := source.Owner
+	if source.CloseAuthority != nil {
+		closeAuthority = *source.CloseAuthority
+	}
+	if authority != closeAuthority {
+		return customError(tokenErrOwnerMismatch)
+	}
+	if !in.isSigner(2) {
+		return errMissingRequiredSignature
+	}
+
+	_, account, _, _ := in.load(0)
+	lamports := account.Lamports
+	if err := in.store(0, Account{Owner: common.SystemProgramID}); err != nil {
+		return err
+	}
+	_, destination, _, _ := in.load(1)
+	destination.Lamports += lamports
+	return in.store(1, destination)
+}
+
+func tokenSyncNative(in *invocation) any {
+	return updateTokenAccount(in, 0, func(account *Account, data token.TokenAccount) any {
+		if data.IsNative == nil {
+			return customError(tokenErrNonNativeNotSupported)
+		}
+		if account.Lamports < *data.IsNative {
+			return errInvalidAccountData
+		}
+		binary.LittleEndian.PutUint64(account.Data[64:72], account.Lamports-*data.IsNative)
+		return nil
+	})
+}
+
+// loadTokenAccount loads an initialized token account of the program, a frozen one is rejected
+func loadTokenAccount(in *invocation, i int) (common.PublicKey, token.TokenAccount, any) {
+	pubkey, account, _, err := in.load(i)
+	if err != nil {
+		return common.PublicKey{}, token.TokenAccount{}, err
+	}
+	if account.Owner != in.programID {
+		return common.PublicKey{}, token.TokenAccount{}, errIncorrectProgramId
+	}
+	tokenAccount, ok := parseTokenAccount(account)
+	if !ok {
+		return common.PublicKey{}, token.TokenAccount{}, customError(tokenErrUninitializedState)
+	}
+	if tokenAccount.State == token.TokenAccountFrozen {
+		return common.PublicKey{}, token.TokenAccount{}, customError(tokenErrAccountFrozen)
+	}
+	return pubkey, tokenAccount, nil
+}
+
+func updateTokenAccount(in *invocation, i int, f func(account *Account, data token.TokenAccount) any) any {
+	_, account, _, err := in.load(i)
+	if err != nil {
+		return err
+	}
+	data, ok := parseTokenAccount(account)
+	if !ok {
+		return customError(tokenErrUninitializedState)
+	}
+	if err := f(&account, data); err != nil {
+		return err
+	}
+	return in.store(i, account)
+}
+
+// checkMint checks the i-th account is the mint of the token account and, if given, its decimals
+func checkMint(in *invocation, i int, expected common.PublicKey, decimals *uint8) any {
+	pubkey, account, _, err := in.load(i)
+	if err != nil {
+		return err
+	}
+	if pubkey != expected {
+		return customError(tokenErrMintMismatch)
+	}
+	mint, ok := parseMint(account)
+	if !ok || account.Owner != in.programID {
+		return customError(tokenErrInvalidMint)
+	}
+	if decimals != nil && *decimals != mint.Decimals {
+		return customError(tokenErrMintDecimalsMismatch)
+	}
+	return nil
+}
+
+// checkAuthority checks the i-th account is the owner or the delegate of the token account and signs
+func checkAuthority(in *invocation, i int, account token.TokenAccount, amount uint64) any {
+	authority, err := in.pubkey(i)
+	if err != nil {
+		return err
+	}
+	switch {
+	case authority == account.Owner:
+	case account.Delegate != nil && authority == *account.Delegate:
+		if account.DelegatedAmount < amount {
+			return customError(tokenErrInsufficientFunds)
+		}
+	default:
+		return customError(tokenErrOwnerMismatch)
+	}
+	if !in.isSigner(i) {
+		return errMissingRequiredSignature
+	}
+	return nil
+}
+
+// debitDelegate lowers the delegated amount when the delegate spends the tokens
+func debitDelegate(in *invocation, b []byte, account token.TokenAccount, authorityIdx int, amount uint64) {
+	authority, _ := in.pubkey(authorityIdx)
+	if authority == account.Owner || account.Delegate == nil || authority != *account.Delegate {
+		return
+	}
+	remaining := account.DelegatedAmount - amount
+	binary.LittleEndian.PutUint64(b[121:129], remaining)
+	if remaining == 0 {
+		putOptionalPublicKey(b[72:108], nil)
+	}
+}
+
+func initializeTokenAccountData(b []byte, mint, owner common.PublicKey) {
+	copy(b[0:32], mint[:])
+	copy(b[32:64], owner[:])
+	b[108] = byte(token.TokenAccountStateInitialized)
+	if len(b) > token.TokenAccountSize {
+		b[token.TokenAccountSize] = byte(token.AccountTypeAccount)
+	}
+}
+
+func putNative(b []byte, rent, amount uint64) {
+	copy(b[109:113], token.Some)
+	binary.LittleEndian.PutUint64(b[113:121], rent)
+	binary.LittleEndian.PutUint64(b[64:72], amount)
+}
+
+func putOptionalPublicKey(b []byte, pubkey *common.PublicKey) {
+	if pubkey == nil {
+		copy(b[0:36], make([]byte, 36))
+		return
+	}
+	copy(b[0:4], token.Some)
+	copy(b[4:36], pubkey[:])
+}
+
+func isNativeMint(programID, mint common.PublicKey) bool {
+	if programID == common.Token2022ProgramID {
+		return mint == token.Token2022NativeMint
+	}
+	return mint == nativeMint
+}
+
+func isTokenProgram(programID common.PublicKey) bool {
+	return programID == common.TokenProgramID || programID == common.Token2022ProgramID
+}
+
+// parseTokenAccount decodes an initialized token account
+func parseTokenAccount(account Account) (token.TokenAccount, bool) {
+	if !isTokenProgram(account.Owner) || len(account.Data) < token.TokenAccountSize {
+		return token.TokenAccount{}, false
+	}
+	tokenAccount, err := token.TokenAccountFromData(account.Data)
+	if err != nil || tokenAccount.State == token.TokenAccountStateUninitialized {
+		return token.TokenAccount{}, false
+	}
+	return tokenAccount, true
+}
+
+// parseMint decodes an initialized mint
+func parseMint(account Account) (token.MintAccount, bool) {
+	if !isTokenProgram(account.Owner) || len(account.Data) < token.MintAccountSize {
+		return token.MintAccount{}, false
+	}
+	mint, err := token.MintAccountFromData(account.Data)
+	if err != nil || !mint.IsInitialized {
+		return token.MintAccount{}, false
+	}
+	return mint, true
+}
+
+func tokenAmount(amount uint64, decimals uint8) rpc.TokenAccountBalance {
+	return rpc.TokenAccountBalance{
+		Amount:         strconv.FormatUint(amount, 10),
+		Decimals:       decimals,
+		UIAmountString: uiAmountString(amount, decimals),
+	}
+}
+
+func uiAmountString(amount uint64, decimals uint8) string {
+	s := strconv.FormatUint(amount, 10)
+	if decimals == 0 {
+		return s
+	}
+	if len(s) <= int(decimals) {
+		s = strings.Repeat("0", int(decimals)-len(s)+1) + s
+	}
+	s = s[:len(s)-int(decimals)] + "." + s[len(s)-int(decimals):]
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
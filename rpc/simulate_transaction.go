@@ -10,11 +10,14 @@ type SimulateTransaction ValueWithContext[SimulateTransactionValue]
 
 // SimulateTransactionValue is a part of SimulateTransactionResponseResult
 type SimulateTransactionValue struct {
-	Err          any            `json:"err"`
-	Logs         []string       `json:"logs,omitempty"`
-	Accounts     []*AccountInfo `json:"accounts,omitempty"`
-	ReturnData   *ReturnData    `json:"returnData,omitempty"`
-	UnitConsumed *uint64        `json:"unitsConsumed,omitempty"`
+	Err          *TransactionError `json:"err"`
+	Logs         []string          `json:"logs,omitempty"`
+	Accounts     []*AccountInfo    `json:"accounts,omitempty"`
+	ReturnData   *ReturnData       `json:"returnData,omitempty"`
+	UnitConsumed *uint64           `json:"unitsConsumed,omitempty"`
+	// InnerInstructions is returned when SimulateTransactionConfig.InnerInstructions is true,
+	// the instructions are in the jsonParsed format
+	InnerInstructions []TransactionMetaInnerInstruction `json:"innerInstructions,omitempty"`
 }
 
 type SimulateTransactionConfig struct {
@@ -23,6 +26,7 @@ type SimulateTransactionConfig struct {
 	Encoding               SimulateTransactionEncoding        `json:"encoding,omitempty"`               // default: "base58"
 	ReplaceRecentBlockhash bool                               `json:"replaceRecentBlockhash,omitempty"` // default: false, conflicts with sigVerify
 	Accounts               *SimulateTransactionConfigAccounts `json:"accounts,omitempty"`
+	InnerInstructions      bool                               `json:"innerInstructions,omitempty"` // default: false
 }
 
 type SimulateTransactionConfigAccounts struct {
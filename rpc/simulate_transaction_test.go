@@ -56,13 +56,10 @@ func TestSimulateTransaction(t *testing.T) {
 							Slot: 80207873,
 						},
 						Value: SimulateTransactionValue{
-							Err: map[string]any{
-								"InstructionError": []any{
-									0.,
-									map[string]any{
-										"Custom": 1.,
-									},
-								},
+							Err: &TransactionError{
+								Kind:             TransactionErrorInstructionError,
+								InstructionIndex: 0,
+								InstructionError: &InstructionError{Kind: InstructionErrorCustom, Code: 1},
 							},
 							Logs: []string{"Program 11111111111111111111111111111111 invoke [1]", "Transfer: insufficient lamports 109112817160, need 10000000000000", "Program 11111111111111111111111111111111 failed: custom program error: 0x1"},
 						},
@@ -89,7 +86,7 @@ func TestSimulateTransaction(t *testing.T) {
 							Slot: 80208056,
 						},
 						Value: SimulateTransactionValue{
-							Err:  "BlockhashNotFound",
+							Err:  &TransactionError{Kind: TransactionErrorBlockhashNotFound},
 							Logs: []string{},
 						},
 					},
@@ -118,13 +115,10 @@ func TestSimulateTransaction(t *testing.T) {
 							Slot: 80208226,
 						},
 						Value: SimulateTransactionValue{
-							Err: map[string]any{
-								"InstructionError": []any{
-									0.,
-									map[string]any{
-										"Custom": 1.,
-									},
-								},
+							Err: &TransactionError{
+								Kind:             TransactionErrorInstructionError,
+								InstructionIndex: 0,
+								InstructionError: &InstructionError{Kind: InstructionErrorCustom, Code: 1},
 							},
 							Logs: []string{"Program 11111111111111111111111111111111 invoke [1]", "Transfer: insufficient lamports 109112817160, need 10000000000000", "Program 11111111111111111111111111111111 failed: custom program error: 0x1"},
 						},
@@ -0,0 +1,436 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// TransactionErrorKind is a variant of the TransactionError of the node.
+// it is an error so it can be the target of errors.Is, e.g. errors.Is(err, rpc.TransactionErrorBlockhashNotFound)
+type TransactionErrorKind string
+
+const (
+	TransactionErrorAccountInUse                          TransactionErrorKind = "AccountInUse"
+	TransactionErrorAccountLoadedTwice                    TransactionErrorKind = "AccountLoadedTwice"
+	TransactionErrorAccountNotFound                       TransactionErrorKind = "AccountNotFound"
+	TransactionErrorProgramAccountNotFound                TransactionErrorKind = "ProgramAccountNotFound"
+	TransactionErrorInsufficientFundsForFee               TransactionErrorKind = "InsufficientFundsForFee"
+	TransactionErrorInvalidAccountForFee                  TransactionErrorKind = "InvalidAccountForFee"
+	TransactionErrorAlreadyProcessed                      TransactionErrorKind = "AlreadyProcessed"
+	TransactionErrorBlockhashNotFound                     TransactionErrorKind = "BlockhashNotFound"
+	TransactionErrorInstructionError                      TransactionErrorKind = "InstructionError"
+	TransactionErrorCallChainTooDeep                      TransactionErrorKind = "CallChainTooDeep"
+	TransactionErrorMissingSignatureForFee                TransactionErrorKind = "MissingSignatureForFee"
+	TransactionErrorInvalidAccountIndex                   TransactionErrorKind = "InvalidAccountIndex"
+	TransactionErrorSignatureFailure                      TransactionErrorKind = "SignatureFailure"
+	TransactionErrorInvalidProgramForExecution            TransactionErrorKind = "InvalidProgramForExecution"
+	TransactionErrorSanitizeFailure                       TransactionErrorKind = "SanitizeFailure"
+	TransactionErrorClusterMaintenance                    TransactionErrorKind = "ClusterMaintenance"
+	TransactionErrorAccountBorrowOutstanding              TransactionErrorKind = "AccountBorrowOutstanding"
+	TransactionErrorWouldExceedMaxBlockCostLimit          TransactionErrorKind = "WouldExceedMaxBlockCostLimit"
+	TransactionErrorUnsupportedVersion                    TransactionErrorKind = "UnsupportedVersion"
+	TransactionErrorInvalidWritableAccount                TransactionErrorKind = "InvalidWritableAccount"
+	TransactionErrorWouldExceedMaxAccountCostLimit        TransactionErrorKind = "WouldExceedMaxAccountCostLimit"
+	TransactionErrorWouldExceedAccountDataBlockLimit      TransactionErrorKind = "WouldExceedAccountDataBlockLimit"
+	TransactionErrorTooManyAccountLocks                   TransactionErrorKind = "TooManyAccountLocks"
+	TransactionErrorAddressLookupTableNotFound            TransactionErrorKind = "AddressLookupTableNotFound"
+	TransactionErrorInvalidAddressLookupTableOwner        TransactionErrorKind = "InvalidAddressLookupTableOwner"
+	TransactionErrorInvalidAddressLookupTableData         TransactionErrorKind = "InvalidAddressLookupTableData"
+	TransactionErrorInvalidAddressLookupTableIndex        TransactionErrorKind = "InvalidAddressLookupTableIndex"
+	TransactionErrorInvalidRentPayingAccount              TransactionErrorKind = "InvalidRentPayingAccount"
+	TransactionErrorWouldExceedMaxVoteCostLimit           TransactionErrorKind = "WouldExceedMaxVoteCostLimit"
+	TransactionErrorWouldExceedAccountDataTotalLimit      TransactionErrorKind = "WouldExceedAccountDataTotalLimit"
+	TransactionErrorDuplicateInstruction                  TransactionErrorKind = "DuplicateInstruction"
+	TransactionErrorInsufficientFundsForRent              TransactionErrorKind = "InsufficientFundsForRent"
+	TransactionErrorMaxLoadedAccountsDataSizeExceeded     TransactionErrorKind = "MaxLoadedAccountsDataSizeExceeded"
+	TransactionErrorInvalidLoadedAccountsDataSizeLimit    TransactionErrorKind = "InvalidLoadedAccountsDataSizeLimit"
+	TransactionErrorResanitizationNeeded                  TransactionErrorKind = "ResanitizationNeeded"
+	TransactionErrorProgramExecutionTemporarilyRestricted TransactionErrorKind = "ProgramExecutionTemporarilyRestricted"
+	TransactionErrorUnbalancedTransaction                 TransactionErrorKind = "UnbalancedTransaction"
+	TransactionErrorProgramCacheHitMaxLimit               TransactionErrorKind = "ProgramCacheHitMaxLimit"
+	TransactionErrorCommitCancelled                       TransactionErrorKind = "CommitCancelled"
+)
+
+var transactionErrorMessages = map[TransactionErrorKind]string{
+	TransactionErrorAccountInUse:                          "Account in use",
+	TransactionErrorAccountLoadedTwice:                    "Account loaded twice",
+	TransactionErrorAccountNotFound:                       "Attempt to debit an account but found no record of a prior credit.",
+	TransactionErrorProgramAccountNotFound:                "Attempt to load a program that does not exist",
+	TransactionErrorInsufficientFundsForFee:               "Insufficient funds for fee",
+	TransactionErrorInvalidAccountForFee:                  "This account may not be used to pay transaction fees",
+	TransactionErrorAlreadyProcessed:                      "This transaction has already been processed",
+	TransactionErrorBlockhashNotFound:                     "Blockhash not found",
+	TransactionErrorInstructionError:                      "Error processing Instruction",
+	TransactionErrorCallChainTooDeep:                      "Loader call chain is too deep",
+	TransactionErrorMissingSignatureForFee:                "Transaction requires a fee but has no signature present",
+	TransactionErrorInvalidAccountIndex:                   "Transaction contains an invalid account reference",
+	TransactionErrorSignatureFailure:                      "Transaction did not pass signature verification",
+	TransactionErrorInvalidProgramForExecution:            "This program may not be used for executing instructions",
+	TransactionErrorSanitizeFailure:                       "Transaction failed to sanitize accounts offsets correctly",
+	TransactionErrorClusterMaintenance:                    "Transactions are currently disabled due to cluster maintenance",
+	TransactionErrorAccountBorrowOutstanding:              "Transaction processing left an account with an outstanding borrowed reference",
+	TransactionErrorWouldExceedMaxBlockCostLimit:          "Transaction would exceed max Block Cost Limit",
+	TransactionErrorUnsupportedVersion:                    "Transaction version is unsupported",
+	TransactionErrorInvalidWritableAccount:                "Transaction loads a writable account that cannot be written",
+	TransactionErrorWouldExceedMaxAccountCostLimit:        "Transaction would exceed max account limit within the block",
+	TransactionErrorWouldExceedAccountDataBlockLimit:      "Transaction would exceed account data limit within the block",
+	TransactionErrorTooManyAccountLocks:                   "Transaction locked too many accounts",
+	TransactionErrorAddressLookupTableNotFound:            "Transaction loads an address table account that doesn't exist",
+	TransactionErrorInvalidAddressLookupTableOwner:        "Transaction loads an address table account with an invalid owner",
+	TransactionErrorInvalidAddressLookupTableData:         "Transaction loads an address table account with invalid data",
+	TransactionErrorInvalidAddressLookupTableIndex:        "Transaction address table lookup uses an invalid index",
+	TransactionErrorInvalidRentPayingAccount:              "Transaction leaves an account with a lower balance than rent-exempt minimum",
+	TransactionErrorWouldExceedMaxVoteCostLimit:           "Transaction would exceed max Vote Cost Limit",
+	TransactionErrorWouldExceedAccountDataTotalLimit:      "Transaction would exceed total account data limit",
+	TransactionErrorDuplicateInstruction:                  "Transaction contains a duplicate instruction that is not allowed",
+	TransactionErrorInsufficientFundsForRent:              "Transaction results in an account with insufficient funds for rent",
+	TransactionErrorMaxLoadedAccountsDataSizeExceeded:     "Transaction exceeded max loaded accounts data size cap",
+	TransactionErrorInvalidLoadedAccountsDataSizeLimit:    "LoadedAccountsDataSizeLimit set for transaction must be greater than 0.",
+	TransactionErrorResanitizationNeeded:                  "ResanitizationNeeded",
+	TransactionErrorProgramExecutionTemporarilyRestricted: "Execution of the program referenced by account is temporarily restricted.",
+	TransactionErrorUnbalancedTransaction:                 "Sum of account balances before and after transaction do not match",
+	TransactionErrorProgramCacheHitMaxLimit:               "Program cache hit max limit",
+	TransactionErrorCommitCancelled:                       "CommitCancelled",
+}
+
+func (k TransactionErrorKind) Error() string {
+	if msg, ok := transactionErrorMessages[k]; ok {
+		return msg
+	}
+	return string(k)
+}
+
+// InstructionErrorKind is a variant of the InstructionError of the node.
+// it is an error so it can be the target of errors.Is, e.g. errors.Is(err, rpc.InstructionErrorInsufficientFunds)
+type InstructionErrorKind string
+
+const (
+	InstructionErrorGenericError                           InstructionErrorKind = "GenericError"
+	InstructionErrorInvalidArgument                        InstructionErrorKind = "InvalidArgument"
+	InstructionErrorInvalidInstructionData                 InstructionErrorKind = "InvalidInstructionData"
+	InstructionErrorInvalidAccountData                     InstructionErrorKind = "InvalidAccountData"
+	InstructionErrorAccountDataTooSmall                    InstructionErrorKind = "AccountDataTooSmall"
+	InstructionErrorInsufficientFunds                      InstructionErrorKind = "InsufficientFunds"
+	InstructionErrorIncorrectProgramId                     InstructionErrorKind = "IncorrectProgramId"
+	InstructionErrorMissingRequiredSignature               InstructionErrorKind = "MissingRequiredSignature"
+	InstructionErrorAccountAlreadyInitialized              InstructionErrorKind = "AccountAlreadyInitialized"
+	InstructionErrorUninitializedAccount                   InstructionErrorKind = "UninitializedAccount"
+	InstructionErrorUnbalancedInstruction                  InstructionErrorKind = "UnbalancedInstruction"
+	InstructionErrorModifiedProgramId                      InstructionErrorKind = "ModifiedProgramId"
+	InstructionErrorExternalAccountLamportSpend            InstructionErrorKind = "ExternalAccountLamportSpend"
+	InstructionErrorExternalAccountDataModified            InstructionErrorKind = "ExternalAccountDataModified"
+	InstructionErrorReadonlyLamportChange                  InstructionErrorKind = "ReadonlyLamportChange"
+	InstructionErrorReadonlyDataModified                   InstructionErrorKind = "ReadonlyDataModified"
+	InstructionErrorDuplicateAccountIndex                  InstructionErrorKind = "DuplicateAccountIndex"
+	InstructionErrorExecutableModified                     InstructionErrorKind = "ExecutableModified"
+	InstructionErrorRentEpochModified                      InstructionErrorKind = "RentEpochModified"
+	InstructionErrorNotEnoughAccountKeys                   InstructionErrorKind = "NotEnoughAccountKeys"
+	InstructionErrorAccountDataSizeChanged                 InstructionErrorKind = "AccountDataSizeChanged"
+	InstructionErrorAccountNotExecutable                   InstructionErrorKind = "AccountNotExecutable"
+	InstructionErrorAccountBorrowFailed                    InstructionErrorKind = "AccountBorrowFailed"
+	InstructionErrorAccountBorrowOutstanding               InstructionErrorKind = "AccountBorrowOutstanding"
+	InstructionErrorDuplicateAccountOutOfSync              InstructionErrorKind = "DuplicateAccountOutOfSync"
+	InstructionErrorCustom                                 InstructionErrorKind = "Custom"
+	InstructionErrorInvalidError                           InstructionErrorKind = "InvalidError"
+	InstructionErrorExecutableDataModified                 InstructionErrorKind = "ExecutableDataModified"
+	InstructionErrorExecutableLamportChange                InstructionErrorKind = "ExecutableLamportChange"
+	InstructionErrorExecutableAccountNotRentExempt         InstructionErrorKind = "ExecutableAccountNotRentExempt"
+	InstructionErrorUnsupportedProgramId                   InstructionErrorKind = "UnsupportedProgramId"
+	InstructionErrorCallDepth                              InstructionErrorKind = "CallDepth"
+	InstructionErrorMissingAccount                         InstructionErrorKind = "MissingAccount"
+	InstructionErrorReentrancyNotAllowed                   InstructionErrorKind = "ReentrancyNotAllowed"
+	InstructionErrorMaxSeedLengthExceeded                  InstructionErrorKind = "MaxSeedLengthExceeded"
+	InstructionErrorInvalidSeeds                           InstructionErrorKind = "InvalidSeeds"
+	InstructionErrorInvalidRealloc                         InstructionErrorKind = "InvalidRealloc"
+	InstructionErrorComputationalBudgetExceeded            InstructionErrorKind = "ComputationalBudgetExceeded"
+	InstructionErrorPrivilegeEscalation                    InstructionErrorKind = "PrivilegeEscalation"
+	InstructionErrorProgramEnvironmentSetupFailure         InstructionErrorKind = "ProgramEnvironmentSetupFailure"
+	InstructionErrorProgramFailedToComplete                InstructionErrorKind = "ProgramFailedToComplete"
+	InstructionErrorProgramFailedToCompile                 InstructionErrorKind = "ProgramFailedToCompile"
+	InstructionErrorImmutable                              InstructionErrorKind = "Immutable"
+	InstructionErrorIncorrectAuthority                     InstructionErrorKind = "IncorrectAuthority"
+	InstructionErrorBorshIoError                           InstructionErrorKind = "BorshIoError"
+	InstructionErrorAccountNotRentExempt                   InstructionErrorKind = "AccountNotRentExempt"
+	InstructionErrorInvalidAccountOwner                    InstructionErrorKind = "InvalidAccountOwner"
+	InstructionErrorArithmeticOverflow                     InstructionErrorKind = "ArithmeticOverflow"
+	InstructionErrorUnsupportedSysvar                      InstructionErrorKind = "UnsupportedSysvar"
+	InstructionErrorIllegalOwner                           InstructionErrorKind = "IllegalOwner"
+	InstructionErrorMaxAccountsDataAllocationsExceeded     InstructionErrorKind = "MaxAccountsDataAllocationsExceeded"
+	InstructionErrorMaxAccountsExceeded                    InstructionErrorKind = "MaxAccountsExceeded"
+	InstructionErrorMaxInstructionTraceLengthExceeded      InstructionErrorKind = "MaxInstructionTraceLengthExceeded"
+	InstructionErrorBuiltinProgramsMustConsumeComputeUnits InstructionErrorKind = "BuiltinProgramsMustConsumeComputeUnits"
+)
+
+var instructionErrorMessages = map[InstructionErrorKind]string{
+	InstructionErrorGenericError:                           "generic instruction error",
+	InstructionErrorInvalidArgument:                        "invalid program argument",
+	InstructionErrorInvalidInstructionData:                 "invalid instruction data",
+	InstructionErrorInvalidAccountData:                     "invalid account data for instruction",
+	InstructionErrorAccountDataTooSmall:                    "account data too small for instruction",
+	InstructionErrorInsufficientFunds:                      "insufficient funds for instruction",
+	InstructionErrorIncorrectProgramId:                     "incorrect program id for instruction",
+	InstructionErrorMissingRequiredSignature:               "missing required signature for instruction",
+	InstructionErrorAccountAlreadyInitialized:              "instruction requires an uninitialized account",
+	InstructionErrorUninitializedAccount:                   "instruction requires an initialized account",
+	InstructionErrorUnbalancedInstruction:                  "sum of account balances before and after instruction do not match",
+	InstructionErrorModifiedProgramId:                      "instruction illegally modified the program id of an account",
+	InstructionErrorExternalAccountLamportSpend:            "instruction spent from the balance of an account it does not own",
+	InstructionErrorExternalAccountDataModified:            "instruction modified data of an account it does not own",
+	InstructionErrorReadonlyLamportChange:                  "instruction changed the balance of a read-only account",
+	InstructionErrorReadonlyDataModified:                   "instruction modified data of a read-only account",
+	InstructionErrorDuplicateAccountIndex:                  "instruction contains duplicate accounts",
+	InstructionErrorExecutableModified:                     "instruction changed executable bit of an account",
+	InstructionErrorRentEpochModified:                      "instruction modified rent epoch of an account",
+	InstructionErrorNotEnoughAccountKeys:                   "insufficient account keys for instruction",
+	InstructionErrorAccountDataSizeChanged:                 "program other than the account's owner changed the size of the account data",
+	InstructionErrorAccountNotExecutable:                   "instruction expected an executable account",
+	InstructionErrorAccountBorrowFailed:                    "instruction tries to borrow reference for an account which is already borrowed",
+	InstructionErrorAccountBorrowOutstanding:               "instruction left account with an outstanding borrowed reference",
+	InstructionErrorDuplicateAccountOutOfSync:              "instruction modifications of multiply-passed account differ",
+	InstructionErrorCustom:                                 "custom program error",
+	InstructionErrorInvalidError:                           "program returned invalid error code",
+	InstructionErrorExecutableDataModified:                 "instruction changed executable accounts data",
+	InstructionErrorExecutableLamportChange:                "instruction changed the balance of an executable account",
+	InstructionErrorExecutableAccountNotRentExempt:         "executable accounts must be rent exempt",
+	InstructionErrorUnsupportedProgramId:                   "Unsupported program id",
+	InstructionErrorCallDepth:                              "Cross-program invocation call depth too deep",
+	InstructionErrorMissingAccount:                         "An account required by the instruction is missing",
+	InstructionErrorReentrancyNotAllowed:                   "Cross-program invocation reentrancy not allowed for this instruction",
+	InstructionErrorMaxSeedLengthExceeded:                  "Length of the seed is too long for address generation",
+	InstructionErrorInvalidSeeds:                           "Provided seeds do not result in a valid address",
+	InstructionErrorInvalidRealloc:                         "Failed to reallocate account data",
+	InstructionErrorComputationalBudgetExceeded:            "Computational budget exceeded",
+	InstructionErrorPrivilegeEscalation:                    "Cross-program invocation with unauthorized signer or writable account",
+	InstructionErrorProgramEnvironmentSetupFailure:         "Failed to create program execution environment",
+	InstructionErrorProgramFailedToComplete:                "Program failed to complete",
+	InstructionErrorProgramFailedToCompile:                 "Program failed to compile",
+	InstructionErrorImmutable:                              "Account is immutable",
+	InstructionErrorIncorrectAuthority:                     "Incorrect authority provided",
+	InstructionErrorBorshIoError:                           "Failed to serialize or deserialize account data",
+	InstructionErrorAccountNotRentExempt:                   "An account does not have enough lamports to be rent-exempt",
+	InstructionErrorInvalidAccountOwner:                    "Invalid account owner",
+	InstructionErrorArithmeticOverflow:                     "Program arithmetic overflowed",
+	InstructionErrorUnsupportedSysvar:                      "Unsupported sysvar",
+	InstructionErrorIllegalOwner:                           "Provided owner is not allowed",
+	InstructionErrorMaxAccountsDataAllocationsExceeded:     "Accounts data allocations exceeded the maximum allowed per transaction",
+	InstructionErrorMaxAccountsExceeded:                    "Max accounts exceeded",
+	InstructionErrorMaxInstructionTraceLengthExceeded:      "Max instruction trace length exceeded",
+	InstructionErrorBuiltinProgramsMustConsumeComputeUnits: "Builtin programs must consume compute units",
+}
+
+func (k InstructionErrorKind) Error() string {
+	if msg, ok := instructionErrorMessages[k]; ok {
+		return msg
+	}
+	return string(k)
+}
+
+// TransactionError is the error of a failed transaction, it is `null` or one of
+// "BlockhashNotFound", {"InstructionError":[0,{"Custom":1}]}, {"DuplicateInstruction":0} and
+// {"InsufficientFundsForRent":{"account_index":0}} in json.
+type TransactionError struct {
+	Kind TransactionErrorKind
+	// InstructionIndex is the index of the instruction of InstructionError and DuplicateInstruction
+	InstructionIndex uint8
+	// AccountIndex is the index of the account of InsufficientFundsForRent and ProgramExecutionTemporarilyRestricted
+	AccountIndex uint8
+	// InstructionError is set if Kind is InstructionError
+	InstructionError *InstructionError
+
+	// raw keeps the json of a variant the sdk doesn't know
+	raw json.RawMessage
+}
+
+func (e *TransactionError) Error() string {
+	switch e.Kind {
+	case TransactionErrorInstructionError:
+		return fmt.Sprintf("Error processing Instruction %v: %v", e.InstructionIndex, e.InstructionError)
+	case TransactionErrorDuplicateInstruction:
+		return fmt.Sprintf("Transaction contains a duplicate instruction (%v) that is not allowed", e.InstructionIndex)
+	case TransactionErrorInsufficientFundsForRent:
+		return fmt.Sprintf("Transaction results in an account (%v) with insufficient funds for rent", e.AccountIndex)
+	case TransactionErrorProgramExecutionTemporarilyRestricted:
+		return fmt.Sprintf("Execution of the program referenced by account at index %v is temporarily restricted.", e.AccountIndex)
+	}
+	return e.Kind.Error()
+}
+
+// Is reports whether the target is the kind of the error
+func (e *TransactionError) Is(target error) bool {
+	kind, ok := target.(TransactionErrorKind)
+	return ok && kind == e.Kind
+}
+
+// Unwrap returns the InstructionError if there is one
+func (e *TransactionError) Unwrap() error {
+	if e.InstructionError == nil {
+		return nil
+	}
+	return e.InstructionError
+}
+
+func (e TransactionError) MarshalJSON() ([]byte, error) {
+	switch e.Kind {
+	case TransactionErrorInstructionError:
+		if e.InstructionError == nil {
+			return nil, fmt.Errorf("InstructionError is required")
+		}
+		return json.Marshal(map[TransactionErrorKind][]any{e.Kind: {e.InstructionIndex, e.InstructionError}})
+	case TransactionErrorDuplicateInstruction:
+		return json.Marshal(map[TransactionErrorKind]uint8{e.Kind: e.InstructionIndex})
+	case TransactionErrorInsufficientFundsForRent, TransactionErrorProgramExecutionTemporarilyRestricted:
+		return json.Marshal(map[TransactionErrorKind]map[string]uint8{e.Kind: {"account_index": e.AccountIndex}})
+	}
+	if len(e.raw) > 0 {
+		return e.raw, nil
+	}
+	return json.Marshal(string(e.Kind))
+}
+
+func (e *TransactionError) UnmarshalJSON(data []byte) error {
+	var kind string
+	if err := json.Unmarshal(data, &kind); err == nil {
+		*e = TransactionError{Kind: TransactionErrorKind(kind)}
+		return nil
+	}
+
+	var m map[TransactionErrorKind]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("failed to unmarshal transaction error, err: %w", err)
+	}
+	if len(m) != 1 {
+		return fmt.Errorf("unexpected transaction error: %s", data)
+	}
+	for k, v := range m {
+		result := TransactionError{Kind: k}
+		switch k {
+		case TransactionErrorInstructionError:
+			var fields []json.RawMessage
+			if err := json.Unmarshal(v, &fields); err != nil {
+				return fmt.Errorf("failed to unmarshal instruction error, err: %w", err)
+			}
+			if len(fields) != 2 {
+				return fmt.Errorf("unexpected instruction error: %s", v)
+			}
+			if err := json.Unmarshal(fields[0], &result.InstructionIndex); err != nil {
+				return fmt.Errorf("failed to unmarshal instruction index, err: %w", err)
+			}
+			result.InstructionError = &InstructionError{}
+			if err := json.Unmarshal(fields[1], result.InstructionError); err != nil {
+				return err
+			}
+		case TransactionErrorDuplicateInstruction:
+			if err := json.Unmarshal(v, &result.InstructionIndex); err != nil {
+				return fmt.Errorf("failed to unmarshal instruction index, err: %w", err)
+			}
+		case TransactionErrorInsufficientFundsForRent, TransactionErrorProgramExecutionTemporarilyRestricted:
+			var fields struct {
+				AccountIndex uint8 `json:"account_index"`
+			}
+			if err := json.Unmarshal(v, &fields); err != nil {
+				return fmt.Errorf("failed to unmarshal account index, err: %w", err)
+			}
+			result.AccountIndex = fields.AccountIndex
+		default:
+			result.raw = append(json.RawMessage{}, bytes.TrimSpace(data)...)
+		}
+		*e = result
+	}
+	return nil
+}
+
+// InstructionError is the error of the instruction which failed, it is like "InvalidArgument",
+// {"Custom":1} or {"BorshIoError":"Unknown"} in json.
+type InstructionError struct {
+	Kind InstructionErrorKind
+	// Code is set if Kind is Custom
+	Code uint32
+	// Message is set if Kind is BorshIoError
+	Message string
+
+	// raw keeps the json of a variant the sdk doesn't know
+	raw json.RawMessage
+}
+
+func (e *InstructionError) Error() string {
+	switch e.Kind {
+	case InstructionErrorCustom:
+		return fmt.Sprintf("custom program error: 0x%x", e.Code)
+	case InstructionErrorBorshIoError:
+		return fmt.Sprintf("Failed to serialize or deserialize account data: %v", e.Message)
+	}
+	return e.Kind.Error()
+}
+
+// Is reports whether the target is the kind of the error
+func (e *InstructionError) Is(target error) bool {
+	kind, ok := target.(InstructionErrorKind)
+	return ok && kind == e.Kind
+}
+
+func (e InstructionError) MarshalJSON() ([]byte, error) {
+	switch e.Kind {
+	case InstructionErrorCustom:
+		return json.Marshal(map[InstructionErrorKind]uint32{e.Kind: e.Code})
+	case InstructionErrorBorshIoError:
+		return json.Marshal(map[InstructionErrorKind]string{e.Kind: e.Message})
+	}
+	if len(e.raw) > 0 {
+		return e.raw, nil
+	}
+	return json.Marshal(string(e.Kind))
+}
+
+func (e *InstructionError) UnmarshalJSON(data []byte) error {
+	var kind string
+	if err := json.Unmarshal(data, &kind); err == nil {
+		*e = InstructionError{Kind: InstructionErrorKind(kind)}
+		return nil
+	}
+
+	var m map[InstructionErrorKind]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("failed to unmarshal instruction error, err: %w", err)
+	}
+	if len(m) != 1 {
+		return fmt.Errorf("unexpected instruction error: %s", data)
+	}
+	for k, v := range m {
+		result := InstructionError{Kind: k}
+		switch k {
+		case InstructionErrorCustom:
+			if err := json.Unmarshal(v, &result.Code); err != nil {
+				return fmt.Errorf("failed to unmarshal custom error code, err: %w", err)
+			}
+		case InstructionErrorBorshIoError:
+			if err := json.Unmarshal(v, &result.Message); err != nil {
+				return fmt.Errorf("failed to unmarshal borsh io error, err: %w", err)
+			}
+		default:
+			result.raw = append(json.RawMessage{}, bytes.TrimSpace(data)...)
+		}
+		*e = result
+	}
+	return nil
+}
+
+// ParseTransactionError converts a transaction error which was decoded into `any`, e.g. the `err` in
+// JsonRpcError.Data, into a *TransactionError. it returns nil if v is nil.
+func ParseTransactionError(v any) (*TransactionError, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if e, ok := v.(*TransactionError); ok {
+		return e, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transaction error, err: %w", err)
+	}
+	var e TransactionError
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionError_JSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		want      *TransactionError
+		wantError string
+	}{
+		{
+			name:      "string",
+			json:      `"BlockhashNotFound"`,
+			want:      &TransactionError{Kind: TransactionErrorBlockhashNotFound},
+			wantError: "Blockhash not found",
+		},
+		{
+			name: "custom",
+			json: `{"InstructionError":[1,{"Custom":6001}]}`,
+			want: &TransactionError{
+				Kind:             TransactionErrorInstructionError,
+				InstructionIndex: 1,
+				InstructionError: &InstructionError{Kind: InstructionErrorCustom, Code: 6001},
+			},
+			wantError: "Error processing Instruction 1: custom program error: 0x1771",
+		},
+		{
+			name: "instruction error",
+			json: `{"InstructionError":[0,"InsufficientFunds"]}`,
+			want: &TransactionError{
+				Kind:             TransactionErrorInstructionError,
+				InstructionError: &InstructionError{Kind: InstructionErrorInsufficientFunds},
+			},
+			wantError: "Error processing Instruction 0: insufficient funds for instruction",
+		},
+		{
+			name: "borsh io error",
+			json: `{"InstructionError":[2,{"BorshIoError":"Unknown"}]}`,
+			want: &TransactionError{
+				Kind:             TransactionErrorInstructionError,
+				InstructionIndex: 2,
+				InstructionError: &InstructionError{Kind: InstructionErrorBorshIoError, Message: "Unknown"},
+			},
+			wantError: "Error processing Instruction 2: Failed to serialize or deserialize account data: Unknown",
+		},
+		{
+			name:      "duplicate instruction",
+			json:      `{"DuplicateInstruction":3}`,
+			want:      &TransactionError{Kind: TransactionErrorDuplicateInstruction, InstructionIndex: 3},
+			wantError: "Transaction contains a duplicate instruction (3) that is not allowed",
+		},
+		{
+			name:      "insufficient funds for rent",
+			json:      `{"InsufficientFundsForRent":{"account_index":2}}`,
+			want:      &TransactionError{Kind: TransactionErrorInsufficientFundsForRent, AccountIndex: 2},
+			wantError: "Transaction results in an account (2) with insufficient funds for rent",
+		},
+		{
+			name:      "unknown",
+			json:      `{"SomethingNew":{"a":1}}`,
+			want:      &TransactionError{Kind: "SomethingNew", raw: json.RawMessage(`{"SomethingNew":{"a":1}}`)},
+			wantError: "SomethingNew",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *TransactionError
+			assert.Nil(t, json.Unmarshal([]byte(tt.json), &got))
+			assert.Equal(t, tt.want, got)
+			assert.EqualError(t, got, tt.wantError)
+
+			b, err := json.Marshal(got)
+			assert.Nil(t, err)
+			assert.JSONEq(t, tt.json, string(b))
+		})
+	}
+
+	var got *TransactionError
+	assert.Nil(t, json.Unmarshal([]byte(`null`), &got))
+	assert.Nil(t, got)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"InstructionError":[0]}`), &got))
+	assert.NotNil(t, json.Unmarshal([]byte(`{"A":1,"B":2}`), &got))
+	assert.NotNil(t, json.Unmarshal([]byte(`1`), &got))
+}
+
+func TestTransactionError_Is(t *testing.T) {
+	var err error = &TransactionError{
+		Kind:             TransactionErrorInstructionError,
+		InstructionError: &InstructionError{Kind: InstructionErrorCustom, Code: 1},
+	}
+	assert.ErrorIs(t, err, TransactionErrorInstructionError)
+	assert.ErrorIs(t, err, InstructionErrorCustom)
+	assert.False(t, errors.Is(err, TransactionErrorBlockhashNotFound))
+	assert.False(t, errors.Is(err, InstructionErrorInsufficientFunds))
+
+	var instructionErr *InstructionError
+	assert.True(t, errors.As(err, &instructionErr))
+	assert.Equal(t, uint32(1), instructionErr.Code)
+
+	err = &TransactionError{Kind: TransactionErrorBlockhashNotFound}
+	assert.ErrorIs(t, err, TransactionErrorBlockhashNotFound)
+	assert.False(t, errors.As(err, &instructionErr))
+}
+
+func TestParseTransactionError(t *testing.T) {
+	got, err := ParseTransactionError(map[string]any{"InstructionError": []any{float64(0), map[string]any{"Custom": float64(1)}}})
+	assert.Nil(t, err)
+	assert.Equal(t, &TransactionError{
+		Kind:             TransactionErrorInstructionError,
+		InstructionError: &InstructionError{Kind: InstructionErrorCustom, Code: 1},
+	}, got)
+
+	got, err = ParseTransactionError(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, got)
+
+	_, err = ParseTransactionError(1)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,173 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRetryMinBackoff  = 200 * time.Millisecond
+	defaultRetryMaxBackoff  = 10 * time.Second
+	defaultEndpointCooldown = 30 * time.Second
+)
+
+type retryConfig struct {
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+// backoff returns the delay before the retry after the attempt (0-based)
+func (r *retryConfig) backoff(attempt int) time.Duration {
+	d := r.minBackoff
+	for i := 0; i < attempt && d < r.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > r.maxBackoff {
+		d = r.maxBackoff
+	}
+	return d
+}
+
+// isRetryableStatus reports whether the status code is worth a retry
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// parseRetryAfter parses the Retry-After header which is either seconds or a http date
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		if seconds > int(math.MaxInt64/time.Second) {
+			return math.MaxInt64
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// rateLimiter is a token bucket which is shared by the copies of a RpcClient
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available or the ctx is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// endpointPool keeps the health of the endpoints. requests go to the first healthy endpoint in order,
+// an endpoint which failed is skipped until its cooldown passes.
+type endpointPool struct {
+	mu             sync.Mutex
+	endpoints      []string
+	unhealthyUntil []time.Time
+	cooldown       time.Duration
+}
+
+func newEndpointPool(endpoints []string, cooldown time.Duration) *endpointPool {
+	return &endpointPool{
+		endpoints:      endpoints,
+		unhealthyUntil: make([]time.Time, len(endpoints)),
+		cooldown:       cooldown,
+	}
+}
+
+// pick returns the first healthy endpoint which hasn't been tried by the current call. if every endpoint
+// is unhealthy, it returns the one which recovers first.
+func (p *endpointPool) pick(tried map[int]bool) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	best := -1
+	for i := range p.endpoints {
+		if now.After(p.unhealthyUntil[i]) && !tried[i] {
+			return i
+		}
+		if best == -1 || p.unhealthyUntil[i].Before(p.unhealthyUntil[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+func (p *endpointPool) markHealthy(i int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.unhealthyUntil[i] = time.Time{}
+}
+
+func (p *endpointPool) markUnhealthy(i int, retryAfter time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	cooldown := p.cooldown
+	if retryAfter > cooldown {
+		cooldown = retryAfter
+	}
+	p.unhealthyUntil[i] = time.Now().Add(cooldown)
+}
+
+// hasHealthy reports whether an endpoint which hasn't been tried is healthy
+func (p *endpointPool) hasHealthy(tried map[int]bool) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	for i := range p.endpoints {
+		if now.After(p.unhealthyUntil[i]) && !tried[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,227 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const transportTestResponse = `{"jsonrpc":"2.0","result":1,"id":1}`
+
+// newStatusServer replies with the statuses in order and 200 after them
+func newStatusServer(statuses ...int) (*httptest.Server, *int32) {
+	var count int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		n := int(atomic.AddInt32(&count, 1))
+		if n <= len(statuses) {
+			rw.WriteHeader(statuses[n-1])
+			return
+		}
+		_, _ = rw.Write([]byte(transportTestResponse))
+	}))
+	return s, &count
+}
+
+func TestRpcClient_Call_Retry(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		maxRetries   int
+		wantRequests int32
+		wantErr      bool
+	}{
+		{
+			name:         "retry 5xx and 429",
+			statuses:     []int{http.StatusBadGateway, http.StatusTooManyRequests},
+			maxRetries:   3,
+			wantRequests: 3,
+		},
+		{
+			name:         "retries exhausted",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			maxRetries:   2,
+			wantRequests: 3,
+			wantErr:      true,
+		},
+		{
+			name:         "no retry on 4xx",
+			statuses:     []int{http.StatusBadRequest},
+			maxRetries:   3,
+			wantRequests: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, count := newStatusServer(tt.statuses...)
+			defer s.Close()
+
+			c := New(WithEndpoint(s.URL), WithRetry(tt.maxRetries, time.Millisecond, 5*time.Millisecond))
+			body, err := c.Call(context.Background(), "getSlot")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.JSONEq(t, transportTestResponse, string(body))
+			}
+			assert.Equal(t, tt.wantRequests, atomic.LoadInt32(count))
+		})
+	}
+}
+
+func TestRpcClient_Call_NoRetryByDefault(t *testing.T) {
+	s, count := newStatusServer(http.StatusInternalServerError)
+	defer s.Close()
+
+	c := New(WithEndpoint(s.URL))
+	_, err := c.Call(context.Background(), "getSlot")
+	assert.EqualError(t, err, "get status code: 500")
+	assert.Equal(t, int32(1), atomic.LoadInt32(count))
+}
+
+func TestRpcClient_Call_Failover(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+	downURL := down.URL
+	down.Close()
+
+	up, count := newStatusServer()
+	defer up.Close()
+
+	c := New(WithEndpoints(downURL, up.URL))
+	for i := 0; i < 2; i++ {
+		body, err := c.Call(context.Background(), "getSlot")
+		assert.Nil(t, err)
+		assert.JSONEq(t, transportTestResponse, string(body))
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(count))
+
+	// the failed endpoint is skipped during its cooldown
+	c.endpoints.mu.Lock()
+	assert.True(t, c.endpoints.unhealthyUntil[0].After(time.Now()))
+	c.endpoints.mu.Unlock()
+}
+
+func TestRpcClient_Call_FailoverRecover(t *testing.T) {
+	primary, primaryCount := newStatusServer(http.StatusServiceUnavailable)
+	defer primary.Close()
+	secondary, secondaryCount := newStatusServer()
+	defer secondary.Close()
+
+	c := New(WithEndpoints(primary.URL, secondary.URL), WithEndpointCooldown(20*time.Millisecond))
+	_, err := c.Call(context.Background(), "getSlot")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(primaryCount))
+	assert.Equal(t, int32(1), atomic.LoadInt32(secondaryCount))
+
+	// the primary is used again after the cooldown
+	time.Sleep(30 * time.Millisecond)
+	_, err = c.Call(context.Background(), "getSlot")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(primaryCount))
+	assert.Equal(t, int32(1), atomic.LoadInt32(secondaryCount))
+}
+
+func TestRpcClient_Call_RateLimit(t *testing.T) {
+	s, count := newStatusServer()
+	defer s.Close()
+
+	c := New(WithEndpoint(s.URL), WithRateLimit(50, 1))
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := c.Call(context.Background(), "getSlot")
+		assert.Nil(t, err)
+	}
+	// the first request uses the burst, the other 4 wait 20ms each
+	assert.GreaterOrEqual(t, time.Since(start), 70*time.Millisecond)
+	assert.Equal(t, int32(5), atomic.LoadInt32(count))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.Call(ctx, "getSlot")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRpcClient_Call_RetryAfterCapped(t *testing.T) {
+	var count int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			rw.Header().Set("Retry-After", "3600")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = rw.Write([]byte(transportTestResponse))
+	}))
+	defer s.Close()
+
+	c := New(WithEndpoint(s.URL), WithRetry(1, time.Millisecond, 5*time.Millisecond))
+	start := time.Now()
+	_, err := c.Call(context.Background(), "getSlot")
+	assert.Nil(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+}
+
+func TestRpcClient_Call_RetryAfterOverridesBackoff(t *testing.T) {
+	var count int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			rw.Header().Set("Retry-After", "1")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = rw.Write([]byte(transportTestResponse))
+	}))
+	defer s.Close()
+
+	// a short Retry-After isn't stretched to the longer backoff
+	c := New(WithEndpoint(s.URL), WithRetry(1, 10*time.Second, 10*time.Second))
+	start := time.Now()
+	_, err := c.Call(context.Background(), "getSlot")
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+}
+
+func TestWithRateLimit_NonPositiveRate(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		c := New(WithRateLimit(rate, 1))
+		assert.Nil(t, c.rateLimiter)
+	}
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		v    string
+		want time.Duration
+	}{
+		{name: "empty", v: "", want: 0},
+		{name: "seconds", v: "3", want: 3 * time.Second},
+		{name: "date", v: now.Add(5 * time.Second).Format(http.TimeFormat), want: 5 * time.Second},
+		{name: "past date", v: now.Add(-5 * time.Second).Format(http.TimeFormat), want: 0},
+		{name: "invalid", v: "soon", want: 0},
+		{name: "overflow", v: "99999999999999999", want: math.MaxInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRetryAfter(tt.v, now))
+		})
+	}
+}
+
+func Test_retryConfig_backoff(t *testing.T) {
+	r := retryConfig{minBackoff: 100 * time.Millisecond, maxBackoff: time.Second}
+	assert.Equal(t, 100*time.Millisecond, r.backoff(0))
+	assert.Equal(t, 200*time.Millisecond, r.backoff(1))
+	assert.Equal(t, 800*time.Millisecond, r.backoff(3))
+	assert.Equal(t, time.Second, r.backoff(4))
+	assert.Equal(t, time.Second, r.backoff(40))
+}
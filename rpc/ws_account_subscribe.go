@@ -0,0 +1,19 @@
+package rpc
+
+import "context"
+
+// AccountSubscribeConfig is an option config for `accountSubscribe`
+type AccountSubscribeConfig struct {
+	Commitment Commitment      `json:"commitment,omitempty"`
+	Encoding   AccountEncoding `json:"encoding,omitempty"`
+}
+
+// AccountSubscribe subscribes to an account to receive notifications when the lamports or data changes
+func (c *WsClient) AccountSubscribe(ctx context.Context, base58Addr string) (*Subscription[ValueWithContext[AccountInfo]], error) {
+	return subscribe[ValueWithContext[AccountInfo]](c, ctx, "accountSubscribe", "accountUnsubscribe", nil, base58Addr, AccountSubscribeConfig{Encoding: AccountEncodingBase64})
+}
+
+// AccountSubscribeWithConfig subscribes to an account to receive notifications when the lamports or data changes
+func (c *WsClient) AccountSubscribeWithConfig(ctx context.Context, base58Addr string, cfg AccountSubscribeConfig) (*Subscription[ValueWithContext[AccountInfo]], error) {
+	return subscribe[ValueWithContext[AccountInfo]](c, ctx, "accountSubscribe", "accountUnsubscribe", nil, base58Addr, cfg)
+}
@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAccountSubscribe(t *testing.T) {
+	testWsAll(
+		t,
+		[]wsParam{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"accountSubscribe","params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",{"encoding":"base64"}]}`,
+				Notification: `{"context":{"slot":5199307},"value":{"data":["AQID","base64"],"executable":false,"lamports":33594,"owner":"11111111111111111111111111111111","rentEpoch":635}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.AccountSubscribe(context.Background(), "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[AccountInfo]{
+					Context: Context{
+						Slot: 5199307,
+					},
+					Value: AccountInfo{
+						Lamports:   33594,
+						Owner:      "11111111111111111111111111111111",
+						RentEpoch:  635,
+						Data:       []any{"AQID", "base64"},
+						Executable: false,
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"accountSubscribe","params":["RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",{"commitment":"finalized","encoding":"base58"}]}`,
+				Notification: `{"context":{"slot":5199307},"value":{"data":["Ldp","base58"],"executable":false,"lamports":33594,"owner":"11111111111111111111111111111111","rentEpoch":635}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.AccountSubscribeWithConfig(
+						context.Background(),
+						"RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7",
+						AccountSubscribeConfig{
+							Commitment: CommitmentFinalized,
+							Encoding:   AccountEncodingBase58,
+						},
+					)
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[AccountInfo]{
+					Context: Context{
+						Slot: 5199307,
+					},
+					Value: AccountInfo{
+						Lamports:   33594,
+						Owner:      "11111111111111111111111111111111",
+						RentEpoch:  635,
+						Data:       []any{"Ldp", "base58"},
+						Executable: false,
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// BlockSubscribeFilter selects blocks by mentioned account. an empty filter means "all".
+type BlockSubscribeFilter struct {
+	MentionsAccountOrProgram string
+}
+
+func (f BlockSubscribeFilter) MarshalJSON() ([]byte, error) {
+	if f.MentionsAccountOrProgram == "" {
+		return json.Marshal("all")
+	}
+	return json.Marshal(struct {
+		MentionsAccountOrProgram string `json:"mentionsAccountOrProgram"`
+	}{
+		MentionsAccountOrProgram: f.MentionsAccountOrProgram,
+	})
+}
+
+// BlockSubscribeConfig is an option config for `blockSubscribe`
+type BlockSubscribeConfig struct {
+	Commitment                     Commitment                       `json:"commitment,omitempty"`
+	Encoding                       GetBlockConfigEncoding           `json:"encoding,omitempty"`
+	TransactionDetails             GetBlockConfigTransactionDetails `json:"transactionDetails,omitempty"`
+	ShowRewards                    *bool                            `json:"showRewards,omitempty"`
+	MaxSupportedTransactionVersion *uint8                           `json:"maxSupportedTransactionVersion,omitempty"`
+}
+
+type BlockNotification struct {
+	Slot  uint64            `json:"slot"`
+	Err   *TransactionError `json:"err"`
+	Block *GetBlock         `json:"block"`
+}
+
+// BlockSubscribe subscribes to receive notification anytime a new block is confirmed or finalized
+func (c *WsClient) BlockSubscribe(ctx context.Context, filter BlockSubscribeFilter) (*Subscription[ValueWithContext[BlockNotification]], error) {
+	return subscribe[ValueWithContext[BlockNotification]](c, ctx, "blockSubscribe", "blockUnsubscribe", nil, filter)
+}
+
+// BlockSubscribeWithConfig subscribes to receive notification anytime a new block is confirmed or finalized
+func (c *WsClient) BlockSubscribeWithConfig(ctx context.Context, filter BlockSubscribeFilter, cfg BlockSubscribeConfig) (*Subscription[ValueWithContext[BlockNotification]], error) {
+	return subscribe[ValueWithContext[BlockNotification]](c, ctx, "blockSubscribe", "blockUnsubscribe", nil, filter, cfg)
+}
@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/pointer"
+)
+
+func TestBlockSubscribe(t *testing.T) {
+	testWsAll(
+		t,
+		[]wsParam{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"blockSubscribe","params":["all"]}`,
+				Notification: `{"context":{"slot":112301554},"value":{"slot":112301554,"block":{"previousBlockhash":"GJp125YAN4ufCSUvZJVdCyWQJ7RPWMmwxoyUQySydZA","blockhash":"6ojMHjctdqfB55JDpEpqfHnP96fiaHEcvzEQ2NNcxzHP","parentSlot":112301553,"transactions":[],"blockTime":1639926816,"blockHeight":101210751},"err":null}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.BlockSubscribe(context.Background(), BlockSubscribeFilter{})
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[BlockNotification]{
+					Context: Context{
+						Slot: 112301554,
+					},
+					Value: BlockNotification{
+						Slot: 112301554,
+						Err:  nil,
+						Block: &GetBlock{
+							Blockhash:         common.MustParseHash("6ojMHjctdqfB55JDpEpqfHnP96fiaHEcvzEQ2NNcxzHP"),
+							BlockTime:         pointer.Get[int64](1639926816),
+							BlockHeight:       pointer.Get[int64](101210751),
+							PreviousBlockhash: common.MustParseHash("GJp125YAN4ufCSUvZJVdCyWQJ7RPWMmwxoyUQySydZA"),
+							ParentSlot:        112301553,
+							Transactions:      []GetBlockTransaction{},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"blockSubscribe","params":[{"mentionsAccountOrProgram":"LieKvPRE8XeX3Y2xVNHjKlpAScD12lYySBVQ4HqoJ5op"},{"commitment":"confirmed","encoding":"base64","transactionDetails":"signatures","showRewards":false}]}`,
+				Notification: `{"context":{"slot":112301554},"value":{"slot":112301554,"block":null,"err":"BlockStoreError"}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.BlockSubscribeWithConfig(
+						context.Background(),
+						BlockSubscribeFilter{MentionsAccountOrProgram: "LieKvPRE8XeX3Y2xVNHjKlpAScD12lYySBVQ4HqoJ5op"},
+						BlockSubscribeConfig{
+							Commitment:         CommitmentConfirmed,
+							Encoding:           GetBlockConfigEncodingBase64,
+							TransactionDetails: GetBlockConfigTransactionDetailsSignatures,
+							ShowRewards:        pointer.Get[bool](false),
+						},
+					)
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[BlockNotification]{
+					Context: Context{
+						Slot: 112301554,
+					},
+					Value: BlockNotification{
+						Slot:  112301554,
+						Err:   &TransactionError{Kind: "BlockStoreError"},
+						Block: nil,
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,604 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	LocalnetWsEndpoint = "ws://localhost:8900"
+	DevnetWsEndpoint   = "wss://api.devnet.solana.com"
+	TestnetWsEndpoint  = "wss://api.testnet.solana.com"
+	MainnetWsEndpoint  = "wss://api.mainnet-beta.solana.com"
+)
+
+var (
+	ErrWsClientClosed = errors.New("ws client closed")
+	// ErrWsSubscriptionBufferFull ends a subscription whose notifications aren't received fast enough
+	ErrWsSubscriptionBufferFull = errors.New("ws subscription buffer full")
+)
+
+// WsOption is a configuration type for the WsClient
+type WsOption func(*WsClient)
+
+// WithWsEndpoint is a WsOption that allows you configure the websocket endpoint
+func WithWsEndpoint(endpoint string) WsOption {
+	return func(c *WsClient) {
+		c.endpoint = endpoint
+	}
+}
+
+// WithWsDialer is a WsOption that allows you provide your own websocket dialer
+func WithWsDialer(d *websocket.Dialer) WsOption {
+	return func(c *WsClient) {
+		c.dialer = d
+	}
+}
+
+// WithWsHeader is a WsOption that allows you attach http headers to the handshake request
+func WithWsHeader(h http.Header) WsOption {
+	return func(c *WsClient) {
+		c.header = h
+	}
+}
+
+// WithWsReconnectBackoff is a WsOption that configures the delay between two reconnect attempts.
+// the delay starts from min and doubles after each failure until it reaches max.
+func WithWsReconnectBackoff(min, max time.Duration) WsOption {
+	return func(c *WsClient) {
+		c.minBackoff = min
+		c.maxBackoff = max
+	}
+}
+
+// WithWsPingInterval is a WsOption that configures how often a ping frame is sent to keep the connection alive.
+// pass 0 to disable it.
+func WithWsPingInterval(d time.Duration) WsOption {
+	return func(c *WsClient) {
+		c.pingInterval = d
+	}
+}
+
+// WithWsNotificationBufferSize is a WsOption that configures the channel size of each subscription.
+// a subscription whose channel is full when a notification arrives ends with ErrWsSubscriptionBufferFull,
+// so a slow reader never blocks the other subscriptions on the connection.
+func WithWsNotificationBufferSize(n int) WsOption {
+	return func(c *WsClient) {
+		c.bufferSize = n
+	}
+}
+
+func setDefaultWsOptions(c *WsClient) {
+	c.endpoint = MainnetWsEndpoint
+	c.dialer = websocket.DefaultDialer
+	c.minBackoff = 500 * time.Millisecond
+	c.maxBackoff = 30 * time.Second
+	c.pingInterval = 30 * time.Second
+	c.bufferSize = 64
+}
+
+// WsClient is a pubsub client. it keeps one websocket connection, reconnects when the connection
+// is lost and re-subscribes all active subscriptions on the new connection.
+type WsClient struct {
+	endpoint     string
+	dialer       *websocket.Dialer
+	header       http.Header
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	pingInterval time.Duration
+	bufferSize   int
+
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	nextId  uint64
+	pending map[uint64]func(*wsMessage, error)
+	subs    map[*wsSubscription]struct{}
+	active  map[uint64]*wsSubscription
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewWsClient dials the endpoint and returns a connected client. if no options is passed,
+// it connects to solana mainnet.
+func NewWsClient(ctx context.Context, opts ...WsOption) (*WsClient, error) {
+	c := &WsClient{
+		pending: map[uint64]func(*wsMessage, error){},
+		subs:    map[*wsSubscription]struct{}{},
+		active:  map[uint64]*wsSubscription{},
+		done:    make(chan struct{}),
+	}
+
+	setDefaultWsOptions(c)
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	conn, _, err := c.dialer.DialContext(ctx, c.endpoint, c.header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %v, err: %v", c.endpoint, err)
+	}
+	c.conn = conn
+
+	go c.run(conn)
+
+	return c, nil
+}
+
+// Close closes the connection and terminates all subscriptions
+func (c *WsClient) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.done)
+
+		c.mu.Lock()
+		conn := c.conn
+		c.conn = nil
+		subs := make([]*wsSubscription, 0, len(c.subs))
+		for sub := range c.subs {
+			subs = append(subs, sub)
+		}
+		c.subs = map[*wsSubscription]struct{}{}
+		c.active = map[uint64]*wsSubscription{}
+		c.mu.Unlock()
+
+		for _, sub := range subs {
+			sub.terminate(ErrWsClientClosed)
+		}
+		if conn != nil {
+			c.writeMu.Lock()
+			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+			c.writeMu.Unlock()
+			err = conn.Close()
+		}
+	})
+	return err
+}
+
+type wsMessage struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      *uint64         `json:"id"`
+	Method  string          `json:"method"`
+	Result  json.RawMessage `json:"result"`
+	Error   *JsonRpcError   `json:"error"`
+	Params  *struct {
+		Result       json.RawMessage `json:"result"`
+		Subscription uint64          `json:"subscription"`
+	} `json:"params"`
+}
+
+func (c *WsClient) run(conn *websocket.Conn) {
+	for {
+		err := c.serve(conn)
+
+		c.mu.Lock()
+		if c.conn == conn {
+			c.conn = nil
+		}
+		pending := c.pending
+		c.pending = map[uint64]func(*wsMessage, error){}
+		c.active = map[uint64]*wsSubscription{}
+		for sub := range c.subs {
+			sub.subscribed = false
+		}
+		c.mu.Unlock()
+
+		for _, f := range pending {
+			f(nil, fmt.Errorf("connection lost, err: %v", err))
+		}
+
+		conn = c.reconnect()
+		if conn == nil {
+			return
+		}
+		c.resubscribe(conn)
+	}
+}
+
+// serve reads messages until the connection fails
+func (c *WsClient) serve(conn *websocket.Conn) error {
+	stop := make(chan struct{})
+	defer close(stop)
+	if c.pingInterval > 0 {
+		go c.ping(conn, stop)
+	}
+
+	for {
+		_, b, err := conn.ReadMessage()
+		if err != nil {
+			_ = conn.Close()
+			return err
+		}
+
+		var msg wsMessage
+		if err := json.Unmarshal(b, &msg); err != nil {
+			continue
+		}
+		c.dispatch(&msg)
+	}
+}
+
+func (c *WsClient) ping(conn *websocket.Conn, stop <-chan struct{}) {
+	ticker := time.NewTicker(c.pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(c.pingInterval)); err != nil {
+				_ = conn.Close()
+				return
+			}
+		}
+	}
+}
+
+func (c *WsClient) dispatch(msg *wsMessage) {
+	// response of a request
+	if msg.Id != nil {
+		c.mu.Lock()
+		f, ok := c.pending[*msg.Id]
+		delete(c.pending, *msg.Id)
+		c.mu.Unlock()
+		if ok {
+			f(msg, nil)
+		}
+		return
+	}
+
+	// notification
+	if msg.Params == nil {
+		return
+	}
+	c.mu.Lock()
+	sub, ok := c.active[msg.Params.Subscription]
+	last := ok && sub.last != nil && sub.last(msg.Params.Result)
+	if last {
+		delete(c.active, msg.Params.Subscription)
+		delete(c.subs, sub)
+	}
+	c.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	if !sub.notify(msg.Params.Result) {
+		c.drop(sub, ErrWsSubscriptionBufferFull)
+		return
+	}
+	if last {
+		sub.terminate(nil)
+	}
+}
+
+// drop ends the subscription with err and cancels it on the server without waiting for the response
+func (c *WsClient) drop(sub *wsSubscription, err error) {
+	c.mu.Lock()
+	delete(c.subs, sub)
+	subscribed, id := sub.subscribed, sub.id
+	if subscribed {
+		delete(c.active, id)
+	}
+	conn := c.conn
+	c.mu.Unlock()
+
+	sub.terminate(err)
+	if subscribed && conn != nil {
+		_ = c.request(conn, sub.unsubscribeMethod, []any{id}, func(*wsMessage, error) {})
+	}
+}
+
+func (c *WsClient) reconnect() *websocket.Conn {
+	backoff := c.minBackoff
+	for {
+		select {
+		case <-c.done:
+			return nil
+		case <-time.After(backoff):
+		}
+
+		conn, _, err := c.dialer.Dial(c.endpoint, c.header)
+		if err == nil {
+			c.mu.Lock()
+			select {
+			case <-c.done:
+				c.mu.Unlock()
+				_ = conn.Close()
+				return nil
+			default:
+			}
+			c.conn = conn
+			c.mu.Unlock()
+			return conn
+		}
+
+		backoff *= 2
+		if backoff > c.maxBackoff {
+			backoff = c.maxBackoff
+		}
+	}
+}
+
+func (c *WsClient) resubscribe(conn *websocket.Conn) {
+	c.mu.Lock()
+	subs := make([]*wsSubscription, 0, len(c.subs))
+	for sub := range c.subs {
+		subs = append(subs, sub)
+	}
+	c.mu.Unlock()
+
+	for _, sub := range subs {
+		c.sendSubscribe(conn, sub)
+	}
+}
+
+// request writes a json rpc request. f is called with the response or with an error if the connection is lost.
+func (c *WsClient) request(conn *websocket.Conn, method string, params []any, f func(*wsMessage, error)) error {
+	c.mu.Lock()
+	c.nextId++
+	id := c.nextId
+	c.pending[id] = f
+	c.mu.Unlock()
+
+	c.writeMu.Lock()
+	err := conn.WriteJSON(JsonRpcRequest{
+		JsonRpc: "2.0",
+		Id:      id,
+		Method:  method,
+		Params:  params,
+	})
+	c.writeMu.Unlock()
+	if err != nil {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		_ = conn.Close()
+		return fmt.Errorf("failed to write request, err: %v", err)
+	}
+	return nil
+}
+
+func (c *WsClient) sendSubscribe(conn *websocket.Conn, sub *wsSubscription) {
+	// a subscription created while reconnecting may be sent by both subscribe and resubscribe
+	c.mu.Lock()
+	if sub.sentOn == conn {
+		c.mu.Unlock()
+		return
+	}
+	sub.sentOn = conn
+	c.mu.Unlock()
+
+	_ = c.request(conn, sub.method, sub.params, func(msg *wsMessage, err error) {
+		// the subscription will be sent again after reconnecting
+		if err != nil {
+			return
+		}
+		if msg.Error != nil {
+			c.mu.Lock()
+			delete(c.subs, sub)
+			c.mu.Unlock()
+			sub.terminate(msg.Error)
+			return
+		}
+		var id uint64
+		if err := json.Unmarshal(msg.Result, &id); err != nil {
+			c.mu.Lock()
+			delete(c.subs, sub)
+			c.mu.Unlock()
+			sub.terminate(fmt.Errorf("failed to parse subscription id, err: %v", err))
+			return
+		}
+
+		c.mu.Lock()
+		_, alive := c.subs[sub]
+		if alive {
+			sub.id = id
+			sub.subscribed = true
+			c.active[id] = sub
+		}
+		c.mu.Unlock()
+
+		if !alive {
+			// unsubscribed before the server confirmed it
+			_ = c.request(conn, sub.unsubscribeMethod, []any{id}, func(*wsMessage, error) {})
+			return
+		}
+		sub.readyOnce.Do(func() { close(sub.ready) })
+	})
+}
+
+func (c *WsClient) subscribe(ctx context.Context, sub *wsSubscription) error {
+	c.mu.Lock()
+	select {
+	case <-c.done:
+		c.mu.Unlock()
+		return ErrWsClientClosed
+	default:
+	}
+	c.subs[sub] = struct{}{}
+	conn := c.conn
+	c.mu.Unlock()
+
+	// if the connection is being re-established, the subscription is sent after reconnecting
+	if conn != nil {
+		c.sendSubscribe(conn, sub)
+	}
+
+	select {
+	case <-sub.ready:
+		return nil
+	case <-sub.done:
+		return sub.err
+	case <-ctx.Done():
+		c.mu.Lock()
+		delete(c.subs, sub)
+		c.mu.Unlock()
+		sub.terminate(ctx.Err())
+		return ctx.Err()
+	}
+}
+
+func (c *WsClient) unsubscribe(ctx context.Context, sub *wsSubscription) error {
+	c.mu.Lock()
+	delete(c.subs, sub)
+	subscribed, id := sub.subscribed, sub.id
+	if subscribed {
+		delete(c.active, id)
+	}
+	conn := c.conn
+	c.mu.Unlock()
+
+	sub.terminate(nil)
+	if !subscribed || conn == nil {
+		return nil
+	}
+
+	res := make(chan error, 1)
+	err := c.request(conn, sub.unsubscribeMethod, []any{id}, func(msg *wsMessage, err error) {
+		if err != nil {
+			res <- err
+			return
+		}
+		if msg.Error != nil {
+			res <- msg.Error
+			return
+		}
+		res <- nil
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-res:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type wsSubscription struct {
+	method            string
+	unsubscribeMethod string
+	params            []any
+	// last reports whether the notification ends the subscription on the server, nil if only an unsubscribe does
+	last func(json.RawMessage) bool
+
+	// guarded by WsClient.mu
+	id         uint64
+	subscribed bool
+	sentOn     *websocket.Conn
+
+	// notify reports false if the notification can't be delivered without blocking
+	notify    func(json.RawMessage) bool
+	ready     chan struct{}
+	readyOnce sync.Once
+	done      chan struct{}
+	doneOnce  sync.Once
+	err       error
+	onDone    func()
+}
+
+func (s *wsSubscription) terminate(err error) {
+	s.doneOnce.Do(func() {
+		s.err = err
+		close(s.done)
+		s.onDone()
+	})
+}
+
+// Subscription delivers notifications of one subscription. the channel returned by
+// Notifications is closed when the subscription ends, Err reports why it ended.
+type Subscription[T any] struct {
+	client *WsClient
+	sub    *wsSubscription
+
+	mu sync.Mutex
+	ch chan T
+}
+
+func subscribe[T any](c *WsClient, ctx context.Context, method, unsubscribeMethod string, last func(json.RawMessage) bool, params ...any) (*Subscription[T], error) {
+	s := &Subscription[T]{
+		client: c,
+		ch:     make(chan T, c.bufferSize),
+	}
+	s.sub = &wsSubscription{
+		method:            method,
+		unsubscribeMethod: unsubscribeMethod,
+		params:            params,
+		last:              last,
+		notify:            s.notify,
+		ready:             make(chan struct{}),
+		done:              make(chan struct{}),
+		onDone:            s.closeChannel,
+	}
+
+	if err := c.subscribe(ctx, s.sub); err != nil {
+		return nil, fmt.Errorf("failed to %v, err: %w", method, err)
+	}
+	return s, nil
+}
+
+func (s *Subscription[T]) notify(raw json.RawMessage) bool {
+	var v T
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.sub.done:
+		return true
+	default:
+	}
+	select {
+	case s.ch <- v:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Subscription[T]) closeChannel() {
+	s.mu.Lock()
+	close(s.ch)
+	s.mu.Unlock()
+}
+
+// Notifications returns a channel which receives every notification of the subscription
+func (s *Subscription[T]) Notifications() <-chan T {
+	return s.ch
+}
+
+// Done returns a channel which is closed when the subscription ends
+func (s *Subscription[T]) Done() <-chan struct{} {
+	return s.sub.done
+}
+
+// Err returns the reason the subscription ended. it is nil while the subscription is alive
+// and after a normal unsubscribe.
+func (s *Subscription[T]) Err() error {
+	select {
+	case <-s.sub.done:
+		return s.sub.err
+	default:
+		return nil
+	}
+}
+
+// Unsubscribe cancels the subscription
+func (s *Subscription[T]) Unsubscribe(ctx context.Context) error {
+	return s.client.unsubscribe(ctx, s.sub)
+}
@@ -0,0 +1,288 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type wsTestRequest struct {
+	Id     uint64            `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	Raw    string            `json:"-"`
+}
+
+// wsTestServer is a fake pubsub server. it answers every `*Subscribe` with an increasing
+// subscription id and every `*Unsubscribe` with true. if notification is set, it is pushed
+// right after a subscription is confirmed.
+type wsTestServer struct {
+	*httptest.Server
+
+	notification string
+	errors       map[string]string
+
+	mu        sync.Mutex
+	conns     []*websocket.Conn
+	nextSubId uint64
+	requests  chan wsTestRequest
+}
+
+func newWsTestServer(t *testing.T) *wsTestServer {
+	s := &wsTestServer{
+		errors:   map[string]string{},
+		requests: make(chan wsTestRequest, 64),
+	}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade, err: %v", err)
+			return
+		}
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+
+		for {
+			_, b, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var r wsTestRequest
+			if err := json.Unmarshal(b, &r); err != nil {
+				t.Errorf("failed to parse request, err: %v", err)
+				return
+			}
+			r.Raw = string(b)
+			s.requests <- r
+
+			s.mu.Lock()
+			switch {
+			case s.errors[r.Method] != "":
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","error":%v,"id":%v}`, s.errors[r.Method], r.Id)))
+			case strings.HasSuffix(r.Method, "Unsubscribe"):
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","result":true,"id":%v}`, r.Id)))
+			case strings.HasSuffix(r.Method, "Subscribe"):
+				subId := s.nextSubId
+				s.nextSubId++
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","result":%v,"id":%v}`, subId, r.Id)))
+				if s.notification != "" {
+					method := strings.TrimSuffix(r.Method, "Subscribe") + "Notification"
+					_ = conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"%v","params":{"result":%v,"subscription":%v}}`, method, s.notification, subId)))
+				}
+			}
+			s.mu.Unlock()
+		}
+	}))
+	return s
+}
+
+func (s *wsTestServer) endpoint() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+func (s *wsTestServer) notify(t *testing.T, method string, subId uint64, result string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conn := s.conns[len(s.conns)-1]
+	err := conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"%v","params":{"result":%v,"subscription":%v}}`, method, result, subId)))
+	require.Nil(t, err)
+}
+
+func (s *wsTestServer) dropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		_ = conn.Close()
+	}
+}
+
+func (s *wsTestServer) nextRequest(t *testing.T) wsTestRequest {
+	select {
+	case r := <-s.requests:
+		return r
+	case <-time.After(3 * time.Second):
+		t.Fatal("timeout waiting for request")
+		return wsTestRequest{}
+	}
+}
+
+func newTestWsClient(t *testing.T, s *wsTestServer) *WsClient {
+	c, err := NewWsClient(
+		context.Background(),
+		WithWsEndpoint(s.endpoint()),
+		WithWsReconnectBackoff(10*time.Millisecond, 50*time.Millisecond),
+	)
+	require.Nil(t, err)
+	return c
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	select {
+	case v, ok := <-ch:
+		require.True(t, ok, "channel closed")
+		return v
+	case <-time.After(3 * time.Second):
+		t.Fatal("timeout waiting for notification")
+		var v T
+		return v
+	}
+}
+
+type wsParam struct {
+	Name          string
+	RequestBody   string
+	Notification  string
+	F             func(c *WsClient) (any, error)
+	ExpectedValue any
+	ExpectedError error
+}
+
+func testWsAll(t *testing.T, params []wsParam) {
+	for _, param := range params {
+		t.Run(param.Name, func(t *testing.T) {
+			s := newWsTestServer(t)
+			defer s.Close()
+			s.notification = param.Notification
+
+			c := newTestWsClient(t, s)
+			defer c.Close()
+
+			got, err := param.F(c)
+			assert.JSONEq(t, param.RequestBody, s.nextRequest(t).Raw)
+			assert.Equal(t, param.ExpectedValue, got)
+			assert.Equal(t, param.ExpectedError, err)
+		})
+	}
+}
+
+func TestWsClient_Reconnect(t *testing.T) {
+	s := newWsTestServer(t)
+	defer s.Close()
+
+	c := newTestWsClient(t, s)
+	defer c.Close()
+
+	sub, err := c.SlotSubscribe(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, "slotSubscribe", s.nextRequest(t).Method)
+
+	s.notify(t, "slotNotification", 0, `{"parent":1,"root":0,"slot":2}`)
+	assert.Equal(t, SlotNotification{Parent: 1, Root: 0, Slot: 2}, receive(t, sub.Notifications()))
+
+	s.dropConnections()
+
+	// the client re-subscribes and gets a new subscription id
+	r := s.nextRequest(t)
+	assert.Equal(t, "slotSubscribe", r.Method)
+
+	// give the client a moment to handle the subscribe response
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		_, ok := c.active[1]
+		return ok
+	}, 3*time.Second, 10*time.Millisecond)
+
+	s.notify(t, "slotNotification", 1, `{"parent":2,"root":1,"slot":3}`)
+	assert.Equal(t, SlotNotification{Parent: 2, Root: 1, Slot: 3}, receive(t, sub.Notifications()))
+	assert.Nil(t, sub.Err())
+}
+
+func TestWsClient_Unsubscribe(t *testing.T) {
+	s := newWsTestServer(t)
+	defer s.Close()
+
+	c := newTestWsClient(t, s)
+	defer c.Close()
+
+	sub, err := c.RootSubscribe(context.Background())
+	require.Nil(t, err)
+	s.nextRequest(t)
+
+	err = sub.Unsubscribe(context.Background())
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":2,"method":"rootUnsubscribe","params":[0]}`, s.nextRequest(t).Raw)
+
+	_, ok := <-sub.Notifications()
+	assert.False(t, ok)
+	assert.Nil(t, sub.Err())
+}
+
+func TestWsClient_SubscribeError(t *testing.T) {
+	s := newWsTestServer(t)
+	defer s.Close()
+	s.errors["accountSubscribe"] = `{"code":-32602,"message":"Invalid Request"}`
+
+	c := newTestWsClient(t, s)
+	defer c.Close()
+
+	_, err := c.AccountSubscribe(context.Background(), "RNfp4xTbBb4C3kcv2KqtAj8mu4YhMHxqm1Skg9uchZ7")
+	var rpcErr *JsonRpcError
+	require.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, -32602, rpcErr.Code)
+}
+
+func TestWsClient_Close(t *testing.T) {
+	s := newWsTestServer(t)
+	defer s.Close()
+
+	c := newTestWsClient(t, s)
+
+	sub, err := c.SlotSubscribe(context.Background())
+	require.Nil(t, err)
+
+	require.Nil(t, c.Close())
+
+	_, ok := <-sub.Notifications()
+	assert.False(t, ok)
+	assert.ErrorIs(t, sub.Err(), ErrWsClientClosed)
+
+	_, err = c.SlotSubscribe(context.Background())
+	assert.ErrorIs(t, err, ErrWsClientClosed)
+}
+
+func TestWsClient_SlowSubscription(t *testing.T) {
+	s := newWsTestServer(t)
+	defer s.Close()
+
+	c, err := NewWsClient(
+		context.Background(),
+		WithWsEndpoint(s.endpoint()),
+		WithWsNotificationBufferSize(1),
+	)
+	require.Nil(t, err)
+	defer c.Close()
+
+	slow, err := c.SlotSubscribe(context.Background())
+	require.Nil(t, err)
+	s.nextRequest(t)
+	fast, err := c.RootSubscribe(context.Background())
+	require.Nil(t, err)
+	s.nextRequest(t)
+
+	// the second notification doesn't fit in the buffer of the slow subscription
+	s.notify(t, "slotNotification", 0, `{"parent":1,"root":0,"slot":2}`)
+	s.notify(t, "slotNotification", 0, `{"parent":2,"root":1,"slot":3}`)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":3,"method":"slotUnsubscribe","params":[0]}`, s.nextRequest(t).Raw)
+
+	// the other subscription keeps receiving
+	s.notify(t, "rootNotification", 1, `5`)
+	assert.Equal(t, uint64(5), receive(t, fast.Notifications()))
+
+	assert.Equal(t, SlotNotification{Parent: 1, Root: 0, Slot: 2}, receive(t, slow.Notifications()))
+	_, ok := <-slow.Notifications()
+	assert.False(t, ok)
+	assert.ErrorIs(t, slow.Err(), ErrWsSubscriptionBufferFull)
+}
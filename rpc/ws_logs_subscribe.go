@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/blocto/solana-go-sdk/common"
+)
+
+// LogsSubscribeFilter selects which transactions' logs are delivered.
+// set Mentions to receive logs of transactions which mention the address, otherwise Type is used.
+type LogsSubscribeFilter struct {
+	Type     LogsSubscribeFilterType
+	Mentions string
+}
+
+type LogsSubscribeFilterType string
+
+const (
+	LogsSubscribeFilterTypeAll          LogsSubscribeFilterType = "all"
+	LogsSubscribeFilterTypeAllWithVotes LogsSubscribeFilterType = "allWithVotes"
+)
+
+func (f LogsSubscribeFilter) MarshalJSON() ([]byte, error) {
+	if f.Mentions != "" {
+		return json.Marshal(struct {
+			Mentions []string `json:"mentions"`
+		}{
+			Mentions: []string{f.Mentions},
+		})
+	}
+	if f.Type == "" {
+		return json.Marshal(LogsSubscribeFilterTypeAll)
+	}
+	return json.Marshal(f.Type)
+}
+
+// LogsSubscribeConfig is an option config for `logsSubscribe`
+type LogsSubscribeConfig struct {
+	Commitment Commitment `json:"commitment,omitempty"`
+}
+
+type LogsNotification struct {
+	Signature common.Signature  `json:"signature"`
+	Err       *TransactionError `json:"err"`
+	Logs      []string          `json:"logs"`
+}
+
+// LogsSubscribe subscribes to transaction logging
+func (c *WsClient) LogsSubscribe(ctx context.Context, filter LogsSubscribeFilter) (*Subscription[ValueWithContext[LogsNotification]], error) {
+	return subscribe[ValueWithContext[LogsNotification]](c, ctx, "logsSubscribe", "logsUnsubscribe", nil, filter)
+}
+
+// LogsSubscribeWithConfig subscribes to transaction logging
+func (c *WsClient) LogsSubscribeWithConfig(ctx context.Context, filter LogsSubscribeFilter, cfg LogsSubscribeConfig) (*Subscription[ValueWithContext[LogsNotification]], error) {
+	return subscribe[ValueWithContext[LogsNotification]](c, ctx, "logsSubscribe", "logsUnsubscribe", nil, filter, cfg)
+}
@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+)
+
+func TestLogsSubscribe(t *testing.T) {
+	testWsAll(
+		t,
+		[]wsParam{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"logsSubscribe","params":["all"]}`,
+				Notification: `{"context":{"slot":5208469},"value":{"signature":"5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv","err":null,"logs":["SBF program 83astBRguLMdt2h5U1Tpdq5tjFoJ6noeGwaY3mDLVcri success"]}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.LogsSubscribe(context.Background(), LogsSubscribeFilter{})
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[LogsNotification]{
+					Context: Context{
+						Slot: 5208469,
+					},
+					Value: LogsNotification{
+						Signature: common.MustParseSignature("5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv"),
+						Err:       nil,
+						Logs:      []string{"SBF program 83astBRguLMdt2h5U1Tpdq5tjFoJ6noeGwaY3mDLVcri success"},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"logsSubscribe","params":["allWithVotes",{"commitment":"finalized"}]}`,
+				Notification: `{"context":{"slot":5208469},"value":{"signature":"5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv","err":null,"logs":[]}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.LogsSubscribeWithConfig(
+						context.Background(),
+						LogsSubscribeFilter{Type: LogsSubscribeFilterTypeAllWithVotes},
+						LogsSubscribeConfig{Commitment: CommitmentFinalized},
+					)
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[LogsNotification]{
+					Context: Context{
+						Slot: 5208469,
+					},
+					Value: LogsNotification{
+						Signature: common.MustParseSignature("5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv"),
+						Err:       nil,
+						Logs:      []string{},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"logsSubscribe","params":[{"mentions":["11111111111111111111111111111111"]}]}`,
+				Notification: `{"context":{"slot":5208469},"value":{"signature":"5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv","err":{"InstructionError":[0,{"Custom":1}]},"logs":[]}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.LogsSubscribe(context.Background(), LogsSubscribeFilter{Mentions: "11111111111111111111111111111111"})
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[LogsNotification]{
+					Context: Context{
+						Slot: 5208469,
+					},
+					Value: LogsNotification{
+						Signature: common.MustParseSignature("5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv"),
+						Err:       &TransactionError{Kind: TransactionErrorInstructionError, InstructionError: &InstructionError{Kind: InstructionErrorCustom, Code: 1}},
+						Logs:      []string{},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,20 @@
+package rpc
+
+import "context"
+
+// ProgramSubscribeConfig is an option config for `programSubscribe`
+type ProgramSubscribeConfig struct {
+	Commitment Commitment                       `json:"commitment,omitempty"`
+	Encoding   AccountEncoding                  `json:"encoding,omitempty"`
+	Filters    []GetProgramAccountsConfigFilter `json:"filters,omitempty"`
+}
+
+// ProgramSubscribe subscribes to a program to receive notifications when the lamports or data for an account owned by the program changes
+func (c *WsClient) ProgramSubscribe(ctx context.Context, programId string) (*Subscription[ValueWithContext[GetProgramAccount]], error) {
+	return subscribe[ValueWithContext[GetProgramAccount]](c, ctx, "programSubscribe", "programUnsubscribe", nil, programId, ProgramSubscribeConfig{Encoding: AccountEncodingBase64})
+}
+
+// ProgramSubscribeWithConfig subscribes to a program to receive notifications when the lamports or data for an account owned by the program changes
+func (c *WsClient) ProgramSubscribeWithConfig(ctx context.Context, programId string, cfg ProgramSubscribeConfig) (*Subscription[ValueWithContext[GetProgramAccount]], error) {
+	return subscribe[ValueWithContext[GetProgramAccount]](c, ctx, "programSubscribe", "programUnsubscribe", nil, programId, cfg)
+}
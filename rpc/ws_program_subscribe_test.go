@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProgramSubscribe(t *testing.T) {
+	testWsAll(
+		t,
+		[]wsParam{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"programSubscribe","params":["11111111111111111111111111111111",{"encoding":"base64"}]}`,
+				Notification: `{"context":{"slot":5208469},"value":{"pubkey":"H4vnBqifaSACnKa7acsxstsY1iV1bvJNxsCY7enrd1hq","account":{"data":["AQID","base64"],"executable":false,"lamports":33594,"owner":"11111111111111111111111111111111","rentEpoch":636}}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.ProgramSubscribe(context.Background(), "11111111111111111111111111111111")
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[GetProgramAccount]{
+					Context: Context{
+						Slot: 5208469,
+					},
+					Value: GetProgramAccount{
+						Pubkey: "H4vnBqifaSACnKa7acsxstsY1iV1bvJNxsCY7enrd1hq",
+						Account: AccountInfo{
+							Lamports:  33594,
+							Owner:     "11111111111111111111111111111111",
+							RentEpoch: 636,
+							Data:      []any{"AQID", "base64"},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"programSubscribe","params":["11111111111111111111111111111111",{"commitment":"confirmed","encoding":"base64","filters":[{"dataSize":80}]}]}`,
+				Notification: `{"context":{"slot":5208469},"value":{"pubkey":"H4vnBqifaSACnKa7acsxstsY1iV1bvJNxsCY7enrd1hq","account":{"data":["AQID","base64"],"executable":false,"lamports":33594,"owner":"11111111111111111111111111111111","rentEpoch":636}}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.ProgramSubscribeWithConfig(
+						context.Background(),
+						"11111111111111111111111111111111",
+						ProgramSubscribeConfig{
+							Commitment: CommitmentConfirmed,
+							Encoding:   AccountEncodingBase64,
+							Filters:    []GetProgramAccountsConfigFilter{{DataSize: 80}},
+						},
+					)
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[GetProgramAccount]{
+					Context: Context{
+						Slot: 5208469,
+					},
+					Value: GetProgramAccount{
+						Pubkey: "H4vnBqifaSACnKa7acsxstsY1iV1bvJNxsCY7enrd1hq",
+						Account: AccountInfo{
+							Lamports:  33594,
+							Owner:     "11111111111111111111111111111111",
+							RentEpoch: 636,
+							Data:      []any{"AQID", "base64"},
+						},
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,8 @@
+package rpc
+
+import "context"
+
+// RootSubscribe subscribes to receive notification anytime a new root is set by the validator
+func (c *WsClient) RootSubscribe(ctx context.Context) (*Subscription[uint64], error) {
+	return subscribe[uint64](c, ctx, "rootSubscribe", "rootUnsubscribe", nil)
+}
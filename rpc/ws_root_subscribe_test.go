@@ -0,0 +1,27 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRootSubscribe(t *testing.T) {
+	testWsAll(
+		t,
+		[]wsParam{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"rootSubscribe"}`,
+				Notification: `42`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.RootSubscribe(context.Background())
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: uint64(42),
+				ExpectedError: nil,
+			},
+		},
+	)
+}
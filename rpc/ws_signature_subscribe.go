@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// SignatureSubscribeConfig is an option config for `signatureSubscribe`
+type SignatureSubscribeConfig struct {
+	Commitment                 Commitment `json:"commitment,omitempty"`
+	EnableReceivedNotification bool       `json:"enableReceivedNotification,omitempty"`
+}
+
+// SignatureNotification is either a processed notification with Err or,
+// if EnableReceivedNotification is set, a notification that the signature was received
+type SignatureNotification struct {
+	Received bool
+	Err      *TransactionError
+}
+
+func (s *SignatureNotification) UnmarshalJSON(data []byte) error {
+	var received string
+	if err := json.Unmarshal(data, &received); err == nil {
+		*s = SignatureNotification{Received: received == "receivedSignature"}
+		return nil
+	}
+	var v struct {
+		Err *TransactionError `json:"err"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = SignatureNotification{Err: v.Err}
+	return nil
+}
+
+// isLastSignatureNotification reports whether the notification is the processed one, which ends the subscription
+func isLastSignatureNotification(raw json.RawMessage) bool {
+	var v ValueWithContext[SignatureNotification]
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return false
+	}
+	return !v.Value.Received
+}
+
+// SignatureSubscribe subscribes to a transaction signature to receive notification when it reaches the commitment.
+// the subscription ends automatically after the processed notification is delivered.
+func (c *WsClient) SignatureSubscribe(ctx context.Context, signature string) (*Subscription[ValueWithContext[SignatureNotification]], error) {
+	return subscribe[ValueWithContext[SignatureNotification]](c, ctx, "signatureSubscribe", "signatureUnsubscribe", isLastSignatureNotification, signature)
+}
+
+// SignatureSubscribeWithConfig subscribes to a transaction signature to receive notification when it reaches the commitment.
+// the subscription ends automatically after the processed notification is delivered.
+func (c *WsClient) SignatureSubscribeWithConfig(ctx context.Context, signature string, cfg SignatureSubscribeConfig) (*Subscription[ValueWithContext[SignatureNotification]], error) {
+	return subscribe[ValueWithContext[SignatureNotification]](c, ctx, "signatureSubscribe", "signatureUnsubscribe", isLastSignatureNotification, signature, cfg)
+}
@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatureSubscribe(t *testing.T) {
+	testWsAll(
+		t,
+		[]wsParam{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"signatureSubscribe","params":["2EBVM6cB8vAAD93Ktr6Vd8p67XPbQzCJX47MpReuiCXJAtcjaxpvWpcg9Ege1Nr5Tk3a2GFrByT7WPBjdsTycY9b"]}`,
+				Notification: `{"context":{"slot":5207624},"value":{"err":null}}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.SignatureSubscribe(context.Background(), "2EBVM6cB8vAAD93Ktr6Vd8p67XPbQzCJX47MpReuiCXJAtcjaxpvWpcg9Ege1Nr5Tk3a2GFrByT7WPBjdsTycY9b")
+					if err != nil {
+						return nil, err
+					}
+					v := receive(t, sub.Notifications())
+
+					// the subscription ends after the first notification
+					_, ok := <-sub.Notifications()
+					assert.False(t, ok)
+					assert.Nil(t, sub.Err())
+
+					return v, nil
+				},
+				ExpectedValue: ValueWithContext[SignatureNotification]{
+					Context: Context{
+						Slot: 5207624,
+					},
+					Value: SignatureNotification{
+						Received: false,
+						Err:      nil,
+					},
+				},
+				ExpectedError: nil,
+			},
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"signatureSubscribe","params":["2EBVM6cB8vAAD93Ktr6Vd8p67XPbQzCJX47MpReuiCXJAtcjaxpvWpcg9Ege1Nr5Tk3a2GFrByT7WPBjdsTycY9b",{"commitment":"finalized","enableReceivedNotification":true}]}`,
+				Notification: `{"context":{"slot":5207624},"value":"receivedSignature"}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.SignatureSubscribeWithConfig(
+						context.Background(),
+						"2EBVM6cB8vAAD93Ktr6Vd8p67XPbQzCJX47MpReuiCXJAtcjaxpvWpcg9Ege1Nr5Tk3a2GFrByT7WPBjdsTycY9b",
+						SignatureSubscribeConfig{
+							Commitment:                 CommitmentFinalized,
+							EnableReceivedNotification: true,
+						},
+					)
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: ValueWithContext[SignatureNotification]{
+					Context: Context{
+						Slot: 5207624,
+					},
+					Value: SignatureNotification{
+						Received: true,
+					},
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
+
+func TestSignatureSubscribe_ReceivedThenProcessed(t *testing.T) {
+	s := newWsTestServer(t)
+	defer s.Close()
+	c := newTestWsClient(t, s)
+	defer c.Close()
+
+	sub, err := c.SignatureSubscribeWithConfig(
+		context.Background(),
+		"2EBVM6cB8vAAD93Ktr6Vd8p67XPbQzCJX47MpReuiCXJAtcjaxpvWpcg9Ege1Nr5Tk3a2GFrByT7WPBjdsTycY9b",
+		SignatureSubscribeConfig{EnableReceivedNotification: true},
+	)
+	assert.Nil(t, err)
+	s.nextRequest(t)
+
+	// the received notification keeps the subscription alive until the processed one
+	s.notify(t, "signatureNotification", 0, `{"context":{"slot":5207624},"value":"receivedSignature"}`)
+	s.notify(t, "signatureNotification", 0, `{"context":{"slot":5207625},"value":{"err":{"InstructionError":[0,{"Custom":1}]}}}`)
+
+	assert.Equal(t, ValueWithContext[SignatureNotification]{
+		Context: Context{Slot: 5207624},
+		Value:   SignatureNotification{Received: true},
+	}, receive(t, sub.Notifications()))
+	processed := receive(t, sub.Notifications())
+	assert.Equal(t, uint64(5207625), processed.Context.Slot)
+	assert.False(t, processed.Value.Received)
+	assert.NotNil(t, processed.Value.Err)
+
+	_, ok := <-sub.Notifications()
+	assert.False(t, ok)
+	assert.Nil(t, sub.Err())
+}
@@ -0,0 +1,31 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSlotSubscribe(t *testing.T) {
+	testWsAll(
+		t,
+		[]wsParam{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"slotSubscribe"}`,
+				Notification: `{"parent":75,"root":44,"slot":76}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.SlotSubscribe(context.Background())
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: SlotNotification{
+					Parent: 75,
+					Root:   44,
+					Slot:   76,
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
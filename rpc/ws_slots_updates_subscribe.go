@@ -0,0 +1,36 @@
+package rpc
+
+import "context"
+
+type SlotsUpdatesNotificationType string
+
+const (
+	SlotsUpdatesNotificationTypeFirstShredReceived SlotsUpdatesNotificationType = "firstShredReceived"
+	SlotsUpdatesNotificationTypeCompleted          SlotsUpdatesNotificationType = "completed"
+	SlotsUpdatesNotificationTypeCreatedBank        SlotsUpdatesNotificationType = "createdBank"
+	SlotsUpdatesNotificationTypeFrozen             SlotsUpdatesNotificationType = "frozen"
+	SlotsUpdatesNotificationTypeDead               SlotsUpdatesNotificationType = "dead"
+	SlotsUpdatesNotificationTypeOptimisticConfirm  SlotsUpdatesNotificationType = "optimisticConfirmation"
+	SlotsUpdatesNotificationTypeRoot               SlotsUpdatesNotificationType = "root"
+)
+
+type SlotsUpdatesNotification struct {
+	Type      SlotsUpdatesNotificationType   `json:"type"`
+	Slot      uint64                         `json:"slot"`
+	Timestamp uint64                         `json:"timestamp"`
+	Parent    *uint64                        `json:"parent,omitempty"`
+	Err       *string                        `json:"err,omitempty"`
+	Stats     *SlotsUpdatesNotificationStats `json:"stats,omitempty"`
+}
+
+type SlotsUpdatesNotificationStats struct {
+	MaxTransactionsPerEntry   uint64 `json:"maxTransactionsPerEntry"`
+	NumFailedTransactions     uint64 `json:"numFailedTransactions"`
+	NumSuccessfulTransactions uint64 `json:"numSuccessfulTransactions"`
+	NumTransactionEntries     uint64 `json:"numTransactionEntries"`
+}
+
+// SlotsUpdatesSubscribe subscribes to receive a notification from the validator on a variety of updates on every slot
+func (c *WsClient) SlotsUpdatesSubscribe(ctx context.Context) (*Subscription[SlotsUpdatesNotification], error) {
+	return subscribe[SlotsUpdatesNotification](c, ctx, "slotsUpdatesSubscribe", "slotsUpdatesUnsubscribe", nil)
+}
@@ -0,0 +1,34 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/pkg/pointer"
+)
+
+func TestSlotsUpdatesSubscribe(t *testing.T) {
+	testWsAll(
+		t,
+		[]wsParam{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"slotsUpdatesSubscribe"}`,
+				Notification: `{"parent":75,"slot":76,"timestamp":1625081266243,"type":"optimisticConfirmation"}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.SlotsUpdatesSubscribe(context.Background())
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: SlotsUpdatesNotification{
+					Type:      SlotsUpdatesNotificationTypeOptimisticConfirm,
+					Slot:      76,
+					Timestamp: 1625081266243,
+					Parent:    pointer.Get[uint64](75),
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -0,0 +1,20 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/blocto/solana-go-sdk/common"
+)
+
+type VoteNotification struct {
+	Hash       common.Hash      `json:"hash"`
+	Slots      []uint64         `json:"slots"`
+	Timestamp  *int64           `json:"timestamp"`
+	Signature  common.Signature `json:"signature"`
+	VotePubkey string           `json:"votePubkey"`
+}
+
+// VoteSubscribe subscribes to receive notification anytime a new vote is observed in gossip
+func (c *WsClient) VoteSubscribe(ctx context.Context) (*Subscription[VoteNotification], error) {
+	return subscribe[VoteNotification](c, ctx, "voteSubscribe", "voteUnsubscribe", nil)
+}
@@ -0,0 +1,35 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+)
+
+func TestVoteSubscribe(t *testing.T) {
+	testWsAll(
+		t,
+		[]wsParam{
+			{
+				RequestBody:  `{"jsonrpc":"2.0","id":1,"method":"voteSubscribe"}`,
+				Notification: `{"hash":"8Rshv2oMkPu5E4opXTRyuyBeZBqQ4S477VG26wUTFxUM","slots":[1,2],"timestamp":null,"signature":"5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv","votePubkey":"Vote111111111111111111111111111111111111111"}`,
+				F: func(c *WsClient) (any, error) {
+					sub, err := c.VoteSubscribe(context.Background())
+					if err != nil {
+						return nil, err
+					}
+					return receive(t, sub.Notifications()), nil
+				},
+				ExpectedValue: VoteNotification{
+					Hash:       common.MustParseHash("8Rshv2oMkPu5E4opXTRyuyBeZBqQ4S477VG26wUTFxUM"),
+					Slots:      []uint64{1, 2},
+					Timestamp:  nil,
+					Signature:  common.MustParseSignature("5h6xBEauJ3PK6SWCZ1PGjBvj8vDdWG3KpwATGy1ARAXFSDwt8GFXM7W5Ncn16wmqokgpiKRLuS83KUxyZyv2sUYv"),
+					VotePubkey: "Vote111111111111111111111111111111111111111",
+				},
+				ExpectedError: nil,
+			},
+		},
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/hdwallet"
 	"github.com/mr-tron/base58"
 )
 
@@ -60,6 +61,9 @@ func AccountFromHex(key string) (Account, error) {
 
 // AccountFromSeed generate a account by seed
 func AccountFromSeed(seed []byte) (Account, error) {
+	if len(seed) != ed25519.SeedSize {
+		return Account{}, fmt.Errorf("%w, expected: %v, got: %v", ErrAccountPrivateKeyLengthMismatch, ed25519.SeedSize, len(seed))
+	}
 	pk := ed25519.NewKeyFromSeed(seed)
 	return AccountFromBytes(pk)
 }
@@ -67,3 +71,23 @@ func AccountFromSeed(seed []byte) (Account, error) {
 func (a Account) Sign(message []byte) []byte {
 	return ed25519.Sign(a.PrivateKey, message)
 }
+
+// DefaultDerivationPath is the derivation path Phantom and Solflare use for the first account
+const DefaultDerivationPath = "m/44'/501'/0'/0'"
+
+// AccountFromMnemonic generate a account by a bip39 mnemonic, an optional passphrase and a derivation path.
+// the path is DefaultDerivationPath if it is empty.
+func AccountFromMnemonic(mnemonic, passphrase, path string) (Account, error) {
+	seed, err := hdwallet.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return Account{}, err
+	}
+	if path == "" {
+		path = DefaultDerivationPath
+	}
+	key, err := hdwallet.Derived(path, seed)
+	if err != nil {
+		return Account{}, err
+	}
+	return AccountFromSeed(key.PrivateKey)
+}
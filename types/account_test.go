@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/pkg/hdwallet"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -132,3 +133,45 @@ func TestAccount_Sign(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountFromMnemonic(t *testing.T) {
+	type args struct {
+		mnemonic   string
+		passphrase string
+		path       string
+	}
+	tests := []struct {
+		name string
+		args args
+		want common.PublicKey
+		err  error
+	}{
+		{
+			args: args{
+				mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			},
+			want: common.PublicKeyFromString("HAgk14JpMQLgt6rVgv7cBQFJWFto5Dqxi472uT3DKpqk"),
+		},
+		{
+			args: args{
+				mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+				path:     "m/44'/501'/1'/0'",
+			},
+			want: common.PublicKeyFromString("Hh8QwFUA6MtVu1qAoq12ucvFHNwCcVTV7hpWjeY1Hztb"),
+		},
+		{
+			args: args{
+				mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+			},
+			want: common.PublicKey{},
+			err:  hdwallet.ErrInvalidMnemonicChecksum,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AccountFromMnemonic(tt.args.mnemonic, tt.args.passphrase, tt.args.path)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, got.PublicKey)
+		})
+	}
+}
@@ -9,8 +9,7 @@ import (
 
 	"github.com/blocto/solana-go-sdk/common"
 	"github.com/blocto/solana-go-sdk/pkg/bincode"
-
-	"github.com/mr-tron/base58"
+	"github.com/blocto/solana-go-sdk/pkg/bytes_decoder"
 )
 
 type MessageHeader struct {
@@ -19,6 +18,25 @@ type MessageHeader struct {
 	NumReadonlyUnsignedAccounts uint8
 }
 
+var (
+	ErrTransactionTooLarge            = errors.New("transaction too large")
+	ErrTooManyAccounts                = errors.New("too many accounts")
+	ErrDuplicateSigner                = errors.New("duplicate signer")
+	ErrDuplicateAccount               = errors.New("duplicate account")
+	ErrMissingFeePayer                = errors.New("missing fee payer")
+	ErrInvalidAccountIndex            = errors.New("invalid account index")
+	ErrInvalidAddressLookupTableIndex = errors.New("invalid address lookup table index")
+	ErrAddressLookupTableNotFound     = errors.New("address lookup table not found")
+	ErrMissingLoadedAddresses         = errors.New("missing loaded addresses")
+	ErrUnsupportedMessageVersion      = errors.New("unsupported message version")
+	// errors of the deserializers, they are the same as the ones of bytes_decoder
+	ErrInsufficientData = bytes_decoder.ErrInsufficientData
+	ErrTrailingBytes    = bytes_decoder.ErrTrailingBytes
+)
+
+// MaxAccounts is the max number of accounts a transaction can use, including the ones from lookup tables
+const MaxAccounts = 256
+
 type MessageVersion string
 
 const (
@@ -30,7 +48,7 @@ type Message struct {
 	Version             MessageVersion
 	Header              MessageHeader
 	Accounts            []common.PublicKey
-	RecentBlockHash     string
+	RecentBlockHash     common.Hash
 	Instructions        []CompiledInstruction
 	AddressLookupTables []CompiledAddressLookupTable
 }
@@ -53,11 +71,7 @@ func (m *Message) Serialize() ([]byte, error) {
 		b = append(b, key[:]...)
 	}
 
-	blockHash, err := base58.Decode(m.RecentBlockHash)
-	if err != nil {
-		return nil, err
-	}
-	b = append(b, blockHash...)
+	b = append(b, m.RecentBlockHash[:]...)
 
 	b = append(b, bincode.UintToVarLenBytes(uint64(len(m.Instructions)))...)
 	for _, instruction := range m.Instructions {
@@ -105,110 +119,292 @@ func (m *Message) Serialize() ([]byte, error) {
 	return b, nil
 }
 
-// DecompileInstructions hasn't support v0 message decode
+// EstimateSize returns the size of the transaction after the message is signed. it counts the
+// signatures from the header so it works before any signature is added.
+func (m *Message) EstimateSize() int {
+	size := 3 // header
+	size += shortVecSize(len(m.Accounts)) + len(m.Accounts)*32
+	size += 32 // recent blockhash
+	size += shortVecSize(len(m.Instructions))
+	for _, instruction := range m.Instructions {
+		size += 1 // program id index
+		size += shortVecSize(len(instruction.Accounts)) + len(instruction.Accounts)
+		size += shortVecSize(len(instruction.Data)) + len(instruction.Data)
+	}
+	if len(m.Version) > 0 && m.Version != MessageVersionLegacy {
+		size += 1 // version prefix
+		n := 0
+		for _, addressLookupTable := range m.AddressLookupTables {
+			if len(addressLookupTable.WritableIndexes) == 0 && len(addressLookupTable.ReadonlyIndexes) == 0 {
+				continue
+			}
+			n++
+			size += 32
+			size += shortVecSize(len(addressLookupTable.WritableIndexes)) + len(addressLookupTable.WritableIndexes)
+			size += shortVecSize(len(addressLookupTable.ReadonlyIndexes)) + len(addressLookupTable.ReadonlyIndexes)
+		}
+		size += shortVecSize(n)
+	}
+
+	signatureCount := int(m.Header.NumRequireSignatures)
+	return shortVecSize(signatureCount) + signatureCount*64 + size
+}
+
+// Validate checks the things the cluster rejects before the message is signed and sent
+func (m *Message) Validate() error {
+	if len(m.Accounts) == 0 || m.Header.NumRequireSignatures == 0 || m.Accounts[0] == (common.PublicKey{}) {
+		return ErrMissingFeePayer
+	}
+	if int(m.Header.NumRequireSignatures) > len(m.Accounts) {
+		return fmt.Errorf("%w: %v signers but %v accounts", ErrInvalidAccountIndex, m.Header.NumRequireSignatures, len(m.Accounts))
+	}
+
+	seen := make(map[common.PublicKey]struct{}, len(m.Accounts))
+	for i, account := range m.Accounts {
+		if _, ok := seen[account]; ok {
+			if i < int(m.Header.NumRequireSignatures) {
+				return fmt.Errorf("%w: %v", ErrDuplicateSigner, account)
+			}
+			return fmt.Errorf("%w: %v", ErrDuplicateAccount, account)
+		}
+		seen[account] = struct{}{}
+	}
+
+	accountCount := len(m.Accounts)
+	if len(m.AddressLookupTables) > 0 && (len(m.Version) == 0 || m.Version == MessageVersionLegacy) {
+		return fmt.Errorf("%w: a legacy message can't use lookup tables", ErrInvalidAddressLookupTableIndex)
+	}
+	for _, addressLookupTable := range m.AddressLookupTables {
+		indexes := make(map[uint8]struct{}, len(addressLookupTable.WritableIndexes)+len(addressLookupTable.ReadonlyIndexes))
+		for _, idxs := range [][]uint8{addressLookupTable.WritableIndexes, addressLookupTable.ReadonlyIndexes} {
+			for _, idx := range idxs {
+				if _, ok := indexes[idx]; ok {
+					return fmt.Errorf("%w: table %v, index %v is used twice", ErrInvalidAddressLookupTableIndex, addressLookupTable.AccountKey, idx)
+				}
+				indexes[idx] = struct{}{}
+			}
+		}
+		accountCount += len(indexes)
+	}
+	if accountCount > MaxAccounts {
+		return fmt.Errorf("%w: %v", ErrTooManyAccounts, accountCount)
+	}
+
+	for i, instruction := range m.Instructions {
+		if instruction.ProgramIDIndex <= 0 || instruction.ProgramIDIndex >= len(m.Accounts) {
+			return fmt.Errorf("%w: instruction #%d, program id index %v", ErrInvalidAccountIndex, i, instruction.ProgramIDIndex)
+		}
+		for _, idx := range instruction.Accounts {
+			if idx < 0 || idx >= accountCount {
+				return fmt.Errorf("%w: instruction #%d, account index %v", ErrInvalidAccountIndex, i, idx)
+			}
+		}
+	}
+
+	if size := m.EstimateSize(); size > PacketDataSize {
+		return fmt.Errorf("%w: %v bytes, max %v", ErrTransactionTooLarge, size, PacketDataSize)
+	}
+	return nil
+}
+
+func shortVecSize(n int) int {
+	return len(bincode.UintToVarLenBytes(uint64(n)))
+}
+
+// DecompileInstructions converts the compiled instructions back into instructions.
+// it returns nil if an index is out of range or the message loads accounts from lookup tables.
+//
+// Deprecated: use DecompileInstructionsWithLoadedAddresses or DecompileInstructionsWithAddressLookupTables,
+// which report why the message can't be decompiled.
 func (m *Message) DecompileInstructions() []Instruction {
-	switch m.Version {
-	case MessageVersionLegacy:
-		return m.decompileLegacyMessageInstructions()
-	case MessageVersionV0:
-		panic("hasn't supported")
-	default:
-		return m.decompileLegacyMessageInstructions()
+	instructions, err := m.DecompileInstructionsWithLoadedAddresses(LoadedAddresses{})
+	if err != nil {
+		return nil
+	}
+	return instructions
+}
+
+// LoadedAddresses are the accounts a v0 message loads from its lookup tables.
+// they follow the static accounts, the writable ones of every table first and then the readonly ones.
+type LoadedAddresses struct {
+	Writable []common.PublicKey
+	Readonly []common.PublicKey
+}
+
+// LoadAddresses resolves the accounts the message loads from the lookup tables.
+// it returns ErrAddressLookupTableNotFound if a table the message uses isn't in the tables.
+func (m *Message) LoadAddresses(addressLookupTableAccounts []AddressLookupTableAccount) (LoadedAddresses, error) {
+	tables := make(map[common.PublicKey][]common.PublicKey, len(addressLookupTableAccounts))
+	for _, table := range addressLookupTableAccounts {
+		tables[table.Key] = table.Addresses
+	}
+
+	loaded := LoadedAddresses{Writable: []common.PublicKey{}, Readonly: []common.PublicKey{}}
+	for _, lookup := range m.AddressLookupTables {
+		addresses, ok := tables[lookup.AccountKey]
+		if !ok {
+			return LoadedAddresses{}, fmt.Errorf("%w: %v", ErrAddressLookupTableNotFound, lookup.AccountKey)
+		}
+		for _, idx := range lookup.WritableIndexes {
+			if int(idx) >= len(addresses) {
+				return LoadedAddresses{}, fmt.Errorf("%w: table %v has %v addresses, index: %v", ErrInvalidAddressLookupTableIndex, lookup.AccountKey, len(addresses), idx)
+			}
+			loaded.Writable = append(loaded.Writable, addresses[idx])
+		}
+	}
+	for _, lookup := range m.AddressLookupTables {
+		addresses := tables[lookup.AccountKey]
+		for _, idx := range lookup.ReadonlyIndexes {
+			if int(idx) >= len(addresses) {
+				return LoadedAddresses{}, fmt.Errorf("%w: table %v has %v addresses, index: %v", ErrInvalidAddressLookupTableIndex, lookup.AccountKey, len(addresses), idx)
+			}
+			loaded.Readonly = append(loaded.Readonly, addresses[idx])
+		}
+	}
+	return loaded, nil
+}
+
+// DecompileInstructionsWithAddressLookupTables decompiles the instructions with the accounts loaded from the tables
+func (m *Message) DecompileInstructionsWithAddressLookupTables(addressLookupTableAccounts []AddressLookupTableAccount) ([]Instruction, error) {
+	loaded, err := m.LoadAddresses(addressLookupTableAccounts)
+	if err != nil {
+		return nil, err
 	}
+	return m.DecompileInstructionsWithLoadedAddresses(loaded)
 }
 
-func (m Message) decompileLegacyMessageInstructions() []Instruction {
+// DecompileInstructionsWithLoadedAddresses decompiles the instructions with the loaded accounts, e.g. the
+// loaded addresses in the meta of a transaction. it returns ErrMissingLoadedAddresses if their number
+// doesn't match the lookups of the message.
+func (m *Message) DecompileInstructionsWithLoadedAddresses(loaded LoadedAddresses) ([]Instruction, error) {
+	var numWritable, numReadonly int
+	for _, lookup := range m.AddressLookupTables {
+		numWritable += len(lookup.WritableIndexes)
+		numReadonly += len(lookup.ReadonlyIndexes)
+	}
+	if len(loaded.Writable) != numWritable || len(loaded.Readonly) != numReadonly {
+		return nil, fmt.Errorf("%w: the message loads %v writable and %v readonly accounts, got %v and %v",
+			ErrMissingLoadedAddresses, numWritable, numReadonly, len(loaded.Writable), len(loaded.Readonly))
+	}
+
+	accountKeys := make([]common.PublicKey, 0, len(m.Accounts)+numWritable+numReadonly)
+	accountKeys = append(accountKeys, m.Accounts...)
+	accountKeys = append(accountKeys, loaded.Writable...)
+	accountKeys = append(accountKeys, loaded.Readonly...)
+
+	numStatic := len(m.Accounts)
+	numSigners := int(m.Header.NumRequireSignatures)
+	isWritable := func(idx int) bool {
+		switch {
+		case idx < numSigners:
+			return idx < numSigners-int(m.Header.NumReadonlySignedAccounts)
+		case idx < numStatic:
+			return idx < numStatic-int(m.Header.NumReadonlyUnsignedAccounts)
+		default:
+			return idx < numStatic+numWritable
+		}
+	}
+
 	instructions := make([]Instruction, 0, len(m.Instructions))
-	for _, cins := range m.Instructions {
+	for i, cins := range m.Instructions {
+		if cins.ProgramIDIndex < 0 || cins.ProgramIDIndex >= len(accountKeys) {
+			return nil, fmt.Errorf("%w: instruction #%v program id index %v", ErrInvalidAccountIndex, i, cins.ProgramIDIndex)
+		}
 		accounts := make([]AccountMeta, 0, len(cins.Accounts))
-		for i := 0; i < len(cins.Accounts); i++ {
+		for _, idx := range cins.Accounts {
+			if idx < 0 || idx >= len(accountKeys) {
+				return nil, fmt.Errorf("%w: instruction #%v account index %v", ErrInvalidAccountIndex, i, idx)
+			}
 			accounts = append(accounts, AccountMeta{
-				PubKey:   m.Accounts[cins.Accounts[i]],
-				IsSigner: cins.Accounts[i] < int(m.Header.NumRequireSignatures),
-				IsWritable: cins.Accounts[i] < int(m.Header.NumRequireSignatures-m.Header.NumReadonlySignedAccounts) ||
-					(cins.Accounts[i] >= int(m.Header.NumRequireSignatures) &&
-						cins.Accounts[i] < len(m.Accounts)-int(m.Header.NumReadonlyUnsignedAccounts)),
+				PubKey:     accountKeys[idx],
+				IsSigner:   idx < numSigners,
+				IsWritable: isWritable(idx),
 			})
 		}
 		instructions = append(instructions, Instruction{
-			ProgramID: m.Accounts[cins.ProgramIDIndex],
+			ProgramID: accountKeys[cins.ProgramIDIndex],
 			Accounts:  accounts,
 			Data:      cins.Data,
 		})
 	}
-	return instructions
+	return instructions, nil
 }
 
 func MessageDeserialize(messageData []byte) (Message, error) {
+	return MessageDeserializeWithConfig(messageData, DeserializeConfig{})
+}
+
+// MessageDeserializeWithConfig deserializes a legacy or v0 message. it returns ErrInsufficientData
+// if the data is truncated and ErrUnsupportedMessageVersion for the other versions.
+func MessageDeserializeWithConfig(messageData []byte, cfg DeserializeConfig) (Message, error) {
 	if len(messageData) == 0 {
-		return Message{}, errors.New("empty message data")
+		return Message{}, fmt.Errorf("%w: empty message data", ErrInsufficientData)
 	}
 
 	var version MessageVersion
 	if v := uint8(messageData[0]); v > 127 {
 		version = MessageVersion(fmt.Sprintf("v%v", v-128))
+		if version != MessageVersionV0 {
+			return Message{}, fmt.Errorf("%w: %v", ErrUnsupportedMessageVersion, version)
+		}
 		messageData = messageData[1:]
 	} else {
 		version = MessageVersionLegacy
 	}
 
-	var numRequireSignatures, numReadonlySignedAccounts, numReadonlyUnsignedAccounts uint8
-	var t uint64
-	var err error
-	list := []*uint8{&numRequireSignatures, &numReadonlySignedAccounts, &numReadonlyUnsignedAccounts}
-	for i := 0; i < len(list); i++ {
-		t, err = parseUvarint(&messageData)
-		if err != nil || t > 255 {
-			return Message{}, fmt.Errorf("message header #%d parse error: %v", i+1, err)
+	var header [3]uint8
+	for i := range header {
+		t, err := parseUint8(&messageData)
+		if err != nil {
+			return Message{}, fmt.Errorf("message header #%d parse error: %w", i+1, err)
 		}
-		*list[i] = uint8(t)
+		header[i] = t
 	}
 
-	accountCount, err := parseUvarint(&messageData)
+	accountCount, err := parseCount(&messageData, 32)
 	if err != nil {
-		return Message{}, fmt.Errorf("falied to parse count of account, err: %v", err)
-	}
-	if len(messageData) < int(accountCount)*32 {
-		return Message{}, errors.New("parse account error")
+		return Message{}, fmt.Errorf("falied to parse count of account, err: %w", err)
 	}
 	accounts := make([]common.PublicKey, 0, accountCount)
-	for i := 0; i < int(accountCount); i++ {
+	for i := 0; i < accountCount; i++ {
 		accounts = append(accounts, common.PublicKeyFromBytes(messageData[:32]))
 		messageData = messageData[32:]
 	}
 
 	if len(messageData) < 32 {
-		return Message{}, errors.New("parse blockhash error")
+		return Message{}, fmt.Errorf("%w: parse blockhash error", ErrInsufficientData)
 	}
-	blockHash := base58.Encode(messageData[:32])
+	var blockHash common.Hash
+	copy(blockHash[:], messageData[:32])
 	messageData = messageData[32:]
 
-	instructionCount, err := parseUvarint(&messageData)
+	// an instruction has at least a program id index and two lengths
+	instructionCount, err := parseCount(&messageData, 3)
 	if err != nil {
-		return Message{}, fmt.Errorf("parse instruction count error: %v", err)
+		return Message{}, fmt.Errorf("parse instruction count error: %w", err)
 	}
 
 	instructions := make([]CompiledInstruction, 0, instructionCount)
-	for i := 0; i < int(instructionCount); i++ {
-		programID, err := parseUvarint(&messageData)
+	for i := 0; i < instructionCount; i++ {
+		programID, err := parseUint8(&messageData)
 		if err != nil {
-			return Message{}, fmt.Errorf("parse instruction #%d programID error: %v", i+1, err)
+			return Message{}, fmt.Errorf("parse instruction #%d programID error: %w", i+1, err)
 		}
-		accountCount, err := parseUvarint(&messageData)
+		accountCount, err := parseCount(&messageData, 1)
 		if err != nil {
-			return Message{}, fmt.Errorf("parse instruction #%d account count error: %v", i+1, err)
+			return Message{}, fmt.Errorf("parse instruction #%d account count error: %w", i+1, err)
 		}
 		accounts := make([]int, 0, accountCount)
-		for j := 0; j < int(accountCount); j++ {
-			accountIdx, err := parseUvarint(&messageData)
+		for j := 0; j < accountCount; j++ {
+			accountIdx, err := parseUint8(&messageData)
 			if err != nil {
-				return Message{}, fmt.Errorf("parse instruction #%d account #%d idx error: %v", i+1, j+1, err)
+				return Message{}, fmt.Errorf("parse instruction #%d account #%d idx error: %w", i+1, j+1, err)
 			}
 			accounts = append(accounts, int(accountIdx))
 		}
-		dataLen, err := parseUvarint(&messageData)
+		dataLen, err := parseCount(&messageData, 1)
 		if err != nil {
-			return Message{}, fmt.Errorf("parse instruction #%d data length error: %v", i+1, err)
+			return Message{}, fmt.Errorf("parse instruction #%d data length error: %w", i+1, err)
 		}
 		var data []byte
 		data, messageData = messageData[:dataLen], messageData[dataLen:]
@@ -222,25 +418,29 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 
 	compiledAddressLookupTables := []CompiledAddressLookupTable{}
 	if version == MessageVersionV0 {
-		addressLookupTableCount, err := parseUvarint(&messageData)
+		// a lookup has at least a table and two lengths
+		addressLookupTableCount, err := parseCount(&messageData, 34)
 		if err != nil {
-			return Message{}, fmt.Errorf("parse instruction count error: %v", err)
+			return Message{}, fmt.Errorf("failed to parse address lookup table count, err: %w", err)
 		}
 
-		for i := uint64(0); i < addressLookupTableCount; i++ {
+		for i := 0; i < addressLookupTableCount; i++ {
+			if len(messageData) < 32 {
+				return Message{}, fmt.Errorf("%w: parse address lookup table #%d error", ErrInsufficientData, i+1)
+			}
 			addressLookupTablePubkey := common.PublicKeyFromBytes(messageData[:32])
 			messageData = messageData[32:]
 
-			writableAccountIdxCount, err := parseUvarint(&messageData)
+			writableAccountIdxCount, err := parseCount(&messageData, 1)
 			if err != nil {
-				return Message{}, fmt.Errorf("failed to parse address lookup table writable account idx count, err: %v", err)
+				return Message{}, fmt.Errorf("failed to parse address lookup table writable account idx count, err: %w", err)
 			}
 			var writableAccountIdxList []uint8
 			writableAccountIdxList, messageData = messageData[:writableAccountIdxCount], messageData[writableAccountIdxCount:]
 
-			readOnlyAccountIdxCount, err := parseUvarint(&messageData)
+			readOnlyAccountIdxCount, err := parseCount(&messageData, 1)
 			if err != nil {
-				return Message{}, fmt.Errorf("failed to parse address lookup table readOnly account idx count, err: %v", err)
+				return Message{}, fmt.Errorf("failed to parse address lookup table readOnly account idx count, err: %w", err)
 			}
 			var readOnlyAccountIdxList []uint8
 			readOnlyAccountIdxList, messageData = messageData[:readOnlyAccountIdxCount], messageData[readOnlyAccountIdxCount:]
@@ -256,12 +456,16 @@ func MessageDeserialize(messageData []byte) (Message, error) {
 		}
 	}
 
+	if cfg.DisallowTrailingBytes && len(messageData) > 0 {
+		return Message{}, fmt.Errorf("%w: %v bytes", ErrTrailingBytes, len(messageData))
+	}
+
 	return Message{
 		Version: version,
 		Header: MessageHeader{
-			NumRequireSignatures:        numRequireSignatures,
-			NumReadonlySignedAccounts:   numReadonlySignedAccounts,
-			NumReadonlyUnsignedAccounts: numReadonlyUnsignedAccounts,
+			NumRequireSignatures:        header[0],
+			NumReadonlySignedAccounts:   header[1],
+			NumReadonlyUnsignedAccounts: header[2],
 		},
 		Accounts:            accounts,
 		RecentBlockHash:     blockHash,
@@ -286,7 +490,7 @@ type AddressLookupTableAccount struct {
 type NewMessageParam struct {
 	FeePayer        common.PublicKey
 	Instructions    []Instruction
-	RecentBlockhash string
+	RecentBlockhash common.Hash
 	// v0 transaction
 	AddressLookupTableAccounts []AddressLookupTableAccount
 }
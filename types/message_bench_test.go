@@ -19,7 +19,7 @@ func BenchmarkSerializeLegacyMessage(b *testing.B) {
 			common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
 			common.SystemProgramID,
 		},
-		RecentBlockHash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		RecentBlockHash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 		Instructions: []CompiledInstruction{
 			{
 				ProgramIDIndex: 2,
@@ -58,7 +58,7 @@ func BenchmarkSerializeV0Message(b *testing.B) {
 			common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
 			common.SystemProgramID,
 		},
-		RecentBlockHash: "5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW",
+		RecentBlockHash: common.MustParseHash("5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW"),
 		Instructions: []CompiledInstruction{
 			{
 				ProgramIDIndex: 1,
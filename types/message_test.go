@@ -1,7 +1,7 @@
 package types
 
 import (
-	"fmt"
+	"errors"
 	"testing"
 
 	"github.com/blocto/solana-go-sdk/common"
@@ -13,7 +13,7 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 		Version             MessageVersion
 		Header              MessageHeader
 		Accounts            []common.PublicKey
-		RecentBlockHash     string
+		RecentBlockHash     common.Hash
 		Instructions        []CompiledInstruction
 		AddressLookupTables []CompiledAddressLookupTable
 	}
@@ -21,7 +21,6 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 		name   string
 		fields fields
 		want   []Instruction
-		panic  string
 	}{
 		{
 			fields: fields{
@@ -35,7 +34,7 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 					common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+				RecentBlockHash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -68,7 +67,7 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 					common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+				RecentBlockHash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -101,7 +100,7 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 					common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+				RecentBlockHash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -110,7 +109,16 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 					},
 				},
 			},
-			panic: "hasn't supported",
+			want: []Instruction{
+				{
+					Accounts: []AccountMeta{
+						{PubKey: common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"), IsSigner: true, IsWritable: true},
+						{PubKey: common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"), IsSigner: false, IsWritable: true},
+					},
+					ProgramID: common.SystemProgramID,
+					Data:      []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+				},
+			},
 		},
 		{
 			fields: fields{
@@ -125,7 +133,7 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 					common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+				RecentBlockHash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -141,7 +149,16 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 					},
 				},
 			},
-			panic: "hasn't supported",
+			want: []Instruction{
+				{
+					Accounts: []AccountMeta{
+						{PubKey: common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"), IsSigner: true, IsWritable: true},
+						{PubKey: common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"), IsSigner: false, IsWritable: true},
+					},
+					ProgramID: common.SystemProgramID,
+					Data:      []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+				},
+			},
 		},
 		{
 			fields: fields{
@@ -155,7 +172,7 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 					common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW",
+				RecentBlockHash: common.MustParseHash("5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 1,
@@ -171,7 +188,7 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 					},
 				},
 			},
-			panic: "hasn't supported",
+			want: nil,
 		},
 	}
 	for _, tt := range tests {
@@ -184,17 +201,74 @@ func TestMessage_DecompileInstructions(t *testing.T) {
 				Instructions:        tt.fields.Instructions,
 				AddressLookupTables: tt.fields.AddressLookupTables,
 			}
-			if len(tt.panic) == 0 {
-				assert.Equal(t, tt.want, m.DecompileInstructions())
-			} else {
-				assert.PanicsWithValue(t, tt.panic, func() {
-					m.DecompileInstructions()
-				})
-			}
+			assert.Equal(t, tt.want, m.DecompileInstructions())
 		})
 	}
 }
 
+func TestMessage_DecompileInstructionsWithAddressLookupTables(t *testing.T) {
+	feePayer := common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde")
+	writable1 := common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i")
+	writable2 := common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b")
+	readonly := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	tables := []AddressLookupTableAccount{
+		{Key: common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"), Addresses: []common.PublicKey{readonly, writable1}},
+		{Key: common.PublicKeyFromString("5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW"), Addresses: []common.PublicKey{writable2}},
+	}
+	instructions := []Instruction{
+		{
+			ProgramID: common.SystemProgramID,
+			Accounts: []AccountMeta{
+				{PubKey: feePayer, IsSigner: true, IsWritable: true},
+				{PubKey: writable1, IsSigner: false, IsWritable: true},
+			},
+			Data: []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			ProgramID: common.MemoProgramID,
+			Accounts: []AccountMeta{
+				{PubKey: readonly, IsSigner: false, IsWritable: false},
+				{PubKey: writable2, IsSigner: false, IsWritable: true},
+			},
+			Data: []byte("memo"),
+		},
+	}
+	m := NewMessage(NewMessageParam{
+		FeePayer:                   feePayer,
+		RecentBlockhash:            common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
+		Instructions:               instructions,
+		AddressLookupTableAccounts: tables,
+	})
+	assert.Len(t, m.AddressLookupTables, 2)
+
+	got, err := m.DecompileInstructionsWithAddressLookupTables(tables)
+	assert.Nil(t, err)
+	assert.Equal(t, instructions, got)
+
+	loaded, err := m.LoadAddresses(tables)
+	assert.Nil(t, err)
+	assert.Equal(t, LoadedAddresses{Writable: []common.PublicKey{writable1, writable2}, Readonly: []common.PublicKey{readonly}}, loaded)
+	got, err = m.DecompileInstructionsWithLoadedAddresses(loaded)
+	assert.Nil(t, err)
+	assert.Equal(t, instructions, got)
+
+	assert.Nil(t, m.DecompileInstructions())
+
+	_, err = m.DecompileInstructionsWithAddressLookupTables(tables[:1])
+	assert.ErrorIs(t, err, ErrAddressLookupTableNotFound)
+
+	_, err = m.DecompileInstructionsWithAddressLookupTables([]AddressLookupTableAccount{tables[0], {Key: tables[1].Key}})
+	assert.ErrorIs(t, err, ErrInvalidAddressLookupTableIndex)
+
+	_, err = m.DecompileInstructionsWithLoadedAddresses(LoadedAddresses{Writable: loaded.Writable})
+	assert.ErrorIs(t, err, ErrMissingLoadedAddresses)
+
+	m.Instructions[0].Accounts = append(m.Instructions[0].Accounts, 10)
+	_, err = m.DecompileInstructionsWithLoadedAddresses(loaded)
+	assert.ErrorIs(t, err, ErrInvalidAccountIndex)
+	assert.NotPanics(t, func() { assert.Nil(t, m.DecompileInstructions()) })
+}
+
 func TestNewMessage(t *testing.T) {
 	type args struct {
 		param NewMessageParam
@@ -226,7 +300,7 @@ func TestNewMessage(t *testing.T) {
 							Data: []byte{2, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0},
 						},
 					},
-					RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+					RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 				},
 			},
 			want: Message{
@@ -241,7 +315,7 @@ func TestNewMessage(t *testing.T) {
 					common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+				RecentBlockHash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -274,7 +348,7 @@ func TestNewMessage(t *testing.T) {
 							Data: []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
 						},
 					},
-					RecentBlockhash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+					RecentBlockhash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 				},
 			},
 			want: Message{
@@ -289,7 +363,7 @@ func TestNewMessage(t *testing.T) {
 					common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+				RecentBlockHash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -322,7 +396,7 @@ func TestNewMessage(t *testing.T) {
 							Data: []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
 						},
 					},
-					RecentBlockhash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+					RecentBlockhash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 					AddressLookupTableAccounts: []AddressLookupTableAccount{
 						{
 							Key: common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"),
@@ -342,7 +416,7 @@ func TestNewMessage(t *testing.T) {
 					common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+				RecentBlockHash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -375,7 +449,7 @@ func TestNewMessage(t *testing.T) {
 							Data: []byte{2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
 						},
 					},
-					RecentBlockhash: "5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW",
+					RecentBlockhash: common.MustParseHash("5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW"),
 					AddressLookupTableAccounts: []AddressLookupTableAccount{
 						{
 							Key: common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"),
@@ -398,7 +472,7 @@ func TestNewMessage(t *testing.T) {
 					common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW",
+				RecentBlockHash: common.MustParseHash("5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 1,
@@ -446,7 +520,7 @@ func TestNewMessage(t *testing.T) {
 							Data: []byte{12, 1, 0, 0, 0, 0, 0, 0, 0, 9},
 						},
 					},
-					RecentBlockhash: "5YjqMBZNwqmoUXkpoL4isLNwkaa2zuqxpRMBob47Bjxd",
+					RecentBlockhash: common.MustParseHash("5YjqMBZNwqmoUXkpoL4isLNwkaa2zuqxpRMBob47Bjxd"),
 					AddressLookupTableAccounts: []AddressLookupTableAccount{
 						{
 							Key: common.PublicKeyFromString("4jBXhGD8X8i2MCkunSDnqvyzQrGcfV6rqy5A4ETJBtaA"),
@@ -476,7 +550,7 @@ func TestNewMessage(t *testing.T) {
 					common.PublicKeyFromString("FUarP2p5EnxD66vVDL4PWRoWMzA56ZVHG24hpEDFShEz"),
 					common.TokenProgramID,
 				},
-				RecentBlockHash: "5YjqMBZNwqmoUXkpoL4isLNwkaa2zuqxpRMBob47Bjxd",
+				RecentBlockHash: common.MustParseHash("5YjqMBZNwqmoUXkpoL4isLNwkaa2zuqxpRMBob47Bjxd"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 1,
@@ -510,7 +584,7 @@ func TestMessageSerialize(t *testing.T) {
 		Version             MessageVersion
 		Header              MessageHeader
 		Accounts            []common.PublicKey
-		RecentBlockHash     string
+		RecentBlockHash     common.Hash
 		Instructions        []CompiledInstruction
 		AddressLookupTables []CompiledAddressLookupTable
 	}
@@ -532,7 +606,7 @@ func TestMessageSerialize(t *testing.T) {
 					common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+				RecentBlockHash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -557,7 +631,7 @@ func TestMessageSerialize(t *testing.T) {
 					common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+				RecentBlockHash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -582,7 +656,7 @@ func TestMessageSerialize(t *testing.T) {
 					common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+				RecentBlockHash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -613,7 +687,7 @@ func TestMessageSerialize(t *testing.T) {
 					common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW",
+				RecentBlockHash: common.MustParseHash("5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 1,
@@ -644,7 +718,7 @@ func TestMessageSerialize(t *testing.T) {
 					common.PublicKeyFromString("FUarP2p5EnxD66vVDL4PWRoWMzA56ZVHG24hpEDFShEz"),
 					common.TokenProgramID,
 				},
-				RecentBlockHash: "8QYt53pDt3jMhgFKWWeGPkbpPprGBp7mTx68q6vv5JW1",
+				RecentBlockHash: common.MustParseHash("8QYt53pDt3jMhgFKWWeGPkbpPprGBp7mTx68q6vv5JW1"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 1,
@@ -709,7 +783,7 @@ func TestMessageDeserialize(t *testing.T) {
 					common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+				RecentBlockHash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -735,7 +809,7 @@ func TestMessageDeserialize(t *testing.T) {
 					common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+				RecentBlockHash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -761,7 +835,7 @@ func TestMessageDeserialize(t *testing.T) {
 					common.PublicKeyFromString("2xNweLHLqrbx4zo1waDvgWJHgsUpPj8Y8icbAFeR4a8i"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo",
+				RecentBlockHash: common.MustParseHash("9rAtxuhtKn8qagc3UtZFyhLrw5zkh6etv43TibaXuSKo"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 2,
@@ -786,7 +860,7 @@ func TestMessageDeserialize(t *testing.T) {
 					common.PublicKeyFromString("9aE476sH92Vz7DMPyq5WLPkrKWivxeuTKEFKd2sZZcde"),
 					common.SystemProgramID,
 				},
-				RecentBlockHash: "5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW",
+				RecentBlockHash: common.MustParseHash("5EvWPqKeYfN2P7SAQZ2TLnXhV3Ltjn6qEhK1F279dUUW"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 1,
@@ -817,7 +891,7 @@ func TestMessageDeserialize(t *testing.T) {
 					common.PublicKeyFromString("FUarP2p5EnxD66vVDL4PWRoWMzA56ZVHG24hpEDFShEz"),
 					common.TokenProgramID,
 				},
-				RecentBlockHash: "8QYt53pDt3jMhgFKWWeGPkbpPprGBp7mTx68q6vv5JW1",
+				RecentBlockHash: common.MustParseHash("8QYt53pDt3jMhgFKWWeGPkbpPprGBp7mTx68q6vv5JW1"),
 				Instructions: []CompiledInstruction{
 					{
 						ProgramIDIndex: 1,
@@ -842,14 +916,235 @@ func TestMessageDeserialize(t *testing.T) {
 		{
 			args: args{messageData: []byte{128}},
 			want: Message{},
-			err:  fmt.Errorf("message header #1 parse error: data is empty"),
+			err:  ErrInsufficientData,
+		},
+		{
+			name: "unsupported version",
+			args: args{messageData: []byte{129, 1, 0, 1, 0}},
+			want: Message{},
+			err:  ErrUnsupportedMessageVersion,
+		},
+		{
+			name: "truncated accounts",
+			args: args{messageData: []byte{1, 0, 1, 3, 206, 211, 135}},
+			want: Message{},
+			err:  ErrInsufficientData,
+		},
+		{
+			name: "huge account count",
+			args: args{messageData: []byte{1, 0, 1, 0xff, 0xff, 0xff, 0xff, 0x0f}},
+			want: Message{},
+			err:  ErrInsufficientData,
+		},
+		{
+			name: "truncated instruction data",
+			args: args{messageData: []byte{1, 0, 1, 1, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240, 134, 172, 221, 244, 189, 59, 8, 252, 7, 91, 129, 169, 22, 151, 32, 104, 208, 131, 64, 75, 232, 201, 77, 13, 187, 220, 103, 232, 190, 100, 35, 210, 17, 42, 1, 0, 0, 12, 2, 0, 0}},
+			want: Message{},
+			err:  ErrInsufficientData,
+		},
+		{
+			name: "truncated address lookup table",
+			args: args{messageData: []byte{128, 1, 0, 0, 1, 127, 96, 107, 250, 152, 133, 208, 224, 73, 251, 113, 151, 128, 139, 86, 80, 101, 70, 138, 50, 141, 153, 218, 110, 56, 39, 122, 181, 120, 55, 86, 185, 29, 11, 113, 4, 101, 239, 39, 167, 201, 112, 156, 239, 236, 36, 251, 140, 76, 199, 150, 228, 218, 214, 20, 123, 180, 181, 103, 160, 71, 251, 237, 123, 0, 1, 131, 118, 36, 248, 169, 123, 97, 98, 215, 133, 18, 92, 220, 162, 163, 79, 201, 66, 96, 112, 57, 224, 101, 105, 255, 83, 217, 144, 233, 242, 195, 102, 2, 0}},
+			want: Message{},
+			err:  ErrInsufficientData,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got, err := MessageDeserialize(tt.args.messageData)
 			assert.Equal(t, tt.want, got)
-			assert.Equal(t, tt.err, err)
+			assert.True(t, errors.Is(err, tt.err), err)
 		})
 	}
 }
+
+func TestMessageDeserializeWithConfig(t *testing.T) {
+	message := NewMessage(NewMessageParam{
+		FeePayer:        common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7"),
+		Instructions:    []Instruction{{ProgramID: common.SystemProgramID, Accounts: []AccountMeta{}, Data: []byte{1}}},
+		RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+	})
+	b, err := message.Serialize()
+	assert.Nil(t, err)
+	b = append(b, 0)
+
+	got, err := MessageDeserializeWithConfig(b, DeserializeConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, message.Instructions, got.Instructions)
+
+	_, err = MessageDeserializeWithConfig(b, DeserializeConfig{DisallowTrailingBytes: true})
+	assert.ErrorIs(t, err, ErrTrailingBytes)
+
+	_, err = MessageDeserializeWithConfig(b[:len(b)-1], DeserializeConfig{DisallowTrailingBytes: true})
+	assert.Nil(t, err)
+}
+
+func TestMessage_EstimateSize(t *testing.T) {
+	feePayer, signer := NewAccount(), NewAccount()
+	to := common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b")
+	instruction := Instruction{
+		ProgramID: common.SystemProgramID,
+		Accounts: []AccountMeta{
+			{PubKey: signer.PublicKey, IsSigner: true, IsWritable: true},
+			{PubKey: to, IsSigner: false, IsWritable: true},
+		},
+		Data: make([]byte, 200),
+	}
+	tests := []struct {
+		name  string
+		param NewMessageParam
+	}{
+		{
+			name: "legacy",
+			param: NewMessageParam{
+				FeePayer:        feePayer.PublicKey,
+				Instructions:    []Instruction{instruction, instruction},
+				RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+			},
+		},
+		{
+			name: "v0",
+			param: NewMessageParam{
+				FeePayer:        feePayer.PublicKey,
+				Instructions:    []Instruction{instruction},
+				RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+				AddressLookupTableAccounts: []AddressLookupTableAccount{
+					{Key: common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"), Addresses: []common.PublicKey{to}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := NewMessage(tt.param)
+			tx, err := NewTransaction(NewTransactionParam{Message: message, Signers: []Account{feePayer, signer}})
+			assert.Nil(t, err)
+			raw, err := tx.Serialize()
+			assert.Nil(t, err)
+			assert.Equal(t, len(raw), message.EstimateSize())
+			assert.Equal(t, len(raw), tx.EstimateSize())
+		})
+	}
+}
+
+func TestMessage_Validate(t *testing.T) {
+	feePayer := common.PublicKeyFromString("EvN4kgKmCmYzdbd5kL8Q8YgkUW5RoqMTpBczrfLExtx7")
+	to := common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b")
+	valid := func() Message {
+		return Message{
+			Version:         MessageVersionV0,
+			Header:          MessageHeader{NumRequireSignatures: 1, NumReadonlyUnsignedAccounts: 1},
+			Accounts:        []common.PublicKey{feePayer, to, common.SystemProgramID},
+			RecentBlockHash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+			Instructions:    []CompiledInstruction{{ProgramIDIndex: 2, Accounts: []int{0, 1, 3}}},
+			AddressLookupTables: []CompiledAddressLookupTable{
+				{AccountKey: common.PublicKeyFromString("HEhDGuxaxGr9LuNtBdvbX2uggyAKoxYgHFaAiqxVu8UY"), WritableIndexes: []uint8{0}},
+			},
+		}
+	}
+	m := valid()
+	assert.Nil(t, m.Validate())
+
+	tests := []struct {
+		name    string
+		modify  func(m *Message)
+		wantErr error
+	}{
+		{
+			name:    "no fee payer",
+			modify:  func(m *Message) { m.Accounts[0] = common.PublicKey{} },
+			wantErr: ErrMissingFeePayer,
+		},
+		{
+			name:    "no signers",
+			modify:  func(m *Message) { m.Header.NumRequireSignatures = 0 },
+			wantErr: ErrMissingFeePayer,
+		},
+		{
+			name: "duplicate signer",
+			modify: func(m *Message) {
+				m.Header.NumRequireSignatures = 2
+				m.Accounts[1] = feePayer
+			},
+			wantErr: ErrDuplicateSigner,
+		},
+		{
+			name:    "duplicate account",
+			modify:  func(m *Message) { m.Accounts[2] = to },
+			wantErr: ErrDuplicateAccount,
+		},
+		{
+			name:    "account index out of range",
+			modify:  func(m *Message) { m.Instructions[0].Accounts = []int{4} },
+			wantErr: ErrInvalidAccountIndex,
+		},
+		{
+			name:    "fee payer as a program",
+			modify:  func(m *Message) { m.Instructions[0].ProgramIDIndex = 0 },
+			wantErr: ErrInvalidAccountIndex,
+		},
+		{
+			name:    "program from a lookup table",
+			modify:  func(m *Message) { m.Instructions[0].ProgramIDIndex = 3 },
+			wantErr: ErrInvalidAccountIndex,
+		},
+		{
+			name:    "lookup table index used twice",
+			modify:  func(m *Message) { m.AddressLookupTables[0].ReadonlyIndexes = []uint8{0} },
+			wantErr: ErrInvalidAddressLookupTableIndex,
+		},
+		{
+			name:    "legacy message with a lookup table",
+			modify:  func(m *Message) { m.Version = MessageVersionLegacy },
+			wantErr: ErrInvalidAddressLookupTableIndex,
+		},
+		{
+			name: "too many accounts",
+			modify: func(m *Message) {
+				for i := 0; i < 255; i++ {
+					m.AddressLookupTables[0].ReadonlyIndexes = append(m.AddressLookupTables[0].ReadonlyIndexes, uint8(i+1))
+				}
+			},
+			wantErr: ErrTooManyAccounts,
+		},
+		{
+			name:    "too large",
+			modify:  func(m *Message) { m.Instructions[0].Data = make([]byte, PacketDataSize) },
+			wantErr: ErrTransactionTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := valid()
+			tt.modify(&m)
+			err := m.Validate()
+			assert.True(t, errors.Is(err, tt.wantErr), err)
+
+			tx := Transaction{Message: m}
+			assert.True(t, errors.Is(tx.Validate(), tt.wantErr))
+		})
+	}
+}
+
+func FuzzMessageDeserialize(f *testing.F) {
+	f.Add([]byte{1, 0, 1, 3, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240, 134, 172, 209, 213, 227, 137, 61, 108, 116, 171, 205, 124, 54, 68, 61, 110, 80, 31, 240, 117, 108, 137, 97, 222, 38, 242, 68, 156, 27, 65, 29, 142, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 221, 244, 189, 59, 8, 252, 7, 91, 129, 169, 22, 151, 32, 104, 208, 131, 64, 75, 232, 201, 77, 13, 187, 220, 103, 232, 190, 100, 35, 210, 17, 42, 1, 2, 2, 0, 1, 12, 2, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+	f.Add([]byte{128, 1, 0, 1, 1, 127, 96, 107, 250, 152, 133, 208, 224, 73, 251, 113, 151, 128, 139, 86, 80, 101, 70, 138, 50, 141, 153, 218, 110, 56, 39, 122, 181, 120, 55, 86, 185, 29, 11, 113, 4, 101, 239, 39, 167, 201, 112, 156, 239, 236, 36, 251, 140, 76, 199, 150, 228, 218, 214, 20, 123, 180, 181, 103, 160, 71, 251, 237, 123, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 2, 0, 1, 1, 2, 1, 131, 118, 36, 248, 169, 123, 97, 98, 215, 133, 18, 92, 220, 162, 163, 79, 201, 66, 96, 112, 57, 224, 101, 105, 255, 83, 217, 144, 233, 242, 195, 102, 1, 0, 1, 1})
+	f.Add([]byte{128})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		message, err := MessageDeserializeWithConfig(data, DeserializeConfig{DisallowTrailingBytes: true})
+		if err != nil {
+			return
+		}
+		_ = message.DecompileInstructions()
+		_, _ = message.DecompileInstructionsWithLoadedAddresses(LoadedAddresses{})
+		_ = message.Validate()
+		_ = message.EstimateSize()
+		b, err := message.Serialize()
+		if err != nil {
+			t.Fatalf("failed to serialize a deserialized message, err: %v", err)
+		}
+		if _, err := MessageDeserialize(b); err != nil {
+			t.Fatalf("failed to deserialize a serialized message, err: %v", err)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/blocto/solana-go-sdk/common"
+)
+
+var (
+	ErrTransactionInvalidSignature      = errors.New("invalid signature")
+	ErrTransactionInvalidSignatureSlots = errors.New("invalid signature slots")
+)
+
+// Signer signs messages for a public key. the private key can live anywhere, in memory (Account),
+// a kms, a hardware signer or a wallet.
+type Signer interface {
+	// Public returns the public key the signer signs for
+	Public() common.PublicKey
+	// SignMessage returns the ed25519 signature of the message
+	SignMessage(ctx context.Context, message []byte) ([]byte, error)
+}
+
+func (a Account) Public() common.PublicKey {
+	return a.PublicKey
+}
+
+func (a Account) SignMessage(_ context.Context, message []byte) ([]byte, error) {
+	return a.Sign(message), nil
+}
+
+type NewTransactionWithSignersParam struct {
+	Message Message
+	Signers []Signer
+}
+
+// NewTransactionWithSigners create a new tx by message and signers. it will reserve signatures slot.
+// the signers which are not in the param can sign it later by Transaction.Sign.
+func NewTransactionWithSigners(ctx context.Context, param NewTransactionWithSignersParam) (Transaction, error) {
+	signatures := make([]Signature, 0, param.Message.Header.NumRequireSignatures)
+	for i := uint8(0); i < param.Message.Header.NumRequireSignatures; i++ {
+		signatures = append(signatures, Signature{})
+	}
+
+	tx := Transaction{
+		Signatures: signatures,
+		Message:    param.Message,
+	}
+	if err := tx.Sign(ctx, param.Signers...); err != nil {
+		return Transaction{}, err
+	}
+	return tx, nil
+}
+
+// Sign signs the tx by the signers and puts the signatures into their slots. it is used for partial signing,
+// signers can sign in any order and the other slots stay as they are.
+func (tx *Transaction) Sign(ctx context.Context, signers ...Signer) error {
+	if len(signers) == 0 {
+		return nil
+	}
+
+	numRequireSignatures := int(tx.Message.Header.NumRequireSignatures)
+	if numRequireSignatures > len(tx.Message.Accounts) {
+		return fmt.Errorf("%w, the message requires %v signatures but has %v accounts", ErrTransactionInvalidSignatureSlots, numRequireSignatures, len(tx.Message.Accounts))
+	}
+	if len(tx.Signatures) < numRequireSignatures {
+		return fmt.Errorf("%w, the message requires %v signatures but the tx has %v slots", ErrTransactionInvalidSignatureSlots, numRequireSignatures, len(tx.Signatures))
+	}
+
+	m := map[common.PublicKey]uint8{}
+	for i := uint8(0); i < tx.Message.Header.NumRequireSignatures; i++ {
+		m[tx.Message.Accounts[i]] = i
+	}
+
+	data, err := tx.Message.Serialize()
+	if err != nil {
+		return fmt.Errorf("failed to serialize message, err: %v", err)
+	}
+
+	for _, signer := range signers {
+		pubkey := signer.Public()
+		idx, ok := m[pubkey]
+		if !ok {
+			return fmt.Errorf("%w, %v is not a signer", ErrTransactionAddNotNecessarySignatures, pubkey)
+		}
+		sig, err := signer.SignMessage(ctx, data)
+		if err != nil {
+			return fmt.Errorf("failed to sign by %v, err: %w", pubkey, err)
+		}
+		if len(sig) != ed25519.SignatureSize || !ed25519.Verify(pubkey.Bytes(), data, sig) {
+			return fmt.Errorf("%w, signed by %v", ErrTransactionInvalidSignature, pubkey)
+		}
+		copy(tx.Signatures[idx][:], sig)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// badSigner signs for a public key it doesn't own
+type badSigner struct {
+	publicKey common.PublicKey
+	account   Account
+}
+
+func (s badSigner) Public() common.PublicKey {
+	return s.publicKey
+}
+
+func (s badSigner) SignMessage(ctx context.Context, message []byte) ([]byte, error) {
+	return s.account.SignMessage(ctx, message)
+}
+
+func TestTransaction_Sign(t *testing.T) {
+	testAccount1 := NewAccount()
+	testAccount2 := NewAccount()
+	testAccount3 := NewAccount()
+
+	emptySig := Signature{}
+	msg := NewMessage(NewMessageParam{
+		FeePayer: testAccount1.PublicKey,
+		Instructions: []Instruction{
+			{
+				ProgramID: common.PublicKeyFromString("CustomProgram111111111111111111111111111111"),
+				Accounts: []AccountMeta{
+					{PubKey: testAccount2.PublicKey, IsSigner: true, IsWritable: true},
+				},
+				Data: []byte{},
+			},
+		},
+		RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+	})
+	serMsg, _ := msg.Serialize()
+
+	tests := []struct {
+		name    string
+		signers []Signer
+		want    []Signature
+		err     error
+	}{
+		{
+			name:    "partial",
+			signers: []Signer{testAccount2},
+			want:    []Signature{emptySig, mustSignature(testAccount2.Sign(serMsg))},
+		},
+		{
+			name:    "any order",
+			signers: []Signer{testAccount2, testAccount1},
+			want:    []Signature{mustSignature(testAccount1.Sign(serMsg)), mustSignature(testAccount2.Sign(serMsg))},
+		},
+		{
+			name:    "not a signer",
+			signers: []Signer{testAccount3},
+			want:    []Signature{emptySig, emptySig},
+			err:     ErrTransactionAddNotNecessarySignatures,
+		},
+		{
+			name:    "invalid signature",
+			signers: []Signer{badSigner{publicKey: testAccount2.PublicKey, account: testAccount3}},
+			want:    []Signature{emptySig, emptySig},
+			err:     ErrTransactionInvalidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := Transaction{
+				Signatures: []Signature{emptySig, emptySig},
+				Message:    msg,
+			}
+			err := tx.Sign(context.Background(), tt.signers...)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, tx.Signatures)
+		})
+	}
+}
+
+func TestTransaction_Sign_InvalidSignatureSlots(t *testing.T) {
+	feePayer := NewAccount()
+	msg := NewMessage(NewMessageParam{
+		FeePayer:        feePayer.PublicKey,
+		RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+	})
+
+	tx := Transaction{Message: msg}
+	assert.ErrorIs(t, tx.Sign(context.Background(), feePayer), ErrTransactionInvalidSignatureSlots)
+
+	msg.Header.NumRequireSignatures = 2
+	tx = Transaction{Signatures: []Signature{{}, {}}, Message: msg}
+	assert.ErrorIs(t, tx.Sign(context.Background(), feePayer), ErrTransactionInvalidSignatureSlots)
+	assert.Equal(t, []Signature{{}, {}}, tx.Signatures)
+}
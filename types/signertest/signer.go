@@ -0,0 +1,57 @@
+// Package signertest provides a types.Signer for tests.
+package signertest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/types"
+)
+
+// Request is a message the signer was asked to sign
+type Request struct {
+	PublicKey common.PublicKey
+	Message   []byte
+}
+
+// RecordingSigner records every sign request and forwards it to Signer. if Err is set, it fails
+// every request with Err instead.
+type RecordingSigner struct {
+	Signer types.Signer
+	Err    error
+
+	mu       sync.Mutex
+	requests []Request
+}
+
+// NewRecordingSigner returns a RecordingSigner backed by a new random account
+func NewRecordingSigner() *RecordingSigner {
+	return &RecordingSigner{Signer: types.NewAccount()}
+}
+
+func (s *RecordingSigner) Public() common.PublicKey {
+	return s.Signer.Public()
+}
+
+func (s *RecordingSigner) SignMessage(ctx context.Context, message []byte) ([]byte, error) {
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		PublicKey: s.Signer.Public(),
+		Message:   append([]byte{}, message...),
+	})
+	err := s.Err
+	s.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	return s.Signer.SignMessage(ctx, message)
+}
+
+// Requests returns the recorded requests in order
+func (s *RecordingSigner) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request{}, s.requests...)
+}
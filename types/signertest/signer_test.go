@@ -0,0 +1,52 @@
+package signertest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/blocto/solana-go-sdk/common"
+	"github.com/blocto/solana-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordingSigner(t *testing.T) {
+	feePayer := types.NewAccount()
+	remote := NewRecordingSigner()
+
+	message := types.NewMessage(types.NewMessageParam{
+		FeePayer: feePayer.PublicKey,
+		Instructions: []types.Instruction{
+			{
+				ProgramID: common.PublicKeyFromString("CustomProgram111111111111111111111111111111"),
+				Accounts: []types.AccountMeta{
+					{PubKey: remote.Public(), IsSigner: true, IsWritable: false},
+				},
+				Data: []byte{},
+			},
+		},
+		RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
+	})
+	serializedMessage, err := message.Serialize()
+	assert.Nil(t, err)
+
+	tx, err := types.NewTransactionWithSigners(context.Background(), types.NewTransactionWithSignersParam{
+		Message: message,
+		Signers: []types.Signer{feePayer, remote},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []Request{{PublicKey: remote.Public(), Message: serializedMessage}}, remote.Requests())
+
+	sig, err := remote.Signer.SignMessage(context.Background(), serializedMessage)
+	assert.Nil(t, err)
+	feePayerSig, err := common.ParseSignatureFromBytes(feePayer.Sign(serializedMessage))
+	assert.Nil(t, err)
+	remoteSig, err := common.ParseSignatureFromBytes(sig)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Signature{feePayerSig, remoteSig}, tx.Signatures)
+
+	remote.Err = errors.New("device disconnected")
+	err = tx.Sign(context.Background(), remote)
+	assert.ErrorIs(t, err, remote.Err)
+	assert.Len(t, remote.Requests(), 2)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"crypto/ed25519"
 	"encoding/binary"
 	"errors"
@@ -10,11 +11,15 @@ import (
 	"github.com/blocto/solana-go-sdk/pkg/bincode"
 )
 
+// PacketDataSize is the max size of a serialized transaction
+const PacketDataSize = 1232
+
 var (
 	ErrTransactionAddNotNecessarySignatures = errors.New("add not necessary signatures")
 )
 
-type Signature []byte
+// Signature is an ed25519 signature of a transaction
+type Signature = common.Signature
 
 type Transaction struct {
 	Signatures []Signature
@@ -27,33 +32,16 @@ type NewTransactionParam struct {
 }
 
 // NewTransaction create a new tx by message and signer. it will reserve signatures slot.
+// use NewTransactionWithSigners if the private keys are not in memory.
 func NewTransaction(param NewTransactionParam) (Transaction, error) {
-	signatures := make([]Signature, 0, param.Message.Header.NumRequireSignatures)
-	for i := uint8(0); i < param.Message.Header.NumRequireSignatures; i++ {
-		signatures = append(signatures, make([]byte, 64))
-	}
-
-	m := map[common.PublicKey]uint8{}
-	for i := uint8(0); i < param.Message.Header.NumRequireSignatures; i++ {
-		m[param.Message.Accounts[i]] = i
-	}
-
-	data, err := param.Message.Serialize()
-	if err != nil {
-		return Transaction{}, fmt.Errorf("failed to serialize message, err: %v", err)
-	}
+	signers := make([]Signer, 0, len(param.Signers))
 	for _, signer := range param.Signers {
-		idx, ok := m[signer.PublicKey]
-		if !ok {
-			return Transaction{}, fmt.Errorf("%w, %v is not a signer", ErrTransactionAddNotNecessarySignatures, signer.PublicKey)
-		}
-		signatures[idx] = signer.Sign(data)
+		signers = append(signers, signer)
 	}
-
-	return Transaction{
-		Signatures: signatures,
-		Message:    param.Message,
-	}, nil
+	return NewTransactionWithSigners(context.Background(), NewTransactionWithSignersParam{
+		Message: param.Message,
+		Signers: signers,
+	})
 }
 
 // AddSignature will add or replace signature into the correct order signature's slot.
@@ -62,10 +50,10 @@ func (tx *Transaction) AddSignature(sig []byte) error {
 	if err != nil {
 		return fmt.Errorf("failed to serialize message, err: %v", err)
 	}
-	for i := uint8(0); i < tx.Message.Header.NumRequireSignatures; i++ {
+	for i := 0; i < int(tx.Message.Header.NumRequireSignatures) && i < len(tx.Message.Accounts) && i < len(tx.Signatures); i++ {
 		a := tx.Message.Accounts[i]
-		if ed25519.Verify(a.Bytes(), data, sig) {
-			tx.Signatures[i] = sig
+		if len(sig) == ed25519.SignatureSize && ed25519.Verify(a.Bytes(), data, sig) {
+			copy(tx.Signatures[i][:], sig)
 			return nil
 		}
 	}
@@ -87,37 +75,58 @@ func (tx *Transaction) Serialize() ([]byte, error) {
 	output := make([]byte, 0, len(signatureCount)+len(signatureCount)*64+len(messageData))
 	output = append(output, signatureCount...)
 	for _, sig := range tx.Signatures {
-		output = append(output, sig...)
+		output = append(output, sig[:]...)
 	}
 	output = append(output, messageData...)
 
 	return output, nil
 }
 
+// EstimateSize returns the size of the transaction once every signature is added
+func (tx *Transaction) EstimateSize() int {
+	return tx.Message.EstimateSize()
+}
+
+// Validate checks the message, see Message.Validate
+func (tx *Transaction) Validate() error {
+	return tx.Message.Validate()
+}
+
+// DeserializeConfig is the config of MessageDeserializeWithConfig and TransactionDeserializeWithConfig
+type DeserializeConfig struct {
+	// DisallowTrailingBytes returns ErrTrailingBytes if there are bytes after the message
+	DisallowTrailingBytes bool
+}
+
 // TransactionDeserialize can deserialize a tx from byte array
 func TransactionDeserialize(tx []byte) (Transaction, error) {
-	signatureCount, err := parseUvarint(&tx)
+	return TransactionDeserializeWithConfig(tx, DeserializeConfig{})
+}
+
+// TransactionDeserializeWithConfig deserializes a tx from byte array.
+// it returns ErrInsufficientData if the data is truncated.
+func TransactionDeserializeWithConfig(tx []byte, cfg DeserializeConfig) (Transaction, error) {
+	signatureCount, err := parseCount(&tx, 64)
 	if err != nil {
-		return Transaction{}, fmt.Errorf("parse signature count error: %v", err)
+		return Transaction{}, fmt.Errorf("parse signature count error: %w", err)
 	}
 	if signatureCount < 1 {
 		return Transaction{}, errors.New("signature count must be greater than or equal to 1")
 	}
-	if len(tx) < int(signatureCount)*64 {
-		return Transaction{}, errors.New("parse signature error")
-	}
 	signatures := make([]Signature, 0, signatureCount)
-	for i := 0; i < int(signatureCount); i++ {
-		signatures = append(signatures, tx[:64])
+	for i := 0; i < signatureCount; i++ {
+		var sig Signature
+		copy(sig[:], tx[:64])
+		signatures = append(signatures, sig)
 		tx = tx[64:]
 	}
 
-	message, err := MessageDeserialize(tx)
+	message, err := MessageDeserializeWithConfig(tx, cfg)
 	if err != nil {
-		return Transaction{}, fmt.Errorf("failed to parse message, err: %v", err)
+		return Transaction{}, fmt.Errorf("failed to parse message, err: %w", err)
 	}
 
-	if uint64(message.Header.NumRequireSignatures) != signatureCount {
+	if int(message.Header.NumRequireSignatures) != signatureCount {
 		return Transaction{}, errors.New("numRequireSignatures is not equal to signatureCount")
 	}
 
@@ -138,12 +147,37 @@ func MustTransactionDeserialize(data []byte) Transaction {
 
 func parseUvarint(tx *[]byte) (uint64, error) {
 	if len(*tx) == 0 {
-		return 0, errors.New("data is empty")
+		return 0, fmt.Errorf("%w: data is empty", ErrInsufficientData)
 	}
 	u, n := binary.Uvarint(*tx)
-	if n <= 0 {
+	if n == 0 {
+		return 0, fmt.Errorf("%w: incomplete varint", ErrInsufficientData)
+	}
+	if n < 0 {
 		return 0, errors.New("format error")
 	}
 	*tx = (*tx)[n:]
 	return u, nil
 }
+
+// parseCount parses a length prefix and checks the rest of the data has enough bytes for
+// the items of size bytes so the count is safe to make a slice.
+func parseCount(tx *[]byte, size int) (int, error) {
+	u, err := parseUvarint(tx)
+	if err != nil {
+		return 0, err
+	}
+	if u > uint64(len(*tx)/size) {
+		return 0, fmt.Errorf("%w: %v items of %v bytes, %v bytes left", ErrInsufficientData, u, size, len(*tx))
+	}
+	return int(u), nil
+}
+
+func parseUint8(tx *[]byte) (uint8, error) {
+	if len(*tx) == 0 {
+		return 0, fmt.Errorf("%w: data is empty", ErrInsufficientData)
+	}
+	u := (*tx)[0]
+	*tx = (*tx)[1:]
+	return u, nil
+}
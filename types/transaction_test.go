@@ -21,7 +21,7 @@ func TestTransaction_Serialize(t *testing.T) {
 	}{
 		{
 			fields: fields{
-				Signatures: []Signature{[]byte{189, 98, 67, 19, 102, 99, 124, 234, 70, 209, 28, 10, 33, 66, 167, 162, 222, 122, 16, 68, 248, 129, 46, 111, 221, 255, 40, 40, 236, 84, 233, 213, 234, 185, 235, 222, 155, 204, 139, 164, 184, 155, 32, 54, 151, 73, 235, 65, 200, 76, 127, 111, 244, 72, 183, 208, 21, 247, 114, 176, 181, 21, 77, 8}},
+				Signatures: []Signature{{189, 98, 67, 19, 102, 99, 124, 234, 70, 209, 28, 10, 33, 66, 167, 162, 222, 122, 16, 68, 248, 129, 46, 111, 221, 255, 40, 40, 236, 84, 233, 213, 234, 185, 235, 222, 155, 204, 139, 164, 184, 155, 32, 54, 151, 73, 235, 65, 200, 76, 127, 111, 244, 72, 183, 208, 21, 247, 114, 176, 181, 21, 77, 8}},
 				Message: Message{
 					Header: MessageHeader{
 						NumRequireSignatures:        1,
@@ -33,7 +33,7 @@ func TestTransaction_Serialize(t *testing.T) {
 						common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
 						common.SystemProgramID,
 					},
-					RecentBlockHash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+					RecentBlockHash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 					Instructions: []CompiledInstruction{
 						{
 							ProgramIDIndex: 2,
@@ -80,7 +80,7 @@ func TestTransactionDeserialize(t *testing.T) {
 				tx: []byte{1, 189, 98, 67, 19, 102, 99, 124, 234, 70, 209, 28, 10, 33, 66, 167, 162, 222, 122, 16, 68, 248, 129, 46, 111, 221, 255, 40, 40, 236, 84, 233, 213, 234, 185, 235, 222, 155, 204, 139, 164, 184, 155, 32, 54, 151, 73, 235, 65, 200, 76, 127, 111, 244, 72, 183, 208, 21, 247, 114, 176, 181, 21, 77, 8, 1, 0, 1, 3, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240, 134, 172, 209, 213, 227, 137, 61, 108, 116, 171, 205, 124, 54, 68, 61, 110, 80, 31, 240, 117, 108, 137, 97, 222, 38, 242, 68, 156, 27, 65, 29, 142, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 221, 244, 189, 59, 8, 252, 7, 91, 129, 169, 22, 151, 32, 104, 208, 131, 64, 75, 232, 201, 77, 13, 187, 220, 103, 232, 190, 100, 35, 210, 17, 42, 1, 2, 2, 0, 1, 12, 2, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0},
 			},
 			want: Transaction{
-				Signatures: []Signature{[]byte{189, 98, 67, 19, 102, 99, 124, 234, 70, 209, 28, 10, 33, 66, 167, 162, 222, 122, 16, 68, 248, 129, 46, 111, 221, 255, 40, 40, 236, 84, 233, 213, 234, 185, 235, 222, 155, 204, 139, 164, 184, 155, 32, 54, 151, 73, 235, 65, 200, 76, 127, 111, 244, 72, 183, 208, 21, 247, 114, 176, 181, 21, 77, 8}},
+				Signatures: []Signature{{189, 98, 67, 19, 102, 99, 124, 234, 70, 209, 28, 10, 33, 66, 167, 162, 222, 122, 16, 68, 248, 129, 46, 111, 221, 255, 40, 40, 236, 84, 233, 213, 234, 185, 235, 222, 155, 204, 139, 164, 184, 155, 32, 54, 151, 73, 235, 65, 200, 76, 127, 111, 244, 72, 183, 208, 21, 247, 114, 176, 181, 21, 77, 8}},
 				Message: Message{
 					Version: MessageVersionLegacy,
 					Header: MessageHeader{
@@ -93,7 +93,7 @@ func TestTransactionDeserialize(t *testing.T) {
 						common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
 						common.SystemProgramID,
 					},
-					RecentBlockHash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+					RecentBlockHash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 					Instructions: []CompiledInstruction{
 						{
 							ProgramIDIndex: 2,
@@ -112,8 +112,8 @@ func TestTransactionDeserialize(t *testing.T) {
 			},
 			want: Transaction{
 				Signatures: []Signature{
-					[]byte{74, 231, 188, 191, 144, 39, 14, 161, 169, 155, 174, 83, 136, 177, 49, 105, 154, 137, 23, 153, 145, 47, 130, 208, 246, 195, 244, 141, 52, 228, 21, 190, 130, 99, 162, 145, 30, 133, 140, 2, 103, 40, 95, 141, 116, 111, 249, 205, 59, 137, 56, 204, 67, 132, 148, 152, 74, 69, 48, 200, 227, 0, 156, 8},
-					[]byte{33, 150, 49, 151, 221, 70, 119, 149, 120, 244, 227, 186, 179, 109, 146, 176, 20, 58, 224, 180, 254, 64, 210, 181, 208, 226, 151, 52, 192, 198, 242, 20, 184, 23, 238, 214, 165, 140, 56, 190, 100, 122, 29, 216, 79, 196, 144, 239, 203, 64, 106, 255, 216, 27, 153, 242, 78, 154, 235, 204, 72, 58, 227, 3},
+					{74, 231, 188, 191, 144, 39, 14, 161, 169, 155, 174, 83, 136, 177, 49, 105, 154, 137, 23, 153, 145, 47, 130, 208, 246, 195, 244, 141, 52, 228, 21, 190, 130, 99, 162, 145, 30, 133, 140, 2, 103, 40, 95, 141, 116, 111, 249, 205, 59, 137, 56, 204, 67, 132, 148, 152, 74, 69, 48, 200, 227, 0, 156, 8},
+					{33, 150, 49, 151, 221, 70, 119, 149, 120, 244, 227, 186, 179, 109, 146, 176, 20, 58, 224, 180, 254, 64, 210, 181, 208, 226, 151, 52, 192, 198, 242, 20, 184, 23, 238, 214, 165, 140, 56, 190, 100, 122, 29, 216, 79, 196, 144, 239, 203, 64, 106, 255, 216, 27, 153, 242, 78, 154, 235, 204, 72, 58, 227, 3},
 				},
 				Message: Message{
 					Version: MessageVersionLegacy,
@@ -127,7 +127,7 @@ func TestTransactionDeserialize(t *testing.T) {
 						common.PublicKeyFromString("A4iUVr5KjmsLymUcv4eSKPedUtoaBceiPeGipKMYc69b"),
 						common.SystemProgramID,
 					},
-					RecentBlockHash: "9qERNBLXzCqchyfquh2DjUT21xsLym6ynZPRh9TZbEiq",
+					RecentBlockHash: common.MustParseHash("9qERNBLXzCqchyfquh2DjUT21xsLym6ynZPRh9TZbEiq"),
 					Instructions: []CompiledInstruction{
 						{
 							ProgramIDIndex: 2,
@@ -145,6 +145,18 @@ func TestTransactionDeserialize(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:    "truncated signatures",
+			args:    args{tx: []byte{2, 189, 98, 67}},
+			want:    Transaction{},
+			wantErr: true,
+		},
+		{
+			name:    "huge signature count",
+			args:    args{tx: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}},
+			want:    Transaction{},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -165,7 +177,7 @@ func TestNewTransaction(t *testing.T) {
 	testAccount2 := NewAccount()
 	testAccount3 := NewAccount()
 
-	emptySig := make([]byte, 64)
+	emptySig := Signature{}
 
 	msg := []Message{
 		NewMessage(NewMessageParam{
@@ -188,7 +200,7 @@ func TestNewTransaction(t *testing.T) {
 					Data: []byte{},
 				},
 			},
-			RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+			RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 		}),
 		NewMessage(NewMessageParam{
 			FeePayer: testAccount1.PublicKey,
@@ -210,7 +222,7 @@ func TestNewTransaction(t *testing.T) {
 					Data: []byte{},
 				},
 			},
-			RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+			RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 		}),
 		NewMessage(NewMessageParam{
 			FeePayer: testAccount1.PublicKey,
@@ -232,7 +244,7 @@ func TestNewTransaction(t *testing.T) {
 					Data: []byte{},
 				},
 			},
-			RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+			RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 		}),
 	}
 	serMsg := make([][]byte, 0, len(msg))
@@ -280,7 +292,7 @@ func TestNewTransaction(t *testing.T) {
 			},
 			want: Transaction{
 				Signatures: []Signature{
-					testAccount1.Sign(serMsg[0]),
+					mustSignature(testAccount1.Sign(serMsg[0])),
 				},
 				Message: msg[0],
 			},
@@ -305,7 +317,7 @@ func TestNewTransaction(t *testing.T) {
 			},
 			want: Transaction{
 				Signatures: []Signature{
-					testAccount1.Sign(serMsg[1]),
+					mustSignature(testAccount1.Sign(serMsg[1])),
 					emptySig,
 				},
 				Message: msg[1],
@@ -319,7 +331,7 @@ func TestNewTransaction(t *testing.T) {
 			want: Transaction{
 				Signatures: []Signature{
 					emptySig,
-					testAccount2.Sign(serMsg[1]),
+					mustSignature(testAccount2.Sign(serMsg[1])),
 				},
 				Message: msg[1],
 			},
@@ -331,8 +343,8 @@ func TestNewTransaction(t *testing.T) {
 			},
 			want: Transaction{
 				Signatures: []Signature{
-					testAccount1.Sign(serMsg[1]),
-					testAccount2.Sign(serMsg[1]),
+					mustSignature(testAccount1.Sign(serMsg[1])),
+					mustSignature(testAccount2.Sign(serMsg[1])),
 				},
 				Message: msg[1],
 			},
@@ -344,8 +356,8 @@ func TestNewTransaction(t *testing.T) {
 			},
 			want: Transaction{
 				Signatures: []Signature{
-					testAccount1.Sign(serMsg[1]),
-					testAccount2.Sign(serMsg[1]),
+					mustSignature(testAccount1.Sign(serMsg[1])),
+					mustSignature(testAccount2.Sign(serMsg[1])),
 				},
 				Message: msg[1],
 			},
@@ -358,7 +370,7 @@ func TestNewTransaction(t *testing.T) {
 			want: Transaction{
 				Signatures: []Signature{
 					emptySig,
-					testAccount2.Sign(serMsg[2]),
+					mustSignature(testAccount2.Sign(serMsg[2])),
 					emptySig,
 				},
 				Message: msg[2],
@@ -383,7 +395,7 @@ func TestTransaction_AddSignature(t *testing.T) {
 	testAccount3 := NewAccount()
 	testAccount4 := NewAccount()
 
-	emptySig := make([]byte, 64)
+	emptySig := Signature{}
 	msg := NewMessage(NewMessageParam{
 		FeePayer: testAccount1.PublicKey,
 		Instructions: []Instruction{
@@ -404,7 +416,7 @@ func TestTransaction_AddSignature(t *testing.T) {
 				Data: []byte{},
 			},
 		},
-		RecentBlockhash: "FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5",
+		RecentBlockhash: common.MustParseHash("FwRYtTPRk5N4wUeP87rTw9kQVSwigB6kbikGzzeCMrW5"),
 	})
 	serMsg, _ := msg.Serialize()
 
@@ -433,7 +445,7 @@ func TestTransaction_AddSignature(t *testing.T) {
 			},
 			want: Transaction{
 				Signatures: []Signature{
-					testAccount1.Sign(serMsg),
+					mustSignature(testAccount1.Sign(serMsg)),
 					emptySig,
 					emptySig,
 				},
@@ -444,7 +456,7 @@ func TestTransaction_AddSignature(t *testing.T) {
 			name: "add duplicate",
 			tx: Transaction{
 				Signatures: []Signature{
-					testAccount1.Sign(serMsg),
+					mustSignature(testAccount1.Sign(serMsg)),
 					emptySig,
 					emptySig,
 				},
@@ -455,7 +467,7 @@ func TestTransaction_AddSignature(t *testing.T) {
 			},
 			want: Transaction{
 				Signatures: []Signature{
-					testAccount1.Sign(serMsg),
+					mustSignature(testAccount1.Sign(serMsg)),
 					emptySig,
 					emptySig,
 				},
@@ -466,7 +478,7 @@ func TestTransaction_AddSignature(t *testing.T) {
 			name: "add no match",
 			tx: Transaction{
 				Signatures: []Signature{
-					testAccount1.Sign(serMsg),
+					mustSignature(testAccount1.Sign(serMsg)),
 					emptySig,
 					emptySig,
 				},
@@ -477,7 +489,7 @@ func TestTransaction_AddSignature(t *testing.T) {
 			},
 			want: Transaction{
 				Signatures: []Signature{
-					testAccount1.Sign(serMsg),
+					mustSignature(testAccount1.Sign(serMsg)),
 					emptySig,
 					emptySig,
 				},
@@ -495,3 +507,27 @@ func TestTransaction_AddSignature(t *testing.T) {
 		})
 	}
 }
+
+func FuzzTransactionDeserialize(f *testing.F) {
+	f.Add([]byte{1, 189, 98, 67, 19, 102, 99, 124, 234, 70, 209, 28, 10, 33, 66, 167, 162, 222, 122, 16, 68, 248, 129, 46, 111, 221, 255, 40, 40, 236, 84, 233, 213, 234, 185, 235, 222, 155, 204, 139, 164, 184, 155, 32, 54, 151, 73, 235, 65, 200, 76, 127, 111, 244, 72, 183, 208, 21, 247, 114, 176, 181, 21, 77, 8, 1, 0, 1, 3, 206, 211, 135, 230, 195, 111, 87, 254, 147, 239, 143, 81, 110, 159, 49, 140, 109, 137, 224, 197, 24, 49, 223, 61, 123, 8, 78, 109, 110, 136, 228, 240, 134, 172, 209, 213, 227, 137, 61, 108, 116, 171, 205, 124, 54, 68, 61, 110, 80, 31, 240, 117, 108, 137, 97, 222, 38, 242, 68, 156, 27, 65, 29, 142, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 221, 244, 189, 59, 8, 252, 7, 91, 129, 169, 22, 151, 32, 104, 208, 131, 64, 75, 232, 201, 77, 13, 187, 220, 103, 232, 190, 100, 35, 210, 17, 42, 1, 2, 2, 0, 1, 12, 2, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+	f.Add([]byte{0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		tx, err := TransactionDeserializeWithConfig(data, DeserializeConfig{DisallowTrailingBytes: true})
+		if err != nil {
+			return
+		}
+		_ = tx.AddSignature(make([]byte, 64))
+		_ = tx.Validate()
+		if _, err := tx.Serialize(); err != nil {
+			t.Fatalf("failed to serialize a deserialized transaction, err: %v", err)
+		}
+	})
+}
+
+func mustSignature(b []byte) Signature {
+	sig, err := common.ParseSignatureFromBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return sig
+}